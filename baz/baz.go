@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // baz
 
 package baz
@@ -12,6 +13,7 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("baz", "f", &F, map[string]interface{}{"arglists": [][]string{{"x"}}})
 }
 
 var F *cljs_core.AFn
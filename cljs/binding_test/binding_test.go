@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// Edited by hand since it was compiled, to follow the compiler and test/cljs/binding_test.cljs changes. Regenerate it with lein compile-clojurescript-tests.
 // cljs.binding-test
 
 package binding_test
@@ -17,24 +18,21 @@ func init() {
 	Test_binding = func(test_binding *cljs_core.AFn) *cljs_core.AFn {
 		return cljs_core.Fn(test_binding, 0, func() interface{} {
 			{
-				var _STAR_foo_STAR_2_3 = cljs_binding_test_other_ns.X_STAR_foo_STAR_
-				_ = _STAR_foo_STAR_2_3
+				cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.binding-test-other-ns/*foo*", float64(2)}, nil}))
 				func() {
 					defer func() {
-						cljs_binding_test_other_ns.X_STAR_foo_STAR_ = _STAR_foo_STAR_2_3
+						cljs_core.Pop_thread_bindings.X_invoke_Arity0()
 
 					}()
 					{
-						cljs_binding_test_other_ns.X_STAR_foo_STAR_ = float64(2)
-
-						if cljs_core.X_EQ_.Arity2IIB(cljs_binding_test_other_ns.X_STAR_foo_STAR_, float64(2)) {
+						if cljs_core.X_EQ_.Arity2IIB(cljs_core.Dynamic_or_zero_("cljs.binding-test-other-ns/*foo*", cljs_binding_test_other_ns.X_STAR_foo_STAR_).(float64), float64(2)) {
 						} else {
 							panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= o/*foo* 2)").(string)}, ``)}))
 						}
 					}
 				}()
 			}
-			if cljs_core.X_EQ_.Arity2IIB(cljs_binding_test_other_ns.X_STAR_foo_STAR_, float64(1)) {
+			if cljs_core.X_EQ_.Arity2IIB(cljs_core.Dynamic_or_zero_("cljs.binding-test-other-ns/*foo*", cljs_binding_test_other_ns.X_STAR_foo_STAR_).(float64), float64(1)) {
 				return nil
 			} else {
 				panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= o/*foo* 1)").(string)}, ``)}))
@@ -42,6 +40,59 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	Test_binding_conveyance = func(test_binding_conveyance *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(test_binding_conveyance, 0, func() interface{} {
+			{
+				cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.binding-test-other-ns/*foo*", float64(2)}, nil}))
+				func() {
+					defer func() {
+						cljs_core.Pop_thread_bindings.X_invoke_Arity0()
+
+					}()
+					{
+						if cljs_core.X_EQ_.Arity2IIB(float64(2), cljs_core.Deref.X_invoke_Arity1(cljs_core.Future_call.X_invoke_Arity1(func(G__4 *cljs_core.AFn) *cljs_core.AFn {
+							return cljs_core.Fn(G__4, 0, func() interface{} {
+								return cljs_core.Dynamic_or_zero_("cljs.binding-test-other-ns/*foo*", cljs_binding_test_other_ns.X_STAR_foo_STAR_).(float64)
+							})
+						}(&cljs_core.AFn{})))) {
+						} else {
+							panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= 2 (clojure.core/deref (future o/*foo*)))").(string)}, ``)}))
+						}
+						{
+							var f = cljs_core.Bound_fn_STAR_.X_invoke_Arity1(func(G__5 *cljs_core.AFn) *cljs_core.AFn {
+								return cljs_core.Fn(G__5, 0, func() interface{} {
+									return cljs_core.Dynamic_or_zero_("cljs.binding-test-other-ns/*foo*", cljs_binding_test_other_ns.X_STAR_foo_STAR_).(float64)
+								})
+							}(&cljs_core.AFn{}))
+							_ = f
+							if !cljs_core.Set_dynamic_("cljs.binding-test-other-ns/*foo*", float64(3)) {
+								cljs_binding_test_other_ns.X_STAR_foo_STAR_ = float64(3)
+							}
+
+							if cljs_core.X_EQ_.Arity2IIB(float64(3), cljs_core.Dynamic_or_zero_("cljs.binding-test-other-ns/*foo*", cljs_binding_test_other_ns.X_STAR_foo_STAR_).(float64)) {
+							} else {
+								panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= 3 o/*foo*)").(string)}, ``)}))
+							}
+							if cljs_core.X_EQ_.Arity2IIB(float64(2), f.(cljs_core.CljsCoreIFn).X_invoke_Arity0()) {
+							} else {
+								panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= 2 (f))").(string)}, ``)}))
+							}
+						}
+					}
+				}()
+			}
+			if cljs_core.X_EQ_.Arity2IIB(float64(1), cljs_core.Deref.X_invoke_Arity1(cljs_core.Future_call.X_invoke_Arity1(func(G__6 *cljs_core.AFn) *cljs_core.AFn {
+				return cljs_core.Fn(G__6, 0, func() interface{} {
+					return cljs_core.Dynamic_or_zero_("cljs.binding-test-other-ns/*foo*", cljs_binding_test_other_ns.X_STAR_foo_STAR_).(float64)
+				})
+			}(&cljs_core.AFn{})))) {
+				return nil
+			} else {
+				panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= 1 (clojure.core/deref (future o/*foo*)))").(string)}, ``)}))
+			}
+		})
+	}(&cljs_core.AFn{})
+
 	Test_with_redefs = func(test_with_redefs *cljs_core.AFn) *cljs_core.AFn {
 		return cljs_core.Fn(test_with_redefs, 0, func() interface{} {
 			{
@@ -70,14 +121,20 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("cljs.binding-test", "test-binding", &Test_binding, map[string]interface{}{"arglists": [][]string{{}}})
+	cljs_core.Intern_("cljs.binding-test", "test-binding-conveyance", &Test_binding_conveyance, map[string]interface{}{"arglists": [][]string{{}}})
+	cljs_core.Intern_("cljs.binding-test", "test-with-redefs", &Test_with_redefs, map[string]interface{}{"arglists": [][]string{{}}})
 }
 
 var Test_binding *cljs_core.AFn
 
+var Test_binding_conveyance *cljs_core.AFn
+
 var Test_with_redefs *cljs_core.AFn
 
 func Test_runner(t *testing.T) {
 	Test_binding.X_invoke_Arity0()
+	Test_binding_conveyance.X_invoke_Arity0()
 	Test_with_redefs.X_invoke_Arity0()
 	assert.True(t, true)
 }
@@ -1,13 +1,18 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // cljs.binding-test-other-ns
 
 package binding_test_other_ns
 
+import cljs_core "github.com/hraberg/cljs2go/cljs/core"
+
 func init() {
 	X_STAR_foo_STAR_ = float64(1)
 
 	Bar = float64(10)
 
+	cljs_core.Intern_("cljs.binding-test-other-ns", "*foo*", &X_STAR_foo_STAR_, map[string]interface{}{"dynamic": true})
+	cljs_core.Intern_("cljs.binding-test-other-ns", "bar", &Bar, map[string]interface{}{})
 }
 
 var X_STAR_foo_STAR_ float64
@@ -0,0 +1,420 @@
+package core
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/hraberg/cljs2go/goog"
+	"github.com/hraberg/cljs2go/js"
+)
+
+// Agents run their actions one at a time on goroutines. Actions dispatched with send are bounded
+// by the size of the send pool, send-off actions always get a goroutine of their own.
+// Sends made from within an action are held until the action completes.
+
+var sendPool = make(chan struct{}, runtime.NumCPU()+2)
+
+var agentsShutdown int32
+
+var heldSends = struct {
+	sync.Mutex
+	actions map[int64][]*agentAction
+}{actions: map[int64][]*agentAction{}}
+
+type agentAction struct {
+	agent *CljsCoreAgent
+	f     interface{}
+	args  interface{}
+	solo  bool
+	latch *sync.WaitGroup
+}
+
+func (this *agentAction) execute() {
+	if this.solo {
+		go this.agent.run(this)
+	} else {
+		go func() {
+			sendPool <- struct{}{}
+			defer func() { <-sendPool }()
+			this.agent.run(this)
+		}()
+	}
+}
+
+type CljsCoreAgent struct {
+	state        unsafe.Pointer
+	queueMutex   sync.Mutex
+	queue        []*agentAction
+	err          interface{}
+	ErrorMode    interface{}
+	ErrorHandler interface{}
+	iref
+}
+
+func (this *CljsCoreAgent) load() *atomState {
+	return (*atomState)(atomic.LoadPointer(&this.state))
+}
+
+func (this *CljsCoreAgent) error() interface{} {
+	this.queueMutex.Lock()
+	defer this.queueMutex.Unlock()
+	return this.err
+}
+
+func (this *CljsCoreAgent) errorHandler() interface{} {
+	this.queueMutex.Lock()
+	defer this.queueMutex.Unlock()
+	return this.ErrorHandler
+}
+
+func (this *CljsCoreAgent) setErrorHandler(handler interface{}) {
+	this.queueMutex.Lock()
+	defer this.queueMutex.Unlock()
+	this.ErrorHandler = handler
+}
+
+func (this *CljsCoreAgent) errorMode() interface{} {
+	this.queueMutex.Lock()
+	defer this.queueMutex.Unlock()
+	return this.ErrorMode
+}
+
+func (this *CljsCoreAgent) setErrorMode(mode interface{}) {
+	this.queueMutex.Lock()
+	defer this.queueMutex.Unlock()
+	this.ErrorMode = mode
+}
+
+func (this *CljsCoreAgent) dispatch(action *agentAction) {
+	if tx := currentTransaction(); tx != nil {
+		tx.actions = append(tx.actions, action)
+		return
+	}
+	id := goroutineId()
+	heldSends.Lock()
+	if held, ok := heldSends.actions[id]; ok {
+		heldSends.actions[id] = append(held, action)
+		heldSends.Unlock()
+		return
+	}
+	heldSends.Unlock()
+	this.enqueue(action)
+}
+
+func (this *CljsCoreAgent) enqueue(action *agentAction) {
+	this.queueMutex.Lock()
+	defer this.queueMutex.Unlock()
+	if this.err != nil {
+		panic(&js.Error{"Agent is failed, needs restart"})
+	}
+	this.queue = append(this.queue, action)
+	if len(this.queue) == 1 {
+		action.execute()
+	}
+}
+
+func (this *CljsCoreAgent) run(action *agentAction) {
+	err := this.apply(action)
+	handler, mode := this.errorHandler(), this.errorMode()
+	if err != nil && !Nil_(handler) {
+		func() {
+			defer func() { recover() }()
+			handler.(CljsCoreIFn).X_invoke_Arity2(this, err)
+		}()
+	}
+	this.queueMutex.Lock()
+	defer this.queueMutex.Unlock()
+	if err != nil && !Keyword_identical_QMARK_.Arity2IIB(mode, continueKeyword) {
+		this.err = err
+		return
+	}
+	this.queue = this.queue[1:]
+	if len(this.queue) > 0 {
+		this.queue[0].execute()
+	}
+}
+
+func release(held []*agentAction) (err interface{}) {
+	defer func() { err = recover() }()
+	for _, action := range held {
+		action.agent.enqueue(action)
+	}
+	return nil
+}
+
+func (this *CljsCoreAgent) apply(action *agentAction) (err interface{}) {
+	if action.latch != nil {
+		defer action.latch.Done()
+		return nil
+	}
+	id := goroutineId()
+	heldSends.Lock()
+	heldSends.actions[id] = nil
+	heldSends.Unlock()
+	defer func() {
+		heldSends.Lock()
+		held := heldSends.actions[id]
+		delete(heldSends.actions, id)
+		heldSends.Unlock()
+		if err = recover(); err == nil {
+			err = release(held)
+		}
+	}()
+	oldval := this.load().val
+	newval := Apply.X_invoke_Arity3(action.f, oldval, action.args)
+	this.validate(this.validator(), newval)
+	atomic.StorePointer(&this.state, unsafe.Pointer(&atomState{newval}))
+	this.notifyWatches(this, oldval, newval)
+	return nil
+}
+
+func (this *CljsCoreAgent) send(f interface{}, args interface{}, solo bool) *CljsCoreAgent {
+	if atomic.LoadInt32(&agentsShutdown) == 1 {
+		panic(&js.Error{"Agents have been shut down"})
+	}
+	action := Binding_conveyor_fn_(Fn(0, func(state_args__ ...interface{}) interface{} {
+		Push_thread_bindings.X_invoke_Arity1(&CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.core/*agent*", this}, nil})
+		defer Pop_thread_bindings.X_invoke_Arity0()
+		return Apply.X_invoke_Arity2(f, state_args__[0])
+	}))
+	this.dispatch(&agentAction{agent: this, f: action, args: args, solo: solo})
+	return this
+}
+
+func (this *CljsCoreAgent) restart(newval interface{}, clearActions bool) {
+	this.queueMutex.Lock()
+	defer this.queueMutex.Unlock()
+	if this.err == nil {
+		panic(&js.Error{"Agent does not need a restart"})
+	}
+	this.validate(this.validator(), newval)
+	atomic.StorePointer(&this.state, unsafe.Pointer(&atomState{newval}))
+	this.err = nil
+	if clearActions {
+		this.queue = nil
+	} else if this.queue = this.queue[1:]; len(this.queue) > 0 {
+		this.queue[0].execute()
+	}
+}
+
+func (_ *CljsCoreAgent) CljsCoreIHash__() {}
+func (this *CljsCoreAgent) X_hash_Arity1() interface{} {
+	return Native_invoke_func.X_invoke_Arity2(goog.GetUid, []interface{}{this})
+}
+
+func (_ *CljsCoreAgent) CljsCoreIWatchable__() {}
+func (this *CljsCoreAgent) X_notify_watches_Arity3(oldval interface{}, newval interface{}) interface{} {
+	this.notifyWatches(this, oldval, newval)
+	return nil
+}
+
+func (this *CljsCoreAgent) X_add_watch_Arity3(key interface{}, f interface{}) interface{} {
+	this.addWatch(key, f)
+	return this
+}
+
+func (this *CljsCoreAgent) X_remove_watch_Arity2(key interface{}) interface{} {
+	return this.removeWatch(key)
+}
+
+func (_ *CljsCoreAgent) CljsCoreIMeta__() {}
+func (this *CljsCoreAgent) X_meta_Arity1() interface{} {
+	return this.Meta
+}
+
+func (_ *CljsCoreAgent) CljsCoreIDeref__() {}
+func (this *CljsCoreAgent) X_deref_Arity1() interface{} {
+	return this.load().val
+}
+
+func (_ *CljsCoreAgent) CljsCoreIPrintWithWriter__() {}
+func (this *CljsCoreAgent) X_pr_writer_Arity3(writer interface{}, opts interface{}) interface{} {
+	Decorate_(writer).(CljsCoreIWriter).X_write_Arity2("#<Agent: ")
+	Pr_writer.X_invoke_Arity3(this.X_deref_Arity1(), writer, opts)
+	return Decorate_(writer).(CljsCoreIWriter).X_write_Arity2(">")
+}
+
+var errorHandlerKeyword = &CljsCoreKeyword{Ns: nil, Name: "error-handler", Fqn: "error-handler", X_hash: float64(-484945776)}
+var errorModeKeyword = &CljsCoreKeyword{Ns: nil, Name: "error-mode", Fqn: "error-mode", X_hash: float64(657386049)}
+var continueKeyword = &CljsCoreKeyword{Ns: nil, Name: "continue", Fqn: "continue", X_hash: float64(-207346553)}
+var failKeyword = &CljsCoreKeyword{Ns: nil, Name: "fail", Fqn: "fail", X_hash: float64(1706214930)}
+var clearActionsKeyword = &CljsCoreKeyword{Ns: nil, Name: "clear-actions", Fqn: "clear-actions", X_hash: float64(1729117985)}
+
+// The agent currently running an action on this goroutine, else nil.
+var X_STAR_agent_STAR_ interface{}
+
+// Creates and returns an agent with an initial value of state and
+// zero or more options (in any order):
+//
+// :meta metadata-map
+//
+// :validator validate-fn
+//
+// :error-handler handler-fn
+//
+// :error-mode mode-keyword
+//
+// If metadata-map is supplied, it will become the metadata on the
+// agent. validate-fn must be nil or a side-effect-free fn of one
+// argument, which will be passed the intended new state on any state
+// change. If the new state is unacceptable, the validate-fn should
+// return false or throw an Error. handler-fn is called if an
+// action throws an Error or if validate-fn rejects a new state --
+// see set-error-handler! for details. The mode-keyword may be either
+// :continue (the default if an error-handler is given) or :fail (the
+// default if no error-handler is given) -- see set-error-mode! for
+// details.
+// @param {...*} var_args
+var Agent = Fn(1, func(state interface{}) interface{} {
+	return &CljsCoreAgent{state: unsafe.Pointer(&atomState{state}), ErrorMode: failKeyword}
+}, func(state_options__ ...interface{}) interface{} {
+	var state = state_options__[0]
+	var opts = Apply.X_invoke_Arity2(Hash_map, state_options__[1])
+	var a = &CljsCoreAgent{state: unsafe.Pointer(&atomState{state}),
+		ErrorHandler: Get.X_invoke_Arity2(opts, errorHandlerKeyword),
+		iref:         iref{Meta: Get.X_invoke_Arity2(opts, metaKeyword), Validator: Get.X_invoke_Arity2(opts, validatorKeyword)}}
+	a.ErrorMode = Get.X_invoke_Arity3(opts, errorModeKeyword, failKeyword)
+	if !Nil_(a.ErrorHandler) {
+		a.ErrorMode = Get.X_invoke_Arity3(opts, errorModeKeyword, continueKeyword)
+	}
+	a.validate(a.Validator, state)
+	return a
+})
+
+// Dispatch an action to an agent. Returns the agent immediately.
+// Subsequently, in a goroutine from a bounded pool, the state of the agent
+// will be set to the value of:
+//
+// (apply action-fn state-of-agent args)
+// @param {...*} var_args
+var Send = Fn(2, func(a interface{}, f interface{}) interface{} {
+	return a.(*CljsCoreAgent).send(f, nil, false)
+}, func(a_f_args__ ...interface{}) interface{} {
+	return a_f_args__[0].(*CljsCoreAgent).send(a_f_args__[1], a_f_args__[2], false)
+})
+
+// Dispatch a potentially blocking action to an agent. Returns the
+// agent immediately. Subsequently, in a separate goroutine, the state of
+// the agent will be set to the value of:
+//
+// (apply action-fn state-of-agent args)
+// @param {...*} var_args
+var Send_off = Fn(2, func(a interface{}, f interface{}) interface{} {
+	return a.(*CljsCoreAgent).send(f, nil, true)
+}, func(a_f_args__ ...interface{}) interface{} {
+	return a_f_args__[0].(*CljsCoreAgent).send(a_f_args__[1], a_f_args__[2], true)
+})
+
+func await(agents interface{}, timeout time.Duration) bool {
+	if !Nil_(Dynamic_("cljs.core/*agent*", X_STAR_agent_STAR_)) {
+		panic(&js.Error{"Can't await in agent action"})
+	}
+	latch := &sync.WaitGroup{}
+	for s := Seq.Arity1IQ(agents); s != nil; s = Next.Arity1IQ(s) {
+		latch.Add(1)
+		a := First.X_invoke_Arity1(s).(*CljsCoreAgent)
+		a.dispatch(&agentAction{agent: a, solo: true, latch: latch})
+	}
+	done := make(chan struct{})
+	go func() {
+		latch.Wait()
+		close(done)
+	}()
+	if timeout < 0 {
+		<-done
+		return true
+	}
+	return derefWithTimeout(done, float64(timeout/time.Millisecond))
+}
+
+// Blocks the current goroutine (indefinitely!) until all actions
+// dispatched thus far, from this goroutine or agent, to the agent(s) have
+// occurred. Throws if an agent is failed.
+// @param {...*} var_args
+var Await = Fn(0, func(agents__ ...interface{}) interface{} {
+	await(agents__[0], -1)
+	return nil
+})
+
+// Blocks the current goroutine until all actions dispatched thus
+// far (from this goroutine or agent) to the agents have occurred, or the
+// timeout (in milliseconds) has elapsed. Returns logical false if
+// returning due to timeout, logical true otherwise.
+// @param {...*} var_args
+var Await_for = Fn(1, func(timeout_ms_agents__ ...interface{}) interface{} {
+	var timeout_ms = timeout_ms_agents__[0]
+	return await(timeout_ms_agents__[1], time.Duration(timeout_ms.(float64))*time.Millisecond)
+})
+
+// Returns the exception thrown during an asynchronous action of the
+// agent if the agent is failed. Returns nil if the agent is not
+// failed.
+var Agent_error = Fn(func(a interface{}) interface{} {
+	return a.(*CljsCoreAgent).error()
+})
+
+// When an agent is failed, changes the agent state to new-state and
+// then un-fails the agent so that sends are allowed again. If
+// a :clear-actions true option is given, any actions queued on the
+// agent that were being held while it was failed will be discarded,
+// otherwise those held actions will proceed. The new-state must pass
+// the validator if any, or restart will throw an exception and the
+// agent will remain failed with its old state and error. Watchers, if
+// any, will NOT be notified of the new state. Throws an exception if
+// the agent is not failed.
+// @param {...*} var_args
+var Restart_agent = Fn(2, func(a interface{}, new_state interface{}) interface{} {
+	a.(*CljsCoreAgent).restart(new_state, false)
+	return new_state
+}, func(a_new_state_options__ ...interface{}) interface{} {
+	var opts = Apply.X_invoke_Arity2(Hash_map, a_new_state_options__[2])
+	a_new_state_options__[0].(*CljsCoreAgent).restart(a_new_state_options__[1], Truth_(Get.X_invoke_Arity2(opts, clearActionsKeyword)))
+	return a_new_state_options__[1]
+})
+
+// Sets the error-handler of agent a to handler-fn. If an action
+// being run by the agent throws an exception or doesn't pass the
+// validator fn, handler-fn will be called with two arguments: the
+// agent and the exception.
+var Set_error_handler_BANG_ = Fn(func(a interface{}, handler_fn interface{}) interface{} {
+	a.(*CljsCoreAgent).setErrorHandler(handler_fn)
+	return nil
+})
+
+// Returns the error-handler of agent a, or nil if there is none.
+// See set-error-handler!
+var Error_handler = Fn(func(a interface{}) interface{} {
+	return a.(*CljsCoreAgent).errorHandler()
+})
+
+// Sets the error-mode of agent a to mode-keyword, which must be
+// either :fail or :continue. If an action being run by the agent
+// throws an exception or doesn't pass the validator fn, an
+// error-handler may be called (see set-error-handler!), after which,
+// if the mode is :continue, the agent will continue as if neither the
+// action that caused the error nor the error itself ever happened.
+//
+// If the mode is :fail, the agent will become failed and will stop
+// accepting new 'send' and 'send-off' actions, and any previously
+// dispatched actions will be held until a 'restart-agent' is
+// performed. Calls to 'restart-agent' will fail.
+var Set_error_mode_BANG_ = Fn(func(a interface{}, mode_keyword interface{}) interface{} {
+	a.(*CljsCoreAgent).setErrorMode(mode_keyword)
+	return nil
+})
+
+// Returns the error-mode of agent a. See set-error-mode!
+var Error_mode = Fn(func(a interface{}) interface{} {
+	return a.(*CljsCoreAgent).errorMode()
+})
+
+// Initiates a shutdown of the goroutine pools that back the agent
+// system. Running and already queued actions will complete, but no new
+// actions will be accepted
+var Shutdown_agents = Fn(func() interface{} {
+	atomic.StoreInt32(&agentsShutdown, 1)
+	return nil
+})
@@ -0,0 +1,485 @@
+// cljs.core.async
+
+// Channels and go blocks backed by real Go channels and goroutines.
+package async
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	"github.com/hraberg/cljs2go/js"
+)
+
+// Buffers offer vals to open channels, called by Put with the channel read locked, see Close.
+type buffer interface {
+	size() int
+	offer(c *CljsCoreAsyncManyToManyChannel, val interface{}) bool
+}
+
+type CljsCoreAsyncFixedBuffer struct {
+	N float64
+}
+
+type CljsCoreAsyncDroppingBuffer struct {
+	N float64
+}
+
+type CljsCoreAsyncSlidingBuffer struct {
+	N float64
+}
+
+func (this *CljsCoreAsyncFixedBuffer) size() int {
+	return int(this.N)
+}
+
+func (this *CljsCoreAsyncFixedBuffer) offer(c *CljsCoreAsyncManyToManyChannel, val interface{}) bool {
+	return c.send(val)
+}
+
+func (this *CljsCoreAsyncDroppingBuffer) size() int {
+	return int(this.N)
+}
+
+func (this *CljsCoreAsyncDroppingBuffer) offer(c *CljsCoreAsyncManyToManyChannel, val interface{}) bool {
+	select {
+	case c.Ch <- val:
+	default:
+	}
+	return true
+}
+
+func (this *CljsCoreAsyncSlidingBuffer) size() int {
+	return int(this.N)
+}
+
+func (this *CljsCoreAsyncSlidingBuffer) offer(c *CljsCoreAsyncManyToManyChannel, val interface{}) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for {
+		select {
+		case c.Ch <- val:
+			return true
+		default:
+			select {
+			case <-c.Ch:
+			default:
+			}
+		}
+	}
+}
+
+// The Go channel is never closed, closing is signalled via Done so pending and future puts can fail instead of panic.
+type CljsCoreAsyncManyToManyChannel struct {
+	Ch   chan interface{}
+	Done chan struct{}
+	Buf  interface{}
+
+	once    sync.Once
+	mutex   sync.Mutex
+	closing sync.RWMutex
+}
+
+func newChannel(buf buffer) *CljsCoreAsyncManyToManyChannel {
+	n := 0
+	if buf != nil {
+		n = buf.size()
+	}
+	return &CljsCoreAsyncManyToManyChannel{Ch: make(chan interface{}, n), Done: make(chan struct{}), Buf: buf}
+}
+
+func (this *CljsCoreAsyncManyToManyChannel) Closed() bool {
+	select {
+	case <-this.Done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Puts hold the read lock of closing from checking that the channel is open until they complete
+// or fail, and Close closes Done, which wakes the pending ones, before it takes the write lock.
+// So once Close returns, every put has either landed or failed, and later puts see it closed.
+func (this *CljsCoreAsyncManyToManyChannel) Close() {
+	this.once.Do(func() {
+		close(this.Done)
+		this.closing.Lock()
+		this.closing.Unlock()
+	})
+}
+
+func (this *CljsCoreAsyncManyToManyChannel) send(val interface{}) bool {
+	select {
+	case this.Ch <- val:
+		return true
+	case <-this.Done:
+		return false
+	}
+}
+
+func (this *CljsCoreAsyncManyToManyChannel) Put(val interface{}) bool {
+	if val == nil {
+		panic(&js.Error{"Can't put nil on channel"})
+	}
+	this.closing.RLock()
+	defer this.closing.RUnlock()
+	if this.Closed() {
+		return false
+	}
+	if buf, ok := this.Buf.(buffer); ok {
+		return buf.offer(this, val)
+	}
+	return this.send(val)
+}
+
+func (this *CljsCoreAsyncManyToManyChannel) drain() interface{} {
+	select {
+	case val := <-this.Ch:
+		return val
+	default:
+		return nil
+	}
+}
+
+func (this *CljsCoreAsyncManyToManyChannel) Take() interface{} {
+	select {
+	case val := <-this.Ch:
+		return val
+	case <-this.Done:
+		return this.drain()
+	}
+}
+
+func (this *CljsCoreAsyncManyToManyChannel) blocking() bool {
+	switch this.Buf.(type) {
+	case *CljsCoreAsyncDroppingBuffer, *CljsCoreAsyncSlidingBuffer:
+		return false
+	default:
+		return true
+	}
+}
+
+func channel(port interface{}) *CljsCoreAsyncManyToManyChannel {
+	if c, ok := port.(*CljsCoreAsyncManyToManyChannel); ok {
+		return c
+	}
+	panic(&js.Error{"Not a channel: " + cljs_core.Pr_str.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{port})).(string)})
+}
+
+func callback(f interface{}, val interface{}) {
+	if !cljs_core.Nil_(f) {
+		f.(cljs_core.CljsCoreIFn).X_invoke_Arity1(val)
+	}
+}
+
+func conveyBindings(f interface{}) interface{} {
+	if cljs_core.Nil_(f) {
+		return f
+	}
+	return cljs_core.Binding_conveyor_fn_(f)
+}
+
+type altsCase struct {
+	port interface{}
+	c    *CljsCoreAsyncManyToManyChannel
+	put  bool
+	val  interface{}
+}
+
+func altsCases(ports interface{}) []altsCase {
+	var cases []altsCase
+	for s := cljs_core.Seq.Arity1IQ(ports); s != nil; s = cljs_core.Next.Arity1IQ(s) {
+		port := cljs_core.First.X_invoke_Arity1(s)
+		if cljs_core.Vector_QMARK_.Arity1IB(port) {
+			c := channel(cljs_core.Nth.X_invoke_Arity2(port, float64(0)))
+			val := cljs_core.Nth.X_invoke_Arity2(port, float64(1))
+			if val == nil {
+				panic(&js.Error{"Can't put nil on channel"})
+			}
+			cases = append(cases, altsCase{c, c, true, val})
+		} else {
+			cases = append(cases, altsCase{port, channel(port), false, nil})
+		}
+	}
+	return cases
+}
+
+// A put on a closed channel only selects Done, the zero Chan disables the send, which could
+// otherwise win against Done while there's buffer space left. Called with the channels put to
+// read locked, see lockPuts.
+func (this altsCase) selectCases() []reflect.SelectCase {
+	done := reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(this.c.Done)}
+	if this.put {
+		send := reflect.SelectCase{Dir: reflect.SelectSend, Send: reflect.ValueOf(&this.val).Elem()}
+		if !this.c.Closed() {
+			send.Chan = reflect.ValueOf(this.c.Ch)
+		}
+		return []reflect.SelectCase{send, done}
+	}
+	return []reflect.SelectCase{{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(this.c.Ch)}, done}
+}
+
+func (this altsCase) result(recv reflect.Value, recvOK bool, done bool) interface{} {
+	var val interface{}
+	switch {
+	case this.put:
+		val = !done
+	case done:
+		val = this.c.drain()
+	case recvOK:
+		val = recv.Interface()
+	}
+	return cljs_core.Vector.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{val, this.port}))
+}
+
+func alts(cases []altsCase, priority bool, hasDefault bool, defaultVal interface{}) interface{} {
+	for _, c := range cases {
+		if c.put && !c.c.blocking() {
+			return c.result(reflect.Value{}, false, !c.c.Put(c.val))
+		}
+	}
+	defer lockPuts(cases)()
+	selectCases := make([]reflect.SelectCase, 0, 2*len(cases)+1)
+	for _, c := range cases {
+		selectCases = append(selectCases, c.selectCases()...)
+	}
+	if priority {
+		for i := range cases {
+			chosen, recv, recvOK := reflect.Select(append(selectCases[2*i:2*i+2:2*i+2], reflect.SelectCase{Dir: reflect.SelectDefault}))
+			if chosen < 2 {
+				return cases[i].result(recv, recvOK, chosen == 1)
+			}
+		}
+	}
+	if hasDefault {
+		selectCases = append(selectCases, reflect.SelectCase{Dir: reflect.SelectDefault})
+	}
+	chosen, recv, recvOK := reflect.Select(selectCases)
+	if chosen == len(cases)*2 {
+		return cljs_core.Vector.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{defaultVal, defaultKeyword}))
+	}
+	return cases[chosen/2].result(recv, recvOK, chosen%2 == 1)
+}
+
+// Read locks the distinct channels put to like Put does, in address order so two alts can't
+// each hold a lock the other waits for while Close waits for both. Returns the unlock.
+func lockPuts(cases []altsCase) func() {
+	var locked []*CljsCoreAsyncManyToManyChannel
+	for _, c := range cases {
+		if c.put {
+			locked = append(locked, c.c)
+		}
+	}
+	sort.Slice(locked, func(i, j int) bool {
+		return reflect.ValueOf(locked[i]).Pointer() < reflect.ValueOf(locked[j]).Pointer()
+	})
+	for i, c := range locked {
+		if i == 0 || c != locked[i-1] {
+			c.closing.RLock()
+		}
+	}
+	return func() {
+		for i, c := range locked {
+			if i == 0 || c != locked[i-1] {
+				c.closing.RUnlock()
+			}
+		}
+	}
+}
+
+var defaultKeyword = cljs_core.Keyword.X_invoke_Arity1("default")
+var priorityKeyword = cljs_core.Keyword.X_invoke_Arity1("priority")
+
+func bufOrN(buf_or_n interface{}) buffer {
+	switch b := buf_or_n.(type) {
+	case nil:
+		return nil
+	case float64:
+		if b == 0 {
+			return nil
+		}
+		return &CljsCoreAsyncFixedBuffer{b}
+	case buffer:
+		return b
+	default:
+		panic(&js.Error{"Not a buffer or size: " + cljs_core.Pr_str.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{buf_or_n})).(string)})
+	}
+}
+
+// Returns a fixed buffer of size n. When full, puts will block/park.
+var Buffer = cljs_core.Fn(func(n interface{}) interface{} {
+	return &CljsCoreAsyncFixedBuffer{n.(float64)}
+})
+
+// Returns a buffer of size n. When full, puts will complete but
+// val will be dropped (no transfer).
+var Dropping_buffer = cljs_core.Fn(func(n interface{}) interface{} {
+	return &CljsCoreAsyncDroppingBuffer{n.(float64)}
+})
+
+// Returns a buffer of size n. When full, puts will complete, and be
+// buffered, but oldest elements in buffer will be dropped (not
+// transferred).
+var Sliding_buffer = cljs_core.Fn(func(n interface{}) interface{} {
+	return &CljsCoreAsyncSlidingBuffer{n.(float64)}
+})
+
+// Returns true if a channel created with buff will never block. That is to say,
+// puts into this buffer will never cause the buffer to be full.
+var Unblocking_buffer_QMARK_ = cljs_core.Fn(func(buff interface{}) bool {
+	switch buff.(type) {
+	case *CljsCoreAsyncDroppingBuffer, *CljsCoreAsyncSlidingBuffer:
+		return true
+	default:
+		return false
+	}
+})
+
+// Creates a channel with an optional buffer. If buf-or-n is a number,
+// will create and use a fixed buffer of that size.
+var Chan = cljs_core.Fn(func() interface{} {
+	return newChannel(nil)
+}, func(buf_or_n interface{}) interface{} {
+	return newChannel(bufOrN(buf_or_n))
+})
+
+// Returns a channel that will close after msecs
+var Timeout = cljs_core.Fn(func(msecs interface{}) interface{} {
+	c := newChannel(nil)
+	time.AfterFunc(time.Duration(msecs.(float64))*time.Millisecond, c.Close)
+	return c
+})
+
+// Closes a channel. The channel will no longer accept any puts (they
+// will be ignored). Data in the channel remains available for taking, until
+// exhausted, after which takes will return nil. If there are any
+// pending takes, they will be dispatched with nil. Closing a closed
+// channel is a no-op. Returns nil.
+var Close_BANG_ = cljs_core.Fn(func(port interface{}) interface{} {
+	channel(port).Close()
+	return nil
+})
+
+// takes a val from port. Will return nil if closed. Will block
+// if nothing is available.
+var X_LT__BANG__BANG_ = cljs_core.Fn(func(port interface{}) interface{} {
+	return channel(port).Take()
+})
+
+// puts a val into port. nil values are not allowed. Will block if no
+// buffer space is available. Returns true unless port is already closed.
+var X_GT__BANG__BANG_ = cljs_core.Fn(func(port interface{}, val interface{}) interface{} {
+	return channel(port).Put(val)
+})
+
+// takes a val from port. Must be called inside a (go ...) block. Will
+// return nil if closed. Will park if nothing is available.
+// Go blocks run on their own goroutine, so parking is blocking.
+var X_LT__BANG_ = X_LT__BANG__BANG_
+
+// puts a val into port. nil values are not allowed. Must be called
+// inside a (go ...) block. Will park if no buffer space is available.
+// Returns true unless port is already closed.
+var X_GT__BANG_ = X_GT__BANG__BANG_
+
+// Asynchronously takes a val from port, passing to fn1. Will pass nil
+// if closed. Returns nil.
+var Take_BANG_ = cljs_core.Fn(func(port interface{}, fn1 interface{}) interface{} {
+	c, fn1 := channel(port), conveyBindings(fn1)
+	go func() {
+		callback(fn1, c.Take())
+	}()
+	return nil
+})
+
+// Asynchronously puts a val into port, calling fn1 (if supplied) when
+// complete, passing false iff port is already closed. nil values are
+// not allowed. Returns true unless port is already closed.
+var Put_BANG_ = cljs_core.Fn(func(port interface{}, val interface{}) interface{} {
+	return put(channel(port), val, nil)
+}, func(port interface{}, val interface{}, fn1 interface{}) interface{} {
+	return put(channel(port), val, fn1)
+})
+
+func put(c *CljsCoreAsyncManyToManyChannel, val interface{}, fn1 interface{}) bool {
+	if val == nil {
+		panic(&js.Error{"Can't put nil on channel"})
+	}
+	if c.Closed() {
+		callback(fn1, false)
+		return false
+	}
+	fn1 = conveyBindings(fn1)
+	go func() {
+		callback(fn1, c.Put(val))
+	}()
+	return true
+}
+
+// Completes at most one of several channel operations. ports is a
+// vector of channel endpoints, which can be either a channel to take
+// from or a vector of [channel-to-put-to val-to-put], in any
+// combination. Blocks until one of the operations completes, and
+// returns a vector of [val port] of the completed operation. If
+// :priority true is supplied the ports are tried in order, and if
+// :default val is supplied and no operation is immediately ready,
+// [val :default] is returned instead.
+var Alts_BANG__BANG_ = cljs_core.Fn(1, func(ports interface{}) interface{} {
+	return alts(altsCases(ports), false, false, nil)
+}, func(ports_opts__ ...interface{}) interface{} {
+	var ports = ports_opts__[0]
+	var opts = cljs_core.Apply.X_invoke_Arity2(cljs_core.Hash_map, ports_opts__[1])
+	return alts(altsCases(ports), cljs_core.Truth_(cljs_core.Get.X_invoke_Arity2(opts, priorityKeyword)),
+		cljs_core.Contains_QMARK_.X_invoke_Arity2(opts, defaultKeyword).(bool), cljs_core.Get.X_invoke_Arity2(opts, defaultKeyword))
+})
+
+// Completes at most one of several channel operations. Must be called
+// inside a (go ...) block. See alts!!.
+var Alts_BANG_ = Alts_BANG__BANG_
+
+// Called with the error a go block throws, after its channel has been
+// closed. When nil, the default, the error is printed with *print-fn*.
+var X_STAR_go_error_handler_STAR_ interface{}
+
+func reportGoError(err interface{}) {
+	defer func() { recover() }()
+	if handler := cljs_core.Dynamic_("cljs.core.async/*go-error-handler*", X_STAR_go_error_handler_STAR_); !cljs_core.Nil_(handler) {
+		handler.(cljs_core.CljsCoreIFn).X_invoke_Arity1(err)
+		return
+	}
+	var message string
+	if e, ok := err.(error); ok {
+		message = e.Error()
+	} else {
+		message = cljs_core.Pr_str.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{err})).(string)
+	}
+	cljs_core.Dynamic_("cljs.core/*print-fn*", cljs_core.X_STAR_print_fn_STAR_).(cljs_core.CljsCoreIFn).X_invoke_Arity1("Exception in go block: " + message + "\n")
+}
+
+// Runs f on a new goroutine, returning immediately to the calling
+// thread. Returns a channel which will receive the result of f when
+// completed, and is then closed. This is the runtime for the go macro.
+// If f throws, the channel is closed and the error is passed to
+// *go-error-handler*.
+var Go_STAR_ = cljs_core.Fn(func(f interface{}) interface{} {
+	c, f := newChannel(&CljsCoreAsyncFixedBuffer{1}), cljs_core.Binding_conveyor_fn_(f)
+	report := cljs_core.Binding_conveyor_fn_(cljs_core.Fn(func(err interface{}) interface{} {
+		reportGoError(err)
+		return nil
+	}))
+	go func() {
+		defer func() {
+			err := recover()
+			c.Close()
+			if err != nil {
+				report.X_invoke_Arity1(err)
+			}
+		}()
+		if val := f.(cljs_core.CljsCoreIFn).X_invoke_Arity0(); val != nil {
+			c.Put(val)
+		}
+	}()
+	return c
+})
@@ -0,0 +1,170 @@
+package async
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+import (
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	"github.com/hraberg/cljs2go/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func vector(xs ...interface{}) interface{} {
+	return cljs_core.Vector.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1(xs))
+}
+
+func Test_Chan(t *testing.T) {
+	c := Chan.X_invoke_Arity0()
+	go X_GT__BANG__BANG_.X_invoke_Arity2(c, "Hello")
+	assert.Equal(t, "Hello", X_LT__BANG__BANG_.X_invoke_Arity1(c))
+
+	c = Chan.X_invoke_Arity1(2.0)
+	assert.Equal(t, true, X_GT__BANG__BANG_.X_invoke_Arity2(c, 1.0))
+	assert.Equal(t, true, X_GT__BANG__BANG_.X_invoke_Arity2(c, 2.0))
+	Close_BANG_.X_invoke_Arity1(c)
+	assert.Equal(t, false, X_GT__BANG__BANG_.X_invoke_Arity2(c, 3.0))
+	assert.Equal(t, 1.0, X_LT__BANG__BANG_.X_invoke_Arity1(c))
+	assert.Equal(t, 2.0, X_LT__BANG__BANG_.X_invoke_Arity1(c))
+	assert.Nil(t, X_LT__BANG__BANG_.X_invoke_Arity1(c))
+
+	PanicsWith(t, "Can't put nil on channel", func() { X_GT__BANG__BANG_.X_invoke_Arity2(Chan.X_invoke_Arity0(), nil) })
+}
+
+func Test_Buffers(t *testing.T) {
+	dropping := Chan.X_invoke_Arity1(Dropping_buffer.X_invoke_Arity1(2.0))
+	sliding := Chan.X_invoke_Arity1(Sliding_buffer.X_invoke_Arity1(2.0))
+	for _, x := range []float64{1, 2, 3} {
+		assert.Equal(t, true, X_GT__BANG__BANG_.X_invoke_Arity2(dropping, x))
+		assert.Equal(t, true, X_GT__BANG__BANG_.X_invoke_Arity2(sliding, x))
+	}
+	assert.Equal(t, 1.0, X_LT__BANG__BANG_.X_invoke_Arity1(dropping))
+	assert.Equal(t, 2.0, X_LT__BANG__BANG_.X_invoke_Arity1(dropping))
+	assert.Equal(t, 2.0, X_LT__BANG__BANG_.X_invoke_Arity1(sliding))
+	assert.Equal(t, 3.0, X_LT__BANG__BANG_.X_invoke_Arity1(sliding))
+
+	assert.True(t, Unblocking_buffer_QMARK_.Arity1IB(Sliding_buffer.X_invoke_Arity1(1.0)))
+	assert.False(t, Unblocking_buffer_QMARK_.Arity1IB(Buffer.X_invoke_Arity1(1.0)))
+}
+
+func Test_PutRacingClose(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		for _, buf := range []buffer{&CljsCoreAsyncFixedBuffer{1}, &CljsCoreAsyncDroppingBuffer{1}, &CljsCoreAsyncSlidingBuffer{1}} {
+			c, put := newChannel(buf), make(chan bool)
+			if i%2 == 0 {
+				go func() { put <- c.Put(1.0) }()
+			} else {
+				go func() { put <- cljs_core.Truth_(cljs_core.First.X_invoke_Arity1(Alts_BANG__BANG_.X_invoke_Arity1(vector(vector(c, 1.0))))) }()
+			}
+			Close_BANG_.X_invoke_Arity1(c)
+			landed := len(c.Ch)
+			assert.Equal(t, landed == 1, <-put)
+			assert.Equal(t, landed, len(c.Ch))
+		}
+	}
+}
+
+func Test_PutTakeCallbacks(t *testing.T) {
+	c := Chan.X_invoke_Arity0()
+	taken := make(chan interface{})
+	Take_BANG_.X_invoke_Arity2(c, cljs_core.Fn(func(x interface{}) interface{} {
+		taken <- x
+		return nil
+	}))
+	put := make(chan interface{})
+	assert.Equal(t, true, Put_BANG_.X_invoke_Arity3(c, "Hello", cljs_core.Fn(func(x interface{}) interface{} {
+		put <- x
+		return nil
+	})))
+	assert.Equal(t, "Hello", <-taken)
+	assert.Equal(t, true, <-put)
+
+	Close_BANG_.X_invoke_Arity1(c)
+	assert.Equal(t, false, Put_BANG_.X_invoke_Arity2(c, "World"))
+}
+
+func Test_Alts(t *testing.T) {
+	a, b := Chan.X_invoke_Arity1(1.0), Chan.X_invoke_Arity1(1.0)
+	X_GT__BANG__BANG_.X_invoke_Arity2(b, "b")
+	assert.Equal(t, vector("b", b), Alts_BANG__BANG_.X_invoke_Arity1(vector(a, b)))
+	assert.Equal(t, vector(true, a), Alts_BANG__BANG_.X_invoke_Arity1(vector(vector(a, "a"))))
+	assert.Equal(t, vector("a", a), Alts_BANG__BANG_.X_invoke_ArityVariadic(vector(b, a),
+		cljs_core.Array_seq.X_invoke_Arity1([]interface{}{priorityKeyword, true})))
+	assert.Equal(t, vector("none", defaultKeyword), Alts_BANG__BANG_.X_invoke_ArityVariadic(vector(a, b),
+		cljs_core.Array_seq.X_invoke_Arity1([]interface{}{defaultKeyword, "none"})))
+
+	closed := Chan.X_invoke_Arity1(10.0)
+	Close_BANG_.X_invoke_Arity1(closed)
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, vector(false, closed), Alts_BANG__BANG_.X_invoke_Arity1(vector(vector(closed, "x"))))
+	}
+	assert.Nil(t, X_LT__BANG__BANG_.X_invoke_Arity1(closed))
+
+	start := time.Now()
+	to := Timeout.X_invoke_Arity1(10.0)
+	assert.Equal(t, vector(nil, to), Alts_BANG__BANG_.X_invoke_Arity1(vector(a, to)))
+	assert.True(t, time.Since(start) >= 10*time.Millisecond)
+}
+
+func Test_Go(t *testing.T) {
+	in, out := Chan.X_invoke_Arity0(), Chan.X_invoke_Arity0()
+	worker := Go_STAR_.X_invoke_Arity1(cljs_core.Fn(func() interface{} {
+		for {
+			x := X_LT__BANG_.X_invoke_Arity1(in)
+			if x == nil {
+				return "done"
+			}
+			X_GT__BANG_.X_invoke_Arity2(out, x.(float64)*2)
+		}
+	}))
+	go func() {
+		for _, x := range []float64{1, 2, 3} {
+			X_GT__BANG__BANG_.X_invoke_Arity2(in, x)
+		}
+		Close_BANG_.X_invoke_Arity1(in)
+	}()
+	for _, x := range []float64{2, 4, 6} {
+		assert.Equal(t, x, X_LT__BANG__BANG_.X_invoke_Arity1(out))
+	}
+	assert.Equal(t, "done", X_LT__BANG__BANG_.X_invoke_Arity1(worker))
+	assert.Nil(t, X_LT__BANG__BANG_.X_invoke_Arity1(worker))
+}
+
+func Test_GoThrows(t *testing.T) {
+	errors := make(chan interface{}, 1)
+	handler := cljs_core.Fn(func(err interface{}) interface{} {
+		errors <- err
+		return nil
+	})
+	bindings := cljs_core.Hash_map.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"cljs.core.async/*go-error-handler*", handler}))
+	worker := cljs_core.With_bindings_STAR_.X_invoke_Arity2(bindings, cljs_core.Fn(func() interface{} {
+		return Go_STAR_.X_invoke_Arity1(cljs_core.Fn(func() interface{} {
+			panic("boom")
+		}))
+	}))
+	assert.Nil(t, X_LT__BANG__BANG_.X_invoke_Arity1(worker))
+	assert.Equal(t, "boom", <-errors)
+
+	printed := make(chan interface{}, 1)
+	bindings = cljs_core.Hash_map.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"cljs.core/*print-fn*", cljs_core.Fn(func(s interface{}) interface{} {
+		printed <- s
+		return nil
+	})}))
+	worker = cljs_core.With_bindings_STAR_.X_invoke_Arity2(bindings, cljs_core.Fn(func() interface{} {
+		return Go_STAR_.X_invoke_Arity1(cljs_core.Fn(func() interface{} {
+			panic(&js.Error{"boom"})
+		}))
+	}))
+	assert.Nil(t, X_LT__BANG__BANG_.X_invoke_Arity1(worker))
+	assert.Equal(t, "Exception in go block: boom\n", <-printed)
+}
+
+func PanicsWith(t *testing.T, message string, f assert.PanicTestFunc) {
+	assert.Equal(t, message, func() (message string) {
+		defer func() { message = fmt.Sprint(recover()) }()
+		f()
+		assert.Fail(t, "should panic")
+		return
+	}())
+}
@@ -0,0 +1,344 @@
+package core
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/hraberg/cljs2go/goog"
+	"github.com/hraberg/cljs2go/js"
+)
+
+// Atom replaces the deftype in core.cljs so it can be shared between goroutines.
+// The state is swapped lock-free, the validator and watches are guarded by the iref.
+
+type iref struct {
+	Meta      interface{}
+	Validator interface{}
+	Watches   interface{}
+	mutex     sync.RWMutex
+}
+
+func (this *iref) validator() interface{} {
+	this.mutex.RLock()
+	defer this.mutex.RUnlock()
+	return this.Validator
+}
+
+func (this *iref) setValidator(validator interface{}) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.Validator = validator
+}
+
+func (this *iref) validate(validator interface{}, newval interface{}) {
+	if !Nil_(validator) && !Truth_(validator.(CljsCoreIFn).X_invoke_Arity1(newval)) {
+		panic(&js.Error{strings.Join([]string{"Assert failed: ", "Validator rejected reference state", "\n", "(validate new-value)"}, ``)})
+	}
+}
+
+func (this *iref) watches() interface{} {
+	this.mutex.RLock()
+	defer this.mutex.RUnlock()
+	return this.Watches
+}
+
+func (this *iref) addWatch(key interface{}, f interface{}) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.Watches = Assoc.X_invoke_Arity3(this.Watches, key, f)
+}
+
+func (this *iref) removeWatch(key interface{}) interface{} {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.Watches = Dissoc.X_invoke_Arity2(this.Watches, key)
+	return this.Watches
+}
+
+func (this *iref) notifyWatches(ref interface{}, oldval interface{}, newval interface{}) {
+	for s := Seq.Arity1IQ(this.watches()); s != nil; s = Next.Arity1IQ(s) {
+		var e = First.X_invoke_Arity1(s)
+		Nth.X_invoke_Arity2(e, float64(1)).(CljsCoreIFn).X_invoke_Arity4(Nth.X_invoke_Arity2(e, float64(0)), ref, oldval, newval)
+	}
+}
+
+type validated interface {
+	validator() interface{}
+	setValidator(validator interface{})
+	validate(validator interface{}, newval interface{})
+	X_deref_Arity1() interface{}
+}
+
+type atomState struct {
+	val interface{}
+}
+
+type CljsCoreAtom struct {
+	state unsafe.Pointer
+	iref
+}
+
+func (this *CljsCoreAtom) load() *atomState {
+	return (*atomState)(atomic.LoadPointer(&this.state))
+}
+
+func (this *CljsCoreAtom) compareAndSwap(old *atomState, newval interface{}) bool {
+	return atomic.CompareAndSwapPointer(&this.state, unsafe.Pointer(old), unsafe.Pointer(&atomState{newval}))
+}
+
+func (this *CljsCoreAtom) swap(f func(interface{}) interface{}) (oldval interface{}, newval interface{}) {
+	for {
+		var old = this.load()
+		newval = f(old.val)
+		this.validate(this.validator(), newval)
+		if this.compareAndSwap(old, newval) {
+			this.notifyWatches(this, old.val, newval)
+			return old.val, newval
+		}
+	}
+}
+
+func (this *CljsCoreAtom) reset(newval interface{}) (oldval interface{}) {
+	this.validate(this.validator(), newval)
+	oldval = (*atomState)(atomic.SwapPointer(&this.state, unsafe.Pointer(&atomState{newval}))).val
+	this.notifyWatches(this, oldval, newval)
+	return oldval
+}
+
+func (this *CljsCoreAtom) compareAndSet(oldval interface{}, newval interface{}) bool {
+	for {
+		var old = this.load()
+		if !X_EQ_.Arity2IIB(old.val, oldval) {
+			return false
+		}
+		this.validate(this.validator(), newval)
+		if this.compareAndSwap(old, newval) {
+			this.notifyWatches(this, old.val, newval)
+			return true
+		}
+	}
+}
+
+func (_ *CljsCoreAtom) CljsCoreIHash__() {}
+func (this *CljsCoreAtom) X_hash_Arity1() interface{} {
+	return Native_invoke_func.X_invoke_Arity2(goog.GetUid, []interface{}{this})
+}
+
+func (_ *CljsCoreAtom) CljsCoreIWatchable__() {}
+func (this *CljsCoreAtom) X_notify_watches_Arity3(oldval interface{}, newval interface{}) interface{} {
+	this.notifyWatches(this, oldval, newval)
+	return nil
+}
+
+func (this *CljsCoreAtom) X_add_watch_Arity3(key interface{}, f interface{}) interface{} {
+	this.addWatch(key, f)
+	return this
+}
+
+func (this *CljsCoreAtom) X_remove_watch_Arity2(key interface{}) interface{} {
+	return this.removeWatch(key)
+}
+
+func (_ *CljsCoreAtom) CljsCoreIMeta__() {}
+func (this *CljsCoreAtom) X_meta_Arity1() interface{} {
+	return this.Meta
+}
+
+func (_ *CljsCoreAtom) CljsCoreIDeref__() {}
+func (this *CljsCoreAtom) X_deref_Arity1() interface{} {
+	return this.load().val
+}
+
+func (_ *CljsCoreAtom) CljsCoreIEquiv__() {}
+func (this *CljsCoreAtom) X_equiv_Arity2(other interface{}) bool {
+	return this == other
+}
+
+func (_ *CljsCoreAtom) CljsCoreIAtom__()  {}
+func (_ *CljsCoreAtom) CljsCoreObject__() {}
+func (this *CljsCoreAtom) Equiv(other interface{}) bool {
+	return this.X_equiv_Arity2(other)
+}
+
+func (_ *CljsCoreAtom) CljsCoreIPrintWithWriter__() {}
+func (a *CljsCoreAtom) X_pr_writer_Arity3(writer interface{}, opts interface{}) interface{} {
+	Decorate_(writer).(CljsCoreIWriter).X_write_Arity2("#<Atom: ")
+	Pr_writer.X_invoke_Arity3(a.X_deref_Arity1(), writer, opts)
+	return Decorate_(writer).(CljsCoreIWriter).X_write_Arity2(">")
+}
+
+func newAtom(state interface{}, meta interface{}, validator interface{}, watches interface{}) *CljsCoreAtom {
+	return &CljsCoreAtom{unsafe.Pointer(&atomState{state}), iref{Meta: meta, Validator: validator, Watches: watches}}
+}
+
+func atomOrNil(a interface{}) *CljsCoreAtom {
+	if a, ok := a.(*CljsCoreAtom); ok {
+		return a
+	}
+	return nil
+}
+
+// Swaps the value of a, which isn't a CljsCoreAtom, through its ISwap implementation. Returns the
+// value f was last called with, which is the one the implementation swapped out.
+func swapVals(a interface{}, f func(interface{}) interface{}) (oldval interface{}, newval interface{}) {
+	newval = Decorate_(a).(CljsCoreISwap).X_swap_BANG__Arity2(Fn(func(x interface{}) interface{} {
+		oldval = x
+		return f(x)
+	}))
+	return oldval, newval
+}
+
+func vals(oldval interface{}, newval interface{}) *CljsCorePersistentVector {
+	return &CljsCorePersistentVector{nil, float64(2), float64(5), CljsCorePersistentVector_EMPTY_NODE, []interface{}{oldval, newval}, nil}
+}
+
+var X__GT_Atom = Fn(func(state interface{}, meta interface{}, validator interface{}, watches interface{}) interface{} {
+	return newAtom(state, meta, validator, watches)
+})
+
+// Creates and returns an Atom with an initial value of x and zero or
+// more options (in any order):
+//
+// :meta metadata-map
+//
+// :validator validate-fn
+//
+// If metadata-map is supplied, it will be come the metadata on the
+// atom. validate-fn must be nil or a side-effect-free fn of one
+// argument, which will be passed the intended new state on any state
+// change. If the new state is unacceptable, the validate-fn should
+// return false or throw an Error.  If either of these error conditions
+// occur, then the value of the atom will not change.
+// @param {...*} var_args
+var Atom = Fn(1, func(x interface{}) interface{} {
+	return newAtom(x, nil, nil, nil)
+}, func(x_p__ ...interface{}) interface{} {
+	var x = x_p__[0]
+	var opts = Apply.X_invoke_Arity2(Hash_map, x_p__[1])
+	return newAtom(x, Get.X_invoke_Arity2(opts, metaKeyword), Get.X_invoke_Arity2(opts, validatorKeyword), nil)
+})
+
+var validatorKeyword = &CljsCoreKeyword{Ns: nil, Name: "validator", Fqn: "validator", X_hash: float64(-1966190681)}
+var metaKeyword = &CljsCoreKeyword{Ns: nil, Name: "meta", Fqn: "meta", X_hash: float64(1499536964)}
+
+// Sets the value of atom to newval without regard for the
+// current value. Returns newval.
+var Reset_BANG_ = Fn(func(a interface{}, new_value interface{}) interface{} {
+	if a := atomOrNil(a); a != nil {
+		a.reset(new_value)
+		return new_value
+	}
+	return Decorate_(a).(CljsCoreIReset).X_reset_BANG__Arity2(new_value)
+})
+
+// Sets the value of atom to newval. Returns [old new], the value of the
+// atom before and after the reset.
+var Reset_vals_BANG_ = Fn(func(a interface{}, new_value interface{}) interface{} {
+	if a := atomOrNil(a); a != nil {
+		return vals(a.reset(new_value), new_value)
+	}
+	if _, ok := Decorate_(a).(CljsCoreISwap); ok {
+		oldval, _ := swapVals(a, func(interface{}) interface{} { return new_value })
+		return vals(oldval, new_value)
+	}
+	oldval := Decorate_(a).(CljsCoreIDeref).X_deref_Arity1()
+	return vals(oldval, Decorate_(a).(CljsCoreIReset).X_reset_BANG__Arity2(new_value))
+})
+
+// Atomically swaps the value of atom to be:
+// (apply f current-value-of-atom args). Note that f may be called
+// multiple times, and thus should be free of side effects.  Returns
+// the value that was swapped in.
+// @param {...*} var_args
+var Swap_BANG_ = Fn(4, func(a interface{}, f interface{}) interface{} {
+	if a := atomOrNil(a); a != nil {
+		_, newval := a.swap(func(x interface{}) interface{} { return f.(CljsCoreIFn).X_invoke_Arity1(x) })
+		return newval
+	}
+	return Decorate_(a).(CljsCoreISwap).X_swap_BANG__Arity2(f)
+}, func(a interface{}, f interface{}, x interface{}) interface{} {
+	if a := atomOrNil(a); a != nil {
+		_, newval := a.swap(func(s interface{}) interface{} { return f.(CljsCoreIFn).X_invoke_Arity2(s, x) })
+		return newval
+	}
+	return Decorate_(a).(CljsCoreISwap).X_swap_BANG__Arity3(f, x)
+}, func(a interface{}, f interface{}, x interface{}, y interface{}) interface{} {
+	if a := atomOrNil(a); a != nil {
+		_, newval := a.swap(func(s interface{}) interface{} { return f.(CljsCoreIFn).X_invoke_Arity3(s, x, y) })
+		return newval
+	}
+	return Decorate_(a).(CljsCoreISwap).X_swap_BANG__Arity4(f, x, y)
+}, func(a_f_x_y_more__ ...interface{}) interface{} {
+	var a = a_f_x_y_more__[0]
+	var f = a_f_x_y_more__[1]
+	var x = a_f_x_y_more__[2]
+	var y = a_f_x_y_more__[3]
+	var more = Seq.Arity1IQ(a_f_x_y_more__[4])
+	if a := atomOrNil(a); a != nil {
+		_, newval := a.swap(func(s interface{}) interface{} { return Apply.X_invoke_Arity5(f, s, x, y, more) })
+		return newval
+	}
+	return Decorate_(a).(CljsCoreISwap).X_swap_BANG__Arity5(f, x, y, more)
+})
+
+// Atomically swaps the value of atom to be:
+// (apply f current-value-of-atom args). Note that f may be called
+// multiple times, and thus should be free of side effects.
+// Returns [old new], the value of the atom before and after the swap.
+// @param {...*} var_args
+var Swap_vals_BANG_ = Fn(2, func(a interface{}, f interface{}) interface{} {
+	swap := func(x interface{}) interface{} { return f.(CljsCoreIFn).X_invoke_Arity1(x) }
+	if a := atomOrNil(a); a != nil {
+		return vals(a.swap(swap))
+	}
+	return vals(swapVals(a, swap))
+}, func(a_f_args__ ...interface{}) interface{} {
+	var a = a_f_args__[0]
+	var f = a_f_args__[1]
+	var args = Seq.Arity1IQ(a_f_args__[2])
+	swap := func(x interface{}) interface{} { return Apply.X_invoke_Arity3(f, x, args) }
+	if a := atomOrNil(a); a != nil {
+		return vals(a.swap(swap))
+	}
+	return vals(swapVals(a, swap))
+})
+
+// Atomically sets the value of atom to newval if and only if the
+// current value of the atom is equal to oldval, as by =. Returns true if
+// set happened, else false.
+var Compare_and_set_BANG_ = Fn(func(a interface{}, oldval interface{}, newval interface{}) interface{} {
+	if a := atomOrNil(a); a != nil {
+		return a.compareAndSet(oldval, newval)
+	}
+	if X_EQ_.Arity2IIB(Decorate_(a).(CljsCoreIDeref).X_deref_Arity1(), oldval) {
+		Decorate_(a).(CljsCoreIReset).X_reset_BANG__Arity2(newval)
+		return true
+	}
+	return false
+})
+
+// Sets the validator-fn for an atom. validator-fn must be nil or a
+// side-effect-free fn of one argument, which will be passed the intended
+// new state on any state change. If the new state is unacceptable, the
+// validator-fn should return false or throw an Error. If the current state
+// is not acceptable to the new validator, an Error will be thrown and the
+// validator will not be changed.
+var Set_validator_BANG_ = Fn(func(iref interface{}, val interface{}) interface{} {
+	if r, ok := iref.(validated); ok {
+		r.validate(val, r.X_deref_Arity1())
+		r.setValidator(val)
+		return val
+	}
+	Native_set_instance_field.X_invoke_Arity3(iref, "Validator", val)
+	return val
+})
+
+// Gets the validator-fn for a var/ref/agent/atom.
+var Get_validator = Fn(func(iref interface{}) interface{} {
+	if r, ok := iref.(validated); ok {
+		return r.validator()
+	}
+	return Native_get_instance_field.X_invoke_Arity2(iref, "Validator")
+})
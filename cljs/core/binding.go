@@ -0,0 +1,349 @@
+package core
+
+import (
+	"bytes"
+	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hraberg/cljs2go/js"
+)
+
+// Dynamic var bindings are kept per goroutine as a stack of frames, keyed by the goroutine id.
+// Each frame holds all bindings in effect, keyed by the fully qualified name of the var.
+// Reads of dynamic vars go through Dynamic_, which falls back to the root value when unbound.
+//
+// Finding the goroutine id means calling runtime.Stack and parsing the id from its first line.
+// Each var counts the goroutines binding it, so a read of a var bound nowhere costs a map lookup
+// and an atomic load, and returns the root without looking up a frame. A read of a var bound in
+// any goroutine looks up the id of the reading goroutine, which takes several microseconds, more
+// the deeper its stack, hundreds of times the cost of an unbound read, see Benchmark_DynamicBound.
+// Code reading a bound var in a hot loop should read it once into a local. The current transaction
+// of the STM is looked up by goroutine id as well, but only while any transaction is running.
+//
+// Frames left behind by goroutines that exit without popping them are swept once their number
+// has doubled since the last sweep. Sweeping dumps the stacks of all goroutines, which is done
+// outside the lock guarding the frames.
+
+type threadBinding struct {
+	goroutine int64
+	val       atomic.Value
+}
+
+type boxed struct {
+	val interface{}
+}
+
+func (b *threadBinding) get() interface{} {
+	return b.val.Load().(boxed).val
+}
+
+func (b *threadBinding) set(val interface{}) {
+	b.val.Store(boxed{val})
+}
+
+type bindingFrame struct {
+	bindings map[string]*threadBinding
+	prev     *bindingFrame
+}
+
+var bindingFrames = struct {
+	sync.Mutex
+	frames    sync.Map
+	bound     int
+	sweepAt   int
+	sweeping  bool
+	boundVars sync.Map
+}{sweepAt: 64}
+
+var boundGoroutines int64
+
+func goroutineId() int64 {
+	var buf [32]byte
+	n := runtime.Stack(buf[:], false)
+	return parseGoroutineId(buf[len("goroutine "):n])
+}
+
+func parseGoroutineId(buf []byte) int64 {
+	id := int64(0)
+	for _, b := range buf {
+		if b < '0' || b > '9' {
+			break
+		}
+		id = id*10 + int64(b-'0')
+	}
+	return id
+}
+
+func liveGoroutines() map[int64]bool {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+	live := map[int64]bool{}
+	for _, line := range bytes.Split(buf, []byte("\n")) {
+		if bytes.HasPrefix(line, []byte("goroutine ")) {
+			live[parseGoroutineId(line[len("goroutine "):])] = true
+		}
+	}
+	return live
+}
+
+func varBound(name string) bool {
+	n, ok := bindingFrames.boundVars.Load(name)
+	return ok && atomic.LoadInt64(n.(*int64)) > 0
+}
+
+func countBindings(frame *bindingFrame, other *bindingFrame, delta int64) {
+	if frame == nil {
+		return
+	}
+	for k := range frame.bindings {
+		if other != nil {
+			if _, ok := other.bindings[k]; ok {
+				continue
+			}
+		}
+		n, _ := bindingFrames.boundVars.LoadOrStore(k, new(int64))
+		atomic.AddInt64(n.(*int64), delta)
+	}
+}
+
+func currentFrame() *bindingFrame {
+	if atomic.LoadInt64(&boundGoroutines) == 0 {
+		return nil
+	}
+	if frame, ok := bindingFrames.frames.Load(goroutineId()); ok {
+		return frame.(*bindingFrame)
+	}
+	return nil
+}
+
+// Must be called with bindingFrames locked.
+func replaceFrame(id int64, frame *bindingFrame) {
+	var prev *bindingFrame
+	if f, ok := bindingFrames.frames.Load(id); ok {
+		prev = f.(*bindingFrame)
+	}
+	countBindings(frame, prev, 1)
+	countBindings(prev, frame, -1)
+	switch {
+	case frame == nil && prev != nil:
+		bindingFrames.frames.Delete(id)
+		bindingFrames.bound--
+		atomic.AddInt64(&boundGoroutines, -1)
+	case frame != nil:
+		if prev == nil {
+			bindingFrames.bound++
+			atomic.AddInt64(&boundGoroutines, 1)
+		}
+		bindingFrames.frames.Store(id, frame)
+	}
+}
+
+// Removes the frames left behind by goroutines that have exited. The dump of all goroutine stacks
+// is taken without holding the lock. Goroutine ids aren't reused, so a frame that existed before the
+// dump and whose goroutine is missing from it is stale.
+func sweepFrames() {
+	var ids []int64
+	bindingFrames.frames.Range(func(id, _ interface{}) bool {
+		ids = append(ids, id.(int64))
+		return true
+	})
+	live := liveGoroutines()
+	bindingFrames.Lock()
+	defer bindingFrames.Unlock()
+	for _, id := range ids {
+		if !live[id] {
+			replaceFrame(id, nil)
+		}
+	}
+	bindingFrames.sweeping = false
+	bindingFrames.sweepAt = 2 * bindingFrames.bound
+	if bindingFrames.sweepAt < 64 {
+		bindingFrames.sweepAt = 64
+	}
+}
+
+func setCurrentFrame(frame *bindingFrame) {
+	id := goroutineId()
+	bindingFrames.Lock()
+	replaceFrame(id, frame)
+	sweep := bindingFrames.bound >= bindingFrames.sweepAt && !bindingFrames.sweeping
+	if sweep {
+		bindingFrames.sweeping = true
+	}
+	bindingFrames.Unlock()
+	if sweep {
+		sweepFrames()
+	}
+}
+
+func varName(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case *CljsCoreSymbol:
+		return v.Str.(string)
+	case *CljsCoreVar:
+		return v.Sym.(*CljsCoreSymbol).Str.(string)
+	}
+	panic(&js.Error{"Not a var: " + Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{v})).(string)})
+}
+
+func pushFrame(bindings interface{}) {
+	id, prev := goroutineId(), currentFrame()
+	frame := &bindingFrame{map[string]*threadBinding{}, prev}
+	if prev != nil {
+		for k, v := range prev.bindings {
+			frame.bindings[k] = v
+		}
+	}
+	Reduce_kv.X_invoke_Arity3(Fn(func(_ interface{}, k interface{}, v interface{}) interface{} {
+		b := &threadBinding{goroutine: id}
+		b.set(v)
+		frame.bindings[varName(k)] = b
+		return nil
+	}), nil, bindings)
+	setCurrentFrame(frame)
+}
+
+func withFrame(frame *bindingFrame, f func() interface{}) interface{} {
+	prev := currentFrame()
+	setCurrentFrame(frame)
+	defer setCurrentFrame(prev)
+	return f()
+}
+
+// Returns the value of the dynamic var name bound in the current goroutine, or root if unbound.
+func Dynamic_(name string, root interface{}) interface{} {
+	if !varBound(name) {
+		return root
+	}
+	if frame := currentFrame(); frame != nil {
+		if b, ok := frame.bindings[name]; ok {
+			return b.get()
+		}
+	}
+	return root
+}
+
+// Like Dynamic_, but returns the zero value of the type of root when the var is bound to nil, for
+// reads of vars whose root has a Go type other than interface{}.
+func Dynamic_or_zero_(name string, root interface{}) interface{} {
+	val := Dynamic_(name, root)
+	if val == nil && root != nil {
+		return reflect.Zero(reflect.TypeOf(root)).Interface()
+	}
+	return val
+}
+
+// Sets the binding of the dynamic var name in the current goroutine. Returns false if the var isn't
+// bound, in which case the caller sets the root value instead.
+func Set_dynamic_(name string, val interface{}) bool {
+	if frame := currentFrame(); frame != nil {
+		if b, ok := frame.bindings[name]; ok {
+			if b.goroutine != goroutineId() {
+				panic(&js.Error{"Can't set!: " + name + " from non-binding goroutine"})
+			}
+			b.set(val)
+			return true
+		}
+	}
+	return false
+}
+
+// WARNING: This is a low-level function. Prefer high-level macros like
+// binding where ever possible.
+//
+// Takes a map of Var/value pairs. Binds each Var to the associated value for
+// the current goroutine. Each call *MUST* be accompanied by a matching call to
+// pop-thread-bindings wrapped in a try-finally!
+var Push_thread_bindings = Fn(func(bindings interface{}) interface{} {
+	pushFrame(bindings)
+	return nil
+})
+
+// Pop one set of bindings pushed with push-binding before. It is an error to
+// pop bindings without pushing before.
+var Pop_thread_bindings = Fn(func() interface{} {
+	frame := currentFrame()
+	if frame == nil {
+		panic(&js.Error{"Pop without matching push"})
+	}
+	setCurrentFrame(frame.prev)
+	return nil
+})
+
+// Get a map with the Var/value pairs which is currently in effect for the
+// current goroutine. The vars are represented by their fully qualified symbols.
+var Get_thread_bindings = Fn(func() interface{} {
+	var bindings interface{} = CljsCorePersistentArrayMap_EMPTY
+	if frame := currentFrame(); frame != nil {
+		for k, v := range frame.bindings {
+			bindings = Assoc.X_invoke_Arity3(bindings, Symbol.X_invoke_Arity1(k), v.get())
+		}
+	}
+	return bindings
+})
+
+// Takes a map of Var/value pairs. Installs for the given Vars the associated
+// values as goroutine-local bindings. Then calls f with the supplied arguments.
+// Pops the installed bindings after f returned. Returns whatever f returns.
+// @param {...*} var_args
+var With_bindings_STAR_ = Fn(2, func(binding_map interface{}, f interface{}) interface{} {
+	pushFrame(binding_map)
+	defer Pop_thread_bindings.X_invoke_Arity0()
+	return f.(CljsCoreIFn).X_invoke_Arity0()
+}, func(binding_map_f_args__ ...interface{}) interface{} {
+	var binding_map, f, args = binding_map_f_args__[0], binding_map_f_args__[1], binding_map_f_args__[2]
+	pushFrame(binding_map)
+	defer Pop_thread_bindings.X_invoke_Arity0()
+	return Apply.X_invoke_Arity2(f, args)
+})
+
+// Returns a function, which will install the same bindings in effect as in
+// the goroutine at the time bound-fn* was called and then call f with any given
+// arguments. This may be used to define a helper function which runs on a
+// different goroutine, but needs the same bindings in place.
+var Bound_fn_STAR_ = Fn(func(f interface{}) interface{} {
+	bindings := Get_thread_bindings.X_invoke_Arity0()
+	return Fn(0, func(args__ ...interface{}) interface{} {
+		pushFrame(bindings)
+		defer Pop_thread_bindings.X_invoke_Arity0()
+		return Apply.X_invoke_Arity2(f, args__[0])
+	})
+})
+
+// Returns true if all of the vars provided as arguments have goroutine-local bindings.
+// Implies that set!'ing the provided vars will succeed.
+// @param {...*} var_args
+var Thread_bound_QMARK_ = Fn(0, func(vars__ ...interface{}) interface{} {
+	frame := currentFrame()
+	for s := Seq.X_invoke_Arity1(vars__[0]); s != nil; s = Next.X_invoke_Arity1(s) {
+		if frame == nil {
+			return false
+		}
+		if b, ok := frame.bindings[varName(First.X_invoke_Arity1(s))]; !ok || b.goroutine != goroutineId() {
+			return false
+		}
+	}
+	return true
+})
+
+// Returns a function that runs f with the bindings of the calling goroutine in effect.
+// Used to convey bindings into futures, agents and channel callbacks.
+func Binding_conveyor_fn_(f interface{}) *AFn {
+	frame := currentFrame()
+	return Fn(0, func(args__ ...interface{}) interface{} {
+		return withFrame(frame, func() interface{} {
+			return Apply.X_invoke_Arity2(f, args__[0])
+		})
+	})
+}
@@ -0,0 +1,549 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hraberg/cljs2go/js"
+)
+
+// Conversion between arbitrary Go values and ClojureScript data.
+
+var keywordizeKeysKeyword = &CljsCoreKeyword{Ns: nil, Name: "keywordize-keys", Fqn: "keywordize-keys", X_hash: float64(1310784252)}
+var depthKeyword = &CljsCoreKeyword{Ns: nil, Name: "depth", Fqn: "depth", X_hash: float64(1768663640)}
+
+var jsPkgPath = reflect.TypeOf(js.Date{}).PkgPath()
+var timeType = reflect.TypeOf(time.Time{})
+
+// Returns the key used for a struct field, following the conventions of encoding/json.
+// Returns false for fields that are skipped.
+func fieldKey(f reflect.StructField) (string, bool) {
+	if f.PkgPath != "" && !f.Anonymous {
+		return "", false
+	}
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	if name := strings.Split(tag, ",")[0]; name != "" {
+		return name, true
+	}
+	return f.Name, true
+}
+
+func omitEmpty(f reflect.StructField) bool {
+	for _, opt := range strings.Split(f.Tag.Get("json"), ",")[1:] {
+		if opt == "omitempty" {
+			return true
+		}
+	}
+	return false
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
+
+// Values which already are ClojureScript data, or which have no ClojureScript counterpart.
+// Types implementing protocols are recognized by their marker methods.
+func isOpaque(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return true
+	}
+	if t.PkgPath() == jsPkgPath || t.Kind() == reflect.Ptr && t.Elem().PkgPath() == jsPkgPath {
+		return true
+	}
+	for i := 0; i < t.NumMethod(); i++ {
+		if strings.HasSuffix(t.Method(i).Name, "__") {
+			return true
+		}
+	}
+	return false
+}
+
+type goToClj struct {
+	keywordize bool
+	depth      float64
+	visiting   map[visit]bool
+}
+
+// A pointer, map or slice being converted, to detect values containing themselves.
+type visit struct {
+	ptr uintptr
+	t   reflect.Type
+}
+
+func newGoToClj(keywordize bool, depth float64) *goToClj {
+	return &goToClj{keywordize, depth, map[visit]bool{}}
+}
+
+// Converts the value behind the pointer, map or slice v, unless it's already being converted
+// further up, in which case v is left as it is.
+func (this *goToClj) reference(v reflect.Value, convert func() interface{}) interface{} {
+	k := visit{v.Pointer(), v.Type()}
+	if this.visiting[k] {
+		return v.Interface()
+	}
+	this.visiting[k] = true
+	defer delete(this.visiting, k)
+	return convert()
+}
+
+func (this *goToClj) key(k string) interface{} {
+	if this.keywordize {
+		return Keyword.X_invoke_Arity1(k)
+	}
+	return k
+}
+
+func (this *goToClj) structFields(v reflect.Value, m interface{}, depth float64) interface{} {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		k, ok := fieldKey(f)
+		if !ok {
+			continue
+		}
+		fv := v.Field(i)
+		if omitEmpty(f) && isEmptyValue(fv) {
+			continue
+		}
+		if f.Anonymous && f.Tag.Get("json") == "" {
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() || this.visiting[visit{fv.Pointer(), fv.Type()}] {
+					continue
+				}
+				if fv.Elem().Kind() == reflect.Struct {
+					k := visit{fv.Pointer(), fv.Type()}
+					this.visiting[k] = true
+					m = this.structFields(fv.Elem(), m, depth)
+					delete(this.visiting, k)
+					continue
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				m = this.structFields(fv, m, depth)
+				continue
+			}
+			if f.PkgPath != "" {
+				continue
+			}
+		}
+		m = Assoc_BANG_.X_invoke_Arity3(m, this.key(k), this.convert(fv, depth+1))
+	}
+	return m
+}
+
+func (this *goToClj) convert(v reflect.Value, depth float64) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if isOpaque(v.Type()) {
+		return v.Interface()
+	}
+	// Below the depth limit only scalars are converted, collections and pointers are left as they are.
+	if this.depth >= 0 && depth >= this.depth {
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			return v.Interface()
+		case reflect.Struct:
+			if v.Type() != timeType {
+				return v.Interface()
+			}
+		}
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return this.convert(v.Elem(), depth)
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return this.reference(v, func() interface{} {
+			return this.convert(v.Elem(), depth)
+		})
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		vector := func() interface{} {
+			ret := Transient.X_invoke_Arity1(CljsCorePersistentVector_EMPTY)
+			for i := 0; i < v.Len(); i++ {
+				ret = Conj_BANG_.X_invoke_Arity2(ret, this.convert(v.Index(i), depth+1))
+			}
+			return Persistent_BANG_.X_invoke_Arity1(ret)
+		}
+		if v.Kind() == reflect.Slice {
+			return this.reference(v, vector)
+		}
+		return vector()
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		return this.reference(v, func() interface{} {
+			ret := Transient.X_invoke_Arity1(CljsCorePersistentArrayMap_EMPTY)
+			for _, k := range v.MapKeys() {
+				key := this.convert(k, depth+1)
+				if k, ok := key.(string); ok {
+					key = this.key(k)
+				}
+				ret = Assoc_BANG_.X_invoke_Arity3(ret, key, this.convert(v.MapIndex(k), depth+1))
+			}
+			return Persistent_BANG_.X_invoke_Arity1(ret)
+		})
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return &js.Date{v.Interface()}
+		}
+		return Persistent_BANG_.X_invoke_Arity1(this.structFields(v, Transient.X_invoke_Arity1(CljsCorePersistentArrayMap_EMPTY), depth))
+	}
+	return v.Interface()
+}
+
+// Recursively transforms Go values into ClojureScript data. Slices and
+// arrays become vectors, maps and structs become maps, pointers are
+// dereferenced, all numbers become doubles and time.Time becomes a
+// js/Date. Struct fields are keyed by their name, or by their json tag
+// if present, and are skipped if unexported or tagged "-".
+//
+// Options:
+//
+// :keywordize-keys - when true, string keys and struct fields become
+// keywords (default false)
+//
+// :depth - the number of levels to convert, values below are left as
+// they are (default unlimited)
+// @param {...*} var_args
+var Go__GT_clj = Fn(1, func(x interface{}) interface{} {
+	return newGoToClj(false, -1).convert(reflect.ValueOf(x), 0)
+}, func(x_opts__ ...interface{}) interface{} {
+	var x = x_opts__[0]
+	var opts = Apply.X_invoke_Arity2(Hash_map, x_opts__[1])
+	var depth = Get.X_invoke_Arity3(opts, depthKeyword, float64(-1))
+	if depth == nil {
+		depth = float64(-1)
+	}
+	return newGoToClj(Truth_(Get.X_invoke_Arity2(opts, keywordizeKeysKeyword)), depth.(float64)).convert(reflect.ValueOf(x), 0)
+})
+
+type cljToGo struct {
+	path []interface{}
+}
+
+func (this *cljToGo) fail(x interface{}, t reflect.Type, reason string) {
+	msg := "Can't convert " + Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{x})).(string) + " to " + t.String()
+	if len(this.path) > 0 {
+		msg += " at " + Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{Vec.X_invoke_Arity1(this.path)})).(string)
+	}
+	if reason != "" {
+		msg += ": " + reason
+	}
+	panic(&js.TypeError{msg})
+}
+
+func (this *cljToGo) at(k interface{}, f func()) {
+	this.path = append(this.path, k)
+	defer func() { this.path = this.path[:len(this.path)-1] }()
+	f()
+}
+
+func (this *cljToGo) number(x interface{}, t reflect.Type) float64 {
+	switch n := x.(type) {
+	case float64:
+		return n
+	case js.JSNumber:
+		return float64(n)
+	}
+	if v := reflect.ValueOf(x); v.IsValid() {
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return float64(v.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return float64(v.Uint())
+		case reflect.Float32, reflect.Float64:
+			return v.Float()
+		}
+	}
+	this.fail(x, t, "not a number")
+	return 0
+}
+
+func (this *cljToGo) integer(x interface{}, t reflect.Type) float64 {
+	n := this.number(x, t)
+	if n != math.Trunc(n) || math.IsInf(n, 0) {
+		this.fail(x, t, "not an integer")
+	}
+	return n
+}
+
+func keyName(k interface{}) (string, bool) {
+	switch k := k.(type) {
+	case string:
+		return k, true
+	case *CljsCoreKeyword:
+		return k.Fqn.(string), true
+	case *CljsCoreSymbol:
+		return k.Str.(string), true
+	}
+	return "", false
+}
+
+// Finds the struct field for a map key, preferring an exact match of the json tag or name.
+// Nil pointers to embedded structs are only allocated on the way to the field found.
+func (this *cljToGo) field(v reflect.Value, name string) reflect.Value {
+	path := fieldPath(v.Type(), name)
+	if path == nil {
+		return reflect.Value{}
+	}
+	for _, i := range path {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v
+}
+
+// Returns the indexes of the settable fields leading to the field for name in the struct type t,
+// through embedded structs and pointers to them, or nil if there's none.
+func fieldPath(t reflect.Type, name string) []int {
+	var fold []int
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		k, ok := fieldKey(f)
+		if !ok {
+			continue
+		}
+		exported := f.PkgPath == ""
+		if f.Anonymous && f.Tag.Get("json") == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr && ft.Elem().Kind() == reflect.Struct && exported {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if path := fieldPath(ft, name); path != nil {
+					return append([]int{i}, path...)
+				}
+				continue
+			}
+		}
+		if k == name && exported {
+			return []int{i}
+		}
+		if fold == nil && strings.EqualFold(k, name) && exported {
+			fold = []int{i}
+		}
+	}
+	return fold
+}
+
+func (this *cljToGo) generic(x interface{}) interface{} {
+	switch {
+	case Map_QMARK_.Arity1IB(x):
+		m := map[string]interface{}{}
+		var g map[interface{}]interface{}
+		for s := Seq.X_invoke_Arity1(x); s != nil; s = Next.X_invoke_Arity1(s) {
+			e := First.X_invoke_Arity1(s)
+			k, v := Key.X_invoke_Arity1(e), Val.X_invoke_Arity1(e)
+			this.at(k, func() {
+				if name, ok := keyName(k); ok && g == nil {
+					m[name] = this.generic(v)
+				} else {
+					if g == nil {
+						g = map[interface{}]interface{}{}
+						for k, v := range m {
+							g[k] = v
+						}
+					}
+					g[this.generic(k)] = this.generic(v)
+				}
+			})
+		}
+		if g != nil {
+			return g
+		}
+		return m
+	case Coll_QMARK_.Arity1IB(x):
+		a := []interface{}{}
+		for s := Seq.X_invoke_Arity1(x); s != nil; s = Next.X_invoke_Arity1(s) {
+			this.at(float64(len(a)), func() {
+				a = append(a, this.generic(First.X_invoke_Arity1(s)))
+			})
+		}
+		return a
+	case Keyword_QMARK_.Arity1IB(x) || Symbol_QMARK_.Arity1IB(x):
+		name, _ := keyName(x)
+		return name
+	}
+	return x
+}
+
+func (this *cljToGo) convert(x interface{}, v reflect.Value) {
+	t := v.Type()
+	if x == nil {
+		v.Set(reflect.Zero(t))
+		return
+	}
+	if xv := reflect.ValueOf(x); t.Kind() != reflect.Interface && xv.Type().AssignableTo(t) {
+		v.Set(xv)
+		return
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		if g := reflect.ValueOf(this.generic(x)); g.Type().AssignableTo(t) {
+			v.Set(g)
+		} else if xv := reflect.ValueOf(x); xv.Type().AssignableTo(t) {
+			v.Set(xv)
+		} else {
+			this.fail(x, t, "")
+		}
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(t.Elem()))
+		}
+		this.convert(x, v.Elem())
+	case reflect.Bool:
+		if b, ok := x.(bool); ok {
+			v.SetBool(b)
+		} else {
+			this.fail(x, t, "not a boolean")
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := this.integer(x, t)
+		if n < math.MinInt64 || n >= math.MaxInt64 || v.OverflowInt(int64(n)) {
+			this.fail(x, t, "out of range")
+		}
+		v.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n := this.integer(x, t)
+		if n < 0 || n >= math.MaxUint64 || v.OverflowUint(uint64(n)) {
+			this.fail(x, t, "out of range")
+		}
+		v.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		n := this.number(x, t)
+		if !math.IsInf(n, 0) && !math.IsNaN(n) && v.OverflowFloat(n) {
+			this.fail(x, t, "out of range")
+		}
+		v.SetFloat(n)
+	case reflect.String:
+		if name, ok := keyName(x); ok {
+			v.SetString(name)
+		} else {
+			this.fail(x, t, "not a string")
+		}
+	case reflect.Slice, reflect.Array:
+		if !Coll_QMARK_.Arity1IB(x) && !Array_QMARK_.Arity1IB(x) {
+			this.fail(x, t, "not a collection")
+		}
+		n := int(Count.X_invoke_Arity1(x).(float64))
+		if t.Kind() == reflect.Array {
+			if n > t.Len() {
+				this.fail(x, t, "too many elements")
+			}
+			v.Set(reflect.Zero(t))
+		} else {
+			v.Set(reflect.MakeSlice(t, n, n))
+		}
+		i := 0
+		for s := Seq.X_invoke_Arity1(x); s != nil; s = Next.X_invoke_Arity1(s) {
+			this.at(float64(i), func() {
+				this.convert(First.X_invoke_Arity1(s), v.Index(i))
+			})
+			i++
+		}
+	case reflect.Map:
+		if !Map_QMARK_.Arity1IB(x) {
+			this.fail(x, t, "not a map")
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(t))
+		}
+		for s := Seq.X_invoke_Arity1(x); s != nil; s = Next.X_invoke_Arity1(s) {
+			e := First.X_invoke_Arity1(s)
+			this.at(Key.X_invoke_Arity1(e), func() {
+				k, val := reflect.New(t.Key()).Elem(), reflect.New(t.Elem()).Elem()
+				this.convert(Key.X_invoke_Arity1(e), k)
+				this.convert(Val.X_invoke_Arity1(e), val)
+				v.SetMapIndex(k, val)
+			})
+		}
+	case reflect.Struct:
+		if t == timeType {
+			if d, ok := x.(*js.Date); ok {
+				sec := math.Floor(d.GetTime() / 1000)
+				v.Set(reflect.ValueOf(time.Unix(int64(sec), int64(d.GetNanoseconds())).UTC()))
+			} else {
+				this.fail(x, t, "not a date")
+			}
+			return
+		}
+		if !Map_QMARK_.Arity1IB(x) {
+			this.fail(x, t, "not a map")
+		}
+		for s := Seq.X_invoke_Arity1(x); s != nil; s = Next.X_invoke_Arity1(s) {
+			e := First.X_invoke_Arity1(s)
+			if name, ok := keyName(Key.X_invoke_Arity1(e)); ok {
+				if f := this.field(v, name); f.IsValid() {
+					this.at(Key.X_invoke_Arity1(e), func() {
+						this.convert(Val.X_invoke_Arity1(e), f)
+					})
+				}
+			}
+		}
+	default:
+		this.fail(x, t, "")
+	}
+}
+
+// Populates target, which must be a non-nil pointer, from the
+// ClojureScript data x and returns target. Maps populate structs by
+// matching keyword or string keys to the json tags or names of the
+// fields, unknown keys are ignored. Numbers are coerced to the target
+// kind, failing if they don't fit. Targets of type interface{} receive
+// slices and maps of plain Go values. Errors report the path to the
+// offending value.
+var Clj__GT_go = Fn(func(x interface{}, target interface{}) interface{} {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		panic(&js.TypeError{"clj->go target must be a non-nil pointer, got " + fmt.Sprintf("%T", target)})
+	}
+	(&cljToGo{}).convert(x, v.Elem())
+	return target
+})
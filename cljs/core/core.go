@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// Edited by hand since it was compiled, to follow the compiler and src/cljs/core/overrides.cljs changes for atoms, futures, bindings and namespaces. Regenerate it with lein compile-clojurescript.
 // cljs.core
 
 package core
@@ -36,7 +37,7 @@ func init() {
 
 	Pr_opts = func(pr_opts *AFn) *AFn {
 		return Fn(pr_opts, 0, func() interface{} {
-			return (&CljsCorePersistentArrayMap{nil, float64(5), []interface{}{(&CljsCoreKeyword{Ns: nil, Name: "flush-on-newline", Fqn: "flush-on-newline", X_hash: float64(-151457939)}), X_STAR_flush_on_newline_STAR_, (&CljsCoreKeyword{Ns: nil, Name: "readably", Fqn: "readably", X_hash: float64(1129599760)}), X_STAR_print_readably_STAR_, (&CljsCoreKeyword{Ns: nil, Name: "meta", Fqn: "meta", X_hash: float64(1499536964)}), X_STAR_print_meta_STAR_, (&CljsCoreKeyword{Ns: nil, Name: "dup", Fqn: "dup", X_hash: float64(556298533)}), X_STAR_print_dup_STAR_, (&CljsCoreKeyword{Ns: nil, Name: "print-length", Fqn: "print-length", X_hash: float64(1931866356)}), X_STAR_print_length_STAR_}, nil})
+			return (&CljsCorePersistentArrayMap{nil, float64(5), []interface{}{(&CljsCoreKeyword{Ns: nil, Name: "flush-on-newline", Fqn: "flush-on-newline", X_hash: float64(-151457939)}), Truth_(Dynamic_("cljs.core/*flush-on-newline*", X_STAR_flush_on_newline_STAR_)), (&CljsCoreKeyword{Ns: nil, Name: "readably", Fqn: "readably", X_hash: float64(1129599760)}), Truth_(Dynamic_("cljs.core/*print-readably*", X_STAR_print_readably_STAR_)), (&CljsCoreKeyword{Ns: nil, Name: "meta", Fqn: "meta", X_hash: float64(1499536964)}), Truth_(Dynamic_("cljs.core/*print-meta*", X_STAR_print_meta_STAR_)), (&CljsCoreKeyword{Ns: nil, Name: "dup", Fqn: "dup", X_hash: float64(556298533)}), Truth_(Dynamic_("cljs.core/*print-dup*", X_STAR_print_dup_STAR_)), (&CljsCoreKeyword{Ns: nil, Name: "print-length", Fqn: "print-length", X_hash: float64(1931866356)}), Dynamic_("cljs.core/*print-length*", X_STAR_print_length_STAR_)}, nil})
 		})
 	}(&AFn{})
 
@@ -1118,12 +1119,6 @@ func init() {
 		})
 	}(&AFn{})
 
-	Deref = func(deref *AFn) *AFn {
-		return Fn(deref, 1, func(o interface{}) interface{} {
-			return Decorate_(o).(CljsCoreIDeref).X_deref_Arity1()
-		})
-	}(&AFn{})
-
 	Ci_reduce = func(ci_reduce *AFn) *AFn {
 		return Fn(ci_reduce, 4, func(cicoll interface{}, f interface{}) interface{} {
 			{
@@ -5053,146 +5048,6 @@ func init() {
 		})
 	}(&AFn{})
 
-	X__GT_Atom = func(__GT_Atom *AFn) *AFn {
-		return Fn(__GT_Atom, 4, func(state interface{}, meta interface{}, validator interface{}, watches interface{}) interface{} {
-			return (&CljsCoreAtom{state, meta, validator, watches})
-		})
-	}(&AFn{})
-
-	Atom = func(atom *AFn) *AFn {
-		return Fn(atom, 1, func(x interface{}) interface{} {
-			return (&CljsCoreAtom{x, nil, nil, nil})
-		}, func(x_p__5034__ ...interface{}) interface{} {
-			var x = x_p__5034__[0]
-			var p__5034 = Seq.Arity1IQ(x_p__5034__[1])
-			_, _ = x, p__5034
-			{
-				var map__5036 = p__5034
-				var map__5036___1 = func() interface{} {
-					if Seq_QMARK_.Arity1IB(map__5036) {
-						return Apply.X_invoke_Arity2(Hash_map, map__5036)
-					} else {
-						return map__5036
-					}
-				}()
-				var validator = Get.X_invoke_Arity2(map__5036___1, (&CljsCoreKeyword{Ns: nil, Name: "validator", Fqn: "validator", X_hash: float64(-1966190681)}))
-				var meta = Get.X_invoke_Arity2(map__5036___1, (&CljsCoreKeyword{Ns: nil, Name: "meta", Fqn: "meta", X_hash: float64(1499536964)}))
-				_, _, _, _ = map__5036, map__5036___1, validator, meta
-				return (&CljsCoreAtom{x, meta, validator, nil})
-			}
-		})
-	}(&AFn{})
-
-	Reset_BANG_ = func(reset_BANG_ *AFn) *AFn {
-		return Fn(reset_BANG_, 2, func(a interface{}, new_value interface{}) interface{} {
-			if Value_(a).Type().AssignableTo(reflect.TypeOf((**CljsCoreAtom)(nil)).Elem()) {
-				{
-					var validate = Native_get_instance_field.X_invoke_Arity2(a, "Validator")
-					_ = validate
-					if Nil_(validate) {
-					} else {
-						if Truth_(func() interface{} {
-							var G__5038 = new_value
-							_ = G__5038
-							return validate.(CljsCoreIFn).X_invoke_Arity1(G__5038)
-						}()) {
-						} else {
-							panic((&js.Error{strings.Join([]string{Str.X_invoke_Arity1("Assert failed: ").(string), Str.X_invoke_Arity1("Validator rejected reference state").(string), Str.X_invoke_Arity1("\n").(string), Str.X_invoke_Arity1("(validate new-value)").(string)}, ``)}))
-						}
-					}
-					{
-						var old_value = Native_get_instance_field.X_invoke_Arity2(a, "State")
-						_ = old_value
-						Native_set_instance_field.X_invoke_Arity3(a, "State", new_value)
-						if Nil_(Native_get_instance_field.X_invoke_Arity2(a, "Watches")) {
-						} else {
-							Decorate_(a).(CljsCoreIWatchable).X_notify_watches_Arity3(old_value, new_value)
-						}
-						return new_value
-					}
-				}
-			} else {
-				return Decorate_(a).(CljsCoreIReset).X_reset_BANG__Arity2(new_value)
-			}
-		})
-	}(&AFn{})
-
-	Swap_BANG_ = func(swap_BANG_ *AFn) *AFn {
-		return Fn(swap_BANG_, 4, func(a interface{}, f interface{}) interface{} {
-			if Value_(a).Type().AssignableTo(reflect.TypeOf((**CljsCoreAtom)(nil)).Elem()) {
-				return Reset_BANG_.X_invoke_Arity2(a, func() interface{} {
-					var G__5045 = Native_get_instance_field.X_invoke_Arity2(a, "State")
-					_ = G__5045
-					return f.(CljsCoreIFn).X_invoke_Arity1(G__5045)
-				}())
-			} else {
-				return Decorate_(a).(CljsCoreISwap).X_swap_BANG__Arity2(f)
-			}
-		}, func(a interface{}, f interface{}, x interface{}) interface{} {
-			if Value_(a).Type().AssignableTo(reflect.TypeOf((**CljsCoreAtom)(nil)).Elem()) {
-				return Reset_BANG_.X_invoke_Arity2(a, func() interface{} {
-					var G__5046 = Native_get_instance_field.X_invoke_Arity2(a, "State")
-					var G__5047 = x
-					_, _ = G__5046, G__5047
-					return f.(CljsCoreIFn).X_invoke_Arity2(G__5046, G__5047)
-				}())
-			} else {
-				return Decorate_(a).(CljsCoreISwap).X_swap_BANG__Arity3(f, x)
-			}
-		}, func(a interface{}, f interface{}, x interface{}, y interface{}) interface{} {
-			if Value_(a).Type().AssignableTo(reflect.TypeOf((**CljsCoreAtom)(nil)).Elem()) {
-				return Reset_BANG_.X_invoke_Arity2(a, func() interface{} {
-					var G__5048 = Native_get_instance_field.X_invoke_Arity2(a, "State")
-					var G__5049 = x
-					var G__5050 = y
-					_, _, _ = G__5048, G__5049, G__5050
-					return f.(CljsCoreIFn).X_invoke_Arity3(G__5048, G__5049, G__5050)
-				}())
-			} else {
-				return Decorate_(a).(CljsCoreISwap).X_swap_BANG__Arity4(f, x, y)
-			}
-		}, func(a_f_x_y_more__ ...interface{}) interface{} {
-			var a = a_f_x_y_more__[0]
-			var f = a_f_x_y_more__[1]
-			var x = a_f_x_y_more__[2]
-			var y = a_f_x_y_more__[3]
-			var more = Seq.Arity1IQ(a_f_x_y_more__[4])
-			_, _, _, _, _ = a, f, x, y, more
-			if Value_(a).Type().AssignableTo(reflect.TypeOf((**CljsCoreAtom)(nil)).Elem()) {
-				return Reset_BANG_.X_invoke_Arity2(a, Apply.X_invoke_Arity5(f, Native_get_instance_field.X_invoke_Arity2(a, "State"), x, y, more))
-			} else {
-				return Decorate_(a).(CljsCoreISwap).X_swap_BANG__Arity5(f, x, y, more)
-			}
-		})
-	}(&AFn{})
-
-	Compare_and_set_BANG_ = func(compare_and_set_BANG_ *AFn) *AFn {
-		return Fn(compare_and_set_BANG_, 3, func(a interface{}, oldval interface{}, newval interface{}) interface{} {
-			if X_EQ_.Arity2IIB(Native_get_instance_field.X_invoke_Arity2(a, "State"), oldval) {
-				Reset_BANG_.X_invoke_Arity2(a, newval)
-				return true
-			} else {
-				return false
-			}
-		})
-	}(&AFn{})
-
-	Set_validator_BANG_ = func(set_validator_BANG_ *AFn) *AFn {
-		return Fn(set_validator_BANG_, 2, func(iref interface{}, val interface{}) interface{} {
-			return func() interface{} {
-				var return__5051 = val
-				Native_set_instance_field.X_invoke_Arity3(iref, "Validator", return__5051)
-				return return__5051
-			}()
-		})
-	}(&AFn{})
-
-	Get_validator = func(get_validator *AFn) *AFn {
-		return Fn(get_validator, 1, func(iref interface{}) interface{} {
-			return Native_get_instance_field.X_invoke_Arity2(iref, "Validator")
-		})
-	}(&AFn{})
-
 	Keep_indexed = func(keep_indexed *AFn) *AFn {
 		return Fn(keep_indexed, 2, func(f interface{}) interface{} {
 			return func(G__5118 *AFn) *AFn {
@@ -10669,7 +10524,7 @@ func init() {
 
 	String_print = func(string_print *AFn) *AFn {
 		return Fn(string_print, 1, func(x interface{}) interface{} {
-			X_STAR_print_fn_STAR_.X_invoke_Arity1(x)
+			Dynamic_or_zero_("cljs.core/*print-fn*", X_STAR_print_fn_STAR_).(*AFn).X_invoke_Arity1(x)
 			return nil
 		})
 	}(&AFn{})
@@ -10835,7 +10690,7 @@ func init() {
 			var objs = Seq.Arity1IQ(objs__[0])
 			_ = objs
 			Pr_with_opts.X_invoke_Arity2(objs, Assoc.X_invoke_Arity3(Pr_opts.X_invoke_Arity0().(CljsCoreIMap), (&CljsCoreKeyword{Ns: nil, Name: "readably", Fqn: "readably", X_hash: float64(1129599760)}), false))
-			if Truth_(X_STAR_print_newline_STAR_) {
+			if Truth_(Dynamic_("cljs.core/*print-newline*", X_STAR_print_newline_STAR_)) {
 				return Newline.X_invoke_Arity1(Pr_opts.X_invoke_Arity0().(CljsCoreIMap))
 			} else {
 				return nil
@@ -10856,7 +10711,7 @@ func init() {
 			var objs = Seq.Arity1IQ(objs__[0])
 			_ = objs
 			Pr_with_opts.X_invoke_Arity2(objs, Pr_opts.X_invoke_Arity0().(CljsCoreIMap))
-			if Truth_(X_STAR_print_newline_STAR_) {
+			if Truth_(Dynamic_("cljs.core/*print-newline*", X_STAR_print_newline_STAR_)) {
 				return Newline.X_invoke_Arity1(Pr_opts.X_invoke_Arity0().(CljsCoreIMap))
 			} else {
 				return nil
@@ -11804,6 +11659,606 @@ func init() {
 		})
 	}(&AFn{})
 
+	Intern_("cljs.core", "*", &X_STAR_, map[string]interface{}{"doc": "Returns the product of nums. (*) returns 1.", "arglists": [][]string{{}, {"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "*1", &X_STAR_1, map[string]interface{}{"doc": "bound in a repl thread to the most recent value printed"})
+	Intern_("cljs.core", "*2", &X_STAR_2, map[string]interface{}{"doc": "bound in a repl thread to the second most recent value printed"})
+	Intern_("cljs.core", "*3", &X_STAR_3, map[string]interface{}{"doc": "bound in a repl thread to the third most recent value printed"})
+	Intern_("cljs.core", "*flush-on-newline*", &X_STAR_flush_on_newline_STAR_, map[string]interface{}{"dynamic": true})
+	Intern_("cljs.core", "*main-cli-fn*", &X_STAR_main_cli_fn_STAR_, map[string]interface{}{"doc": "When compiled for a command-line target, whatever\nfunction *main-fn* is set to will be called with the command-line\nargv as arguments", "dynamic": true})
+	Intern_("cljs.core", "*print-dup*", &X_STAR_print_dup_STAR_, map[string]interface{}{"dynamic": true})
+	Intern_("cljs.core", "*print-fn*", &X_STAR_print_fn_STAR_, map[string]interface{}{"doc": "Each runtime environment provides a different way to print output.\nWhatever function *print-fn* is bound to will be passed any\nStrings which should be printed.", "arglists": [][]string{{"_"}}, "dynamic": true})
+	Intern_("cljs.core", "*print-meta*", &X_STAR_print_meta_STAR_, map[string]interface{}{"dynamic": true})
+	Intern_("cljs.core", "*print-newline*", &X_STAR_print_newline_STAR_, map[string]interface{}{"dynamic": true})
+	Intern_("cljs.core", "*print-readably*", &X_STAR_print_readably_STAR_, map[string]interface{}{"dynamic": true})
+	Intern_("cljs.core", "*unchecked-if*", &X_STAR_unchecked_if_STAR_, map[string]interface{}{"dynamic": true})
+	Intern_("cljs.core", "+", &X_PLUS_, map[string]interface{}{"doc": "Returns the sum of nums. (+) returns 0.", "arglists": [][]string{{}, {"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "-", &X_, map[string]interface{}{"doc": "If no ys are supplied, returns the negation of x, else subtracts\nthe ys from x and returns the result.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "->ArrayChunk", &X__GT_ArrayChunk, map[string]interface{}{"arglists": [][]string{{"arr", "off", "end"}}})
+	Intern_("cljs.core", "->ArrayIter", &X__GT_ArrayIter, map[string]interface{}{"arglists": [][]string{{"arr", "i"}}})
+	Intern_("cljs.core", "->ArrayList", &X__GT_ArrayList, map[string]interface{}{"arglists": [][]string{{"arr"}}})
+	Intern_("cljs.core", "->ArrayNode", &X__GT_ArrayNode, map[string]interface{}{"arglists": [][]string{{"edit", "cnt", "arr"}}})
+	Intern_("cljs.core", "->ArrayNodeSeq", &X__GT_ArrayNodeSeq, map[string]interface{}{"arglists": [][]string{{"meta", "nodes", "i", "s", "__hash"}}})
+	Intern_("cljs.core", "->BitmapIndexedNode", &X__GT_BitmapIndexedNode, map[string]interface{}{"arglists": [][]string{{"edit", "bitmap", "arr"}}})
+	Intern_("cljs.core", "->BlackNode", &X__GT_BlackNode, map[string]interface{}{"arglists": [][]string{{"key", "val", "left", "right", "__hash"}}})
+	Intern_("cljs.core", "->Box", &X__GT_Box, map[string]interface{}{"arglists": [][]string{{"val"}}})
+	Intern_("cljs.core", "->ChunkBuffer", &X__GT_ChunkBuffer, map[string]interface{}{"arglists": [][]string{{"buf", "end"}}})
+	Intern_("cljs.core", "->ChunkedCons", &X__GT_ChunkedCons, map[string]interface{}{"arglists": [][]string{{"chunk", "more", "meta", "__hash"}}})
+	Intern_("cljs.core", "->ChunkedSeq", &X__GT_ChunkedSeq, map[string]interface{}{"arglists": [][]string{{"vec", "node", "i", "off", "meta", "__hash"}}})
+	Intern_("cljs.core", "->Cons", &X__GT_Cons, map[string]interface{}{"arglists": [][]string{{"meta", "first", "rest", "__hash"}}})
+	Intern_("cljs.core", "->Delay", &X__GT_Delay, map[string]interface{}{"arglists": [][]string{{"f", "value"}}})
+	Intern_("cljs.core", "->ES6EntriesIterator", &X__GT_ES6EntriesIterator, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "->ES6Iterator", &X__GT_ES6Iterator, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "->ES6IteratorSeq", &X__GT_ES6IteratorSeq, map[string]interface{}{"arglists": [][]string{{"value", "iter", "_rest"}}})
+	Intern_("cljs.core", "->ES6SetEntriesIterator", &X__GT_ES6SetEntriesIterator, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "->Eduction", &X__GT_Eduction, map[string]interface{}{"arglists": [][]string{{"xform", "coll"}}})
+	Intern_("cljs.core", "->EmptyList", &X__GT_EmptyList, map[string]interface{}{"arglists": [][]string{{"meta"}}})
+	Intern_("cljs.core", "->ExceptionInfo", &X__GT_ExceptionInfo, map[string]interface{}{"arglists": [][]string{{"message", "data", "cause"}}})
+	Intern_("cljs.core", "->HashCollisionNode", &X__GT_HashCollisionNode, map[string]interface{}{"arglists": [][]string{{"edit", "collision-hash", "cnt", "arr"}}})
+	Intern_("cljs.core", "->IndexedSeq", &X__GT_IndexedSeq, map[string]interface{}{"arglists": [][]string{{"arr", "i"}}})
+	Intern_("cljs.core", "->IndexedSeqIterator", &X__GT_IndexedSeqIterator, map[string]interface{}{"arglists": [][]string{{"arr", "i"}}})
+	Intern_("cljs.core", "->KeySeq", &X__GT_KeySeq, map[string]interface{}{"arglists": [][]string{{"mseq", "_meta"}}})
+	Intern_("cljs.core", "->Keyword", &X__GT_Keyword, map[string]interface{}{"arglists": [][]string{{"ns", "name", "fqn", "_hash"}}})
+	Intern_("cljs.core", "->LazySeq", &X__GT_LazySeq, map[string]interface{}{"arglists": [][]string{{"meta", "fn", "s", "__hash"}}})
+	Intern_("cljs.core", "->LazyTransformer", &X__GT_LazyTransformer, map[string]interface{}{"arglists": [][]string{{"stepper", "first", "rest", "meta"}}})
+	Intern_("cljs.core", "->List", &X__GT_List, map[string]interface{}{"arglists": [][]string{{"meta", "first", "rest", "count", "__hash"}}})
+	Intern_("cljs.core", "->MetaFn", &X__GT_MetaFn, map[string]interface{}{"arglists": [][]string{{"afn", "meta"}}})
+	Intern_("cljs.core", "->MultiFn", &X__GT_MultiFn, map[string]interface{}{"arglists": [][]string{{"name", "dispatch-fn", "default-dispatch-val", "hierarchy", "method-table", "prefer-table", "method-cache", "cached-hierarchy"}}})
+	Intern_("cljs.core", "->MultiStepper", &X__GT_MultiStepper, map[string]interface{}{"arglists": [][]string{{"xform", "iters", "nexts"}}})
+	Intern_("cljs.core", "->NeverEquiv", &X__GT_NeverEquiv, map[string]interface{}{"arglists": [][]string{{}}})
+	Intern_("cljs.core", "->NodeSeq", &X__GT_NodeSeq, map[string]interface{}{"arglists": [][]string{{"meta", "nodes", "i", "s", "__hash"}}})
+	Intern_("cljs.core", "->PersistentArrayMap", &X__GT_PersistentArrayMap, map[string]interface{}{"arglists": [][]string{{"meta", "cnt", "arr", "__hash"}}})
+	Intern_("cljs.core", "->PersistentArrayMapIterator", &X__GT_PersistentArrayMapIterator, map[string]interface{}{"arglists": [][]string{{"arr", "i", "cnt"}}})
+	Intern_("cljs.core", "->PersistentArrayMapSeq", &X__GT_PersistentArrayMapSeq, map[string]interface{}{"arglists": [][]string{{"arr", "i", "_meta"}}})
+	Intern_("cljs.core", "->PersistentHashMap", &X__GT_PersistentHashMap, map[string]interface{}{"arglists": [][]string{{"meta", "cnt", "root", "has-nil?", "nil-val", "__hash"}}})
+	Intern_("cljs.core", "->PersistentHashSet", &X__GT_PersistentHashSet, map[string]interface{}{"arglists": [][]string{{"meta", "hash-map", "__hash"}}})
+	Intern_("cljs.core", "->PersistentQueue", &X__GT_PersistentQueue, map[string]interface{}{"arglists": [][]string{{"meta", "count", "front", "rear", "__hash"}}})
+	Intern_("cljs.core", "->PersistentQueueSeq", &X__GT_PersistentQueueSeq, map[string]interface{}{"arglists": [][]string{{"meta", "front", "rear", "__hash"}}})
+	Intern_("cljs.core", "->PersistentTreeMap", &X__GT_PersistentTreeMap, map[string]interface{}{"arglists": [][]string{{"comp", "tree", "cnt", "meta", "__hash"}}})
+	Intern_("cljs.core", "->PersistentTreeMapSeq", &X__GT_PersistentTreeMapSeq, map[string]interface{}{"arglists": [][]string{{"meta", "stack", "ascending?", "cnt", "__hash"}}})
+	Intern_("cljs.core", "->PersistentTreeSet", &X__GT_PersistentTreeSet, map[string]interface{}{"arglists": [][]string{{"meta", "tree-map", "__hash"}}})
+	Intern_("cljs.core", "->PersistentVector", &X__GT_PersistentVector, map[string]interface{}{"arglists": [][]string{{"meta", "cnt", "shift", "root", "tail", "__hash"}}})
+	Intern_("cljs.core", "->RSeq", &X__GT_RSeq, map[string]interface{}{"arglists": [][]string{{"ci", "i", "meta"}}})
+	Intern_("cljs.core", "->Range", &X__GT_Range, map[string]interface{}{"arglists": [][]string{{"meta", "start", "end", "step", "__hash"}}})
+	Intern_("cljs.core", "->RangeIterator", &X__GT_RangeIterator, map[string]interface{}{"arglists": [][]string{{"i", "end", "step"}}})
+	Intern_("cljs.core", "->RangedIterator", &X__GT_RangedIterator, map[string]interface{}{"arglists": [][]string{{"i", "base", "arr", "v", "start", "end"}}})
+	Intern_("cljs.core", "->RedNode", &X__GT_RedNode, map[string]interface{}{"arglists": [][]string{{"key", "val", "left", "right", "__hash"}}})
+	Intern_("cljs.core", "->Reduced", &X__GT_Reduced, map[string]interface{}{"arglists": [][]string{{"val"}}})
+	Intern_("cljs.core", "->SeqIter", &X__GT_SeqIter, map[string]interface{}{"arglists": [][]string{{"_seq", "_next"}}})
+	Intern_("cljs.core", "->Stepper", &X__GT_Stepper, map[string]interface{}{"arglists": [][]string{{"xform", "iter"}}})
+	Intern_("cljs.core", "->StringBufferWriter", &X__GT_StringBufferWriter, map[string]interface{}{"arglists": [][]string{{"sb"}}})
+	Intern_("cljs.core", "->StringIter", &X__GT_StringIter, map[string]interface{}{"arglists": [][]string{{"s", "i"}}})
+	Intern_("cljs.core", "->Subvec", &X__GT_Subvec, map[string]interface{}{"arglists": [][]string{{"meta", "v", "start", "end", "__hash"}}})
+	Intern_("cljs.core", "->Symbol", &X__GT_Symbol, map[string]interface{}{"arglists": [][]string{{"ns", "name", "str", "_hash", "_meta"}}})
+	Intern_("cljs.core", "->TransientArrayMap", &X__GT_TransientArrayMap, map[string]interface{}{"arglists": [][]string{{"editable?", "len", "arr"}}})
+	Intern_("cljs.core", "->TransientHashMap", &X__GT_TransientHashMap, map[string]interface{}{"arglists": [][]string{{"edit", "root", "count", "has-nil?", "nil-val"}}})
+	Intern_("cljs.core", "->TransientHashSet", &X__GT_TransientHashSet, map[string]interface{}{"arglists": [][]string{{"transient-map"}}})
+	Intern_("cljs.core", "->TransientVector", &X__GT_TransientVector, map[string]interface{}{"arglists": [][]string{{"cnt", "shift", "root", "tail"}}})
+	Intern_("cljs.core", "->UUID", &X__GT_UUID, map[string]interface{}{"arglists": [][]string{{"uuid"}}})
+	Intern_("cljs.core", "->ValSeq", &X__GT_ValSeq, map[string]interface{}{"arglists": [][]string{{"mseq", "_meta"}}})
+	Intern_("cljs.core", "->Var", &X__GT_Var, map[string]interface{}{"arglists": [][]string{{"val", "sym", "_meta"}}})
+	Intern_("cljs.core", "->VectorNode", &X__GT_VectorNode, map[string]interface{}{"arglists": [][]string{{"edit", "arr"}}})
+	Intern_("cljs.core", "->t4787", &X__GT_t4787, map[string]interface{}{})
+	Intern_("cljs.core", "-add-method", &X_add_method, map[string]interface{}{"arglists": [][]string{{"mf", "dispatch-val", "method"}}})
+	Intern_("cljs.core", "-add-watch", &X_add_watch, map[string]interface{}{"arglists": [][]string{{"this", "key", "f"}}})
+	Intern_("cljs.core", "-as-transient", &X_as_transient, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-assoc", &X_assoc, map[string]interface{}{"arglists": [][]string{{"coll", "k", "v"}}})
+	Intern_("cljs.core", "-assoc!", &X_assoc_BANG_, map[string]interface{}{"arglists": [][]string{{"tcoll", "key", "val"}}})
+	Intern_("cljs.core", "-assoc-n", &X_assoc_n, map[string]interface{}{"arglists": [][]string{{"coll", "n", "val"}}})
+	Intern_("cljs.core", "-assoc-n!", &X_assoc_n_BANG_, map[string]interface{}{"arglists": [][]string{{"tcoll", "n", "val"}}})
+	Intern_("cljs.core", "-chunked-first", &X_chunked_first, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-chunked-next", &X_chunked_next, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-chunked-rest", &X_chunked_rest, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-clj->js", &X_clj__GT_js, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "-clone", &X_clone, map[string]interface{}{"arglists": [][]string{{"value"}}})
+	Intern_("cljs.core", "-comparator", &X_comparator, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-compare", &X_compare, map[string]interface{}{"arglists": [][]string{{"x", "y"}}})
+	Intern_("cljs.core", "-conj", &X_conj, map[string]interface{}{"arglists": [][]string{{"coll", "o"}}})
+	Intern_("cljs.core", "-conj!", &X_conj_BANG_, map[string]interface{}{"arglists": [][]string{{"tcoll", "val"}}})
+	Intern_("cljs.core", "-contains-key?", &X_contains_key_QMARK_, map[string]interface{}{"arglists": [][]string{{"coll", "k"}}})
+	Intern_("cljs.core", "-count", &X_count, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-deref", &X_deref, map[string]interface{}{"arglists": [][]string{{"o"}}})
+	Intern_("cljs.core", "-deref-with-timeout", &X_deref_with_timeout, map[string]interface{}{"arglists": [][]string{{"o", "msec", "timeout-val"}}})
+	Intern_("cljs.core", "-disjoin", &X_disjoin, map[string]interface{}{"arglists": [][]string{{"coll", "v"}}})
+	Intern_("cljs.core", "-disjoin!", &X_disjoin_BANG_, map[string]interface{}{"arglists": [][]string{{"tcoll", "v"}}})
+	Intern_("cljs.core", "-dissoc", &X_dissoc, map[string]interface{}{"arglists": [][]string{{"coll", "k"}}})
+	Intern_("cljs.core", "-dissoc!", &X_dissoc_BANG_, map[string]interface{}{"arglists": [][]string{{"tcoll", "key"}}})
+	Intern_("cljs.core", "-drop-first", &X_drop_first, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-empty", &X_empty, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-entry-key", &X_entry_key, map[string]interface{}{"arglists": [][]string{{"coll", "entry"}}})
+	Intern_("cljs.core", "-equiv", &X_equiv, map[string]interface{}{"arglists": [][]string{{"o", "other"}}})
+	Intern_("cljs.core", "-first", &X_first, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-flush", &X_flush, map[string]interface{}{"arglists": [][]string{{"writer"}}})
+	Intern_("cljs.core", "-get-method", &X_get_method, map[string]interface{}{"arglists": [][]string{{"mf", "dispatch-val"}}})
+	Intern_("cljs.core", "-global-hierarchy", &X_global_hierarchy, map[string]interface{}{})
+	Intern_("cljs.core", "-hash", &X_hash, map[string]interface{}{"arglists": [][]string{{"o"}}})
+	Intern_("cljs.core", "-invoke", &X_invoke, map[string]interface{}{"arglists": [][]string{{"this"}, {"this", "a"}, {"this", "a", "b"}, {"this", "a", "b", "c"}, {"this", "a", "b", "c", "d"}, {"this", "a", "b", "c", "d", "e"}, {"this", "a", "b", "c", "d", "e", "f"}, {"this", "a", "b", "c", "d", "e", "f", "g"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h", "i"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q", "r"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q", "r", "s"}, {"this", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q", "r", "s", "t"}}})
+	Intern_("cljs.core", "-iterator", &X_iterator, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-js->clj", &X_js__GT_clj, map[string]interface{}{"arglists": [][]string{{"x", "options"}}})
+	Intern_("cljs.core", "-key", &X_key, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-key->js", &X_key__GT_js, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "-kv-reduce", &X_kv_reduce, map[string]interface{}{"arglists": [][]string{{"coll", "f", "init"}}})
+	Intern_("cljs.core", "-lookup", &X_lookup, map[string]interface{}{"arglists": [][]string{{"o", "k"}, {"o", "k", "not-found"}}})
+	Intern_("cljs.core", "-meta", &X_meta, map[string]interface{}{"arglists": [][]string{{"o"}}})
+	Intern_("cljs.core", "-methods", &X_methods, map[string]interface{}{"arglists": [][]string{{"mf"}}})
+	Intern_("cljs.core", "-name", &X_name, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "-namespace", &X_namespace, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "-next", &X_next, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-notify-watches", &X_notify_watches, map[string]interface{}{"arglists": [][]string{{"this", "oldval", "newval"}}})
+	Intern_("cljs.core", "-nth", &X_nth, map[string]interface{}{"arglists": [][]string{{"coll", "n"}, {"coll", "n", "not-found"}}})
+	Intern_("cljs.core", "-peek", &X_peek, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-persistent!", &X_persistent_BANG_, map[string]interface{}{"arglists": [][]string{{"tcoll"}}})
+	Intern_("cljs.core", "-pop", &X_pop, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-pop!", &X_pop_BANG_, map[string]interface{}{"arglists": [][]string{{"tcoll"}}})
+	Intern_("cljs.core", "-pr-writer", &X_pr_writer, map[string]interface{}{"arglists": [][]string{{"o", "writer", "opts"}}})
+	Intern_("cljs.core", "-prefer-method", &X_prefer_method, map[string]interface{}{"arglists": [][]string{{"mf", "dispatch-val", "dispatch-val-y"}}})
+	Intern_("cljs.core", "-prefers", &X_prefers, map[string]interface{}{"arglists": [][]string{{"mf"}}})
+	Intern_("cljs.core", "-realized?", &X_realized_QMARK_, map[string]interface{}{"arglists": [][]string{{"d"}}})
+	Intern_("cljs.core", "-reduce", &X_reduce, map[string]interface{}{"arglists": [][]string{{"coll", "f"}, {"coll", "f", "start"}}})
+	Intern_("cljs.core", "-remove-method", &X_remove_method, map[string]interface{}{"arglists": [][]string{{"mf", "dispatch-val"}}})
+	Intern_("cljs.core", "-remove-watch", &X_remove_watch, map[string]interface{}{"arglists": [][]string{{"this", "key"}}})
+	Intern_("cljs.core", "-reset", &X_reset, map[string]interface{}{"arglists": [][]string{{"mf"}}})
+	Intern_("cljs.core", "-reset!", &X_reset_BANG_, map[string]interface{}{"arglists": [][]string{{"o", "new-value"}}})
+	Intern_("cljs.core", "-rest", &X_rest, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-rseq", &X_rseq, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-seq", &X_seq, map[string]interface{}{"arglists": [][]string{{"o"}}})
+	Intern_("cljs.core", "-sorted-seq", &X_sorted_seq, map[string]interface{}{"arglists": [][]string{{"coll", "ascending?"}}})
+	Intern_("cljs.core", "-sorted-seq-from", &X_sorted_seq_from, map[string]interface{}{"arglists": [][]string{{"coll", "k", "ascending?"}}})
+	Intern_("cljs.core", "-swap!", &X_swap_BANG_, map[string]interface{}{"arglists": [][]string{{"o", "f"}, {"o", "f", "a"}, {"o", "f", "a", "b"}, {"o", "f", "a", "b", "xs"}}})
+	Intern_("cljs.core", "-val", &X_val, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "-with-meta", &X_with_meta, map[string]interface{}{"arglists": [][]string{{"o", "meta"}}})
+	Intern_("cljs.core", "-write", &X_write, map[string]interface{}{"arglists": [][]string{{"writer", "s"}}})
+	Intern_("cljs.core", "/", &X_SLASH_, map[string]interface{}{"doc": "If no denominators are supplied, returns 1/numerator,\nelse returns numerator divided by all of the denominators.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "<", &X_LT_, map[string]interface{}{"doc": "Returns non-nil if nums are in monotonically increasing order,\notherwise false.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "<=", &X_LT__EQ_, map[string]interface{}{"doc": "Returns non-nil if nums are in monotonically non-decreasing order,\notherwise false.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "==", &X_EQ__EQ_, map[string]interface{}{"doc": "Returns non-nil if nums all have the equivalent\nvalue, otherwise false. Behavior on non nums is\nundefined.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", ">", &X_GT_, map[string]interface{}{"doc": "Returns non-nil if nums are in monotonically decreasing order,\notherwise false.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", ">=", &X_GT__EQ_, map[string]interface{}{"doc": "Returns non-nil if nums are in monotonically non-increasing order,\notherwise false.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "INIT", &INIT, map[string]interface{}{})
+	Intern_("cljs.core", "ITER_SYMBOL", &ITER_SYMBOL, map[string]interface{}{})
+	Intern_("cljs.core", "START", &START, map[string]interface{}{})
+	Intern_("cljs.core", "accumulating-seq-count", &Accumulating_seq_count, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "aclone", &Aclone, map[string]interface{}{"doc": "Returns a javascript array, cloned from the passed in array", "arglists": [][]string{{"arr"}}})
+	Intern_("cljs.core", "add-watch", &Add_watch, map[string]interface{}{"doc": "Alpha - subject to change.\n\nAdds a watch function to an atom reference. The watch fn must be a\nfn of 4 args: a key, the reference, its old-state, its\nnew-state. Whenever the reference's state might have been changed,\nany registered watches will have their functions called. The watch\nfn will be called synchronously. Note that an atom's state\nmay have changed again prior to the fn call, so use old/new-state\nrather than derefing the reference. Keys must be unique per\nreference, and can be used to remove the watch with remove-watch,\nbut are otherwise considered opaque by the watch mechanism.  Bear in\nmind that regardless of the result or action of the watch fns the\natom's value will change.  Example:\n\n(def a (atom 0))\n(add-watch a :inc (fn [k r o n] (assert (== 0 n))))\n(swap! a inc)\n;; Assertion Error\n(deref a)\n;=> 1", "arglists": [][]string{{"iref", "key", "f"}}})
+	Intern_("cljs.core", "aget", &Aget, map[string]interface{}{"doc": "Returns the value at the index.", "arglists": [][]string{{"array", "i"}, {"array", "i", "&", "idxs"}}})
+	Intern_("cljs.core", "alength", &Alength, map[string]interface{}{"doc": "Returns the length of the array. Works on arrays of all types.", "arglists": [][]string{{"array"}}})
+	Intern_("cljs.core", "alter-meta!", &Alter_meta_BANG_, map[string]interface{}{"doc": "Atomically sets the metadata for a namespace/var/ref/agent/atom to be:\n\n(apply f its-current-meta args)\n\nf must be free of side-effects", "arglists": [][]string{{"iref", "f", "&", "args"}}})
+	Intern_("cljs.core", "ancestors", &Ancestors, map[string]interface{}{"doc": "Returns the immediate and indirect parents of tag, either via a JavaScript type\ninheritance relationship or a relationship established via derive. h\nmust be a hierarchy obtained from make-hierarchy, if not supplied\ndefaults to the global hierarchy", "arglists": [][]string{{"tag"}, {"h", "tag"}}})
+	Intern_("cljs.core", "array->transient-hash-map", &Array__GT_transient_hash_map, map[string]interface{}{"arglists": [][]string{{"len", "arr"}}})
+	Intern_("cljs.core", "array-chunk", &Array_chunk, map[string]interface{}{"arglists": [][]string{{"arr"}, {"arr", "off"}, {"arr", "off", "end"}}})
+	Intern_("cljs.core", "array-copy", &Array_copy, map[string]interface{}{"arglists": [][]string{{"from", "i", "to", "j", "len"}}})
+	Intern_("cljs.core", "array-copy-downward", &Array_copy_downward, map[string]interface{}{"arglists": [][]string{{"from", "i", "to", "j", "len"}}})
+	Intern_("cljs.core", "array-for", &Array_for, map[string]interface{}{"arglists": [][]string{{"pv", "i"}}})
+	Intern_("cljs.core", "array-iter", &Array_iter, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "array-list", &Array_list, map[string]interface{}{"arglists": [][]string{{}}})
+	Intern_("cljs.core", "array-map", &Array_map, map[string]interface{}{"doc": "keyval => key val\nReturns a new array map with supplied mappings.", "arglists": [][]string{{"&", "keyvals"}}})
+	Intern_("cljs.core", "array-map-extend-kv", &Array_map_extend_kv, map[string]interface{}{"arglists": [][]string{{"m", "k", "v"}}})
+	Intern_("cljs.core", "array-map-index-of", &Array_map_index_of, map[string]interface{}{"arglists": [][]string{{"m", "k"}}})
+	Intern_("cljs.core", "array-map-index-of-equiv?", &Array_map_index_of_equiv_QMARK_, map[string]interface{}{"arglists": [][]string{{"arr", "m", "k"}}})
+	Intern_("cljs.core", "array-map-index-of-identical?", &Array_map_index_of_identical_QMARK_, map[string]interface{}{"arglists": [][]string{{"arr", "m", "k"}}})
+	Intern_("cljs.core", "array-map-index-of-keyword?", &Array_map_index_of_keyword_QMARK_, map[string]interface{}{"arglists": [][]string{{"arr", "m", "k"}}})
+	Intern_("cljs.core", "array-map-index-of-nil?", &Array_map_index_of_nil_QMARK_, map[string]interface{}{"arglists": [][]string{{"arr", "m", "k"}}})
+	Intern_("cljs.core", "array-map-index-of-symbol?", &Array_map_index_of_symbol_QMARK_, map[string]interface{}{"arglists": [][]string{{"arr", "m", "k"}}})
+	Intern_("cljs.core", "array-reduce", &Array_reduce, map[string]interface{}{"arglists": [][]string{{"arr", "f"}, {"arr", "f", "val"}, {"arr", "f", "val", "idx"}}})
+	Intern_("cljs.core", "array-seq", &Array_seq, map[string]interface{}{"arglists": [][]string{{"array"}, {"array", "i"}}})
+	Intern_("cljs.core", "array?", &Array_QMARK_, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "aset", &Aset, map[string]interface{}{"doc": "Sets the value at the index.", "arglists": [][]string{{"array", "i", "val"}, {"array", "idx", "idx2", "&", "idxv"}}})
+	Intern_("cljs.core", "assoc", &Assoc, map[string]interface{}{"doc": "assoc[iate]. When applied to a map, returns a new map of the\nsame (hashed/sorted) type, that contains the mapping of key(s) to\nval(s). When applied to a vector, returns a new vector that\ncontains val at index.", "arglists": [][]string{{"coll", "k", "v"}, {"coll", "k", "v", "&", "kvs"}}})
+	Intern_("cljs.core", "assoc!", &Assoc_BANG_, map[string]interface{}{"doc": "When applied to a transient map, adds mapping of key(s) to\nval(s). When applied to a transient vector, sets the val at index.\nNote - index must be <= (count vector). Returns coll.", "arglists": [][]string{{"tcoll", "key", "val"}, {"tcoll", "key", "val", "&", "kvs"}}})
+	Intern_("cljs.core", "assoc-in", &Assoc_in, map[string]interface{}{"doc": "Associates a value in a nested associative structure, where ks is a\nsequence of keys and v is the new value and returns a new nested structure.\nIf any levels do not exist, hash-maps will be created.", "arglists": [][]string{{"m", "p__6082", "v"}}})
+	Intern_("cljs.core", "associative?", &Associative_QMARK_, map[string]interface{}{"doc": "Returns true if coll implements Associative", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "balance-left", &Balance_left, map[string]interface{}{"arglists": [][]string{{"key", "val", "ins", "right"}}})
+	Intern_("cljs.core", "balance-left-del", &Balance_left_del, map[string]interface{}{"arglists": [][]string{{"key", "val", "del", "right"}}})
+	Intern_("cljs.core", "balance-right", &Balance_right, map[string]interface{}{"arglists": [][]string{{"key", "val", "left", "ins"}}})
+	Intern_("cljs.core", "balance-right-del", &Balance_right_del, map[string]interface{}{"arglists": [][]string{{"key", "val", "left", "del"}}})
+	Intern_("cljs.core", "bit-and", &Bit_and, map[string]interface{}{"doc": "Bitwise and", "arglists": [][]string{{"x", "y"}}})
+	Intern_("cljs.core", "bit-and-not", &Bit_and_not, map[string]interface{}{"doc": "Bitwise and", "arglists": [][]string{{"x", "y"}}})
+	Intern_("cljs.core", "bit-clear", &Bit_clear, map[string]interface{}{"doc": "Clear bit at index n", "arglists": [][]string{{"x", "n"}}})
+	Intern_("cljs.core", "bit-count", &Bit_count, map[string]interface{}{"doc": "Counts the number of bits set in n", "arglists": [][]string{{"v"}}})
+	Intern_("cljs.core", "bit-flip", &Bit_flip, map[string]interface{}{"doc": "Flip bit at index n", "arglists": [][]string{{"x", "n"}}})
+	Intern_("cljs.core", "bit-not", &Bit_not, map[string]interface{}{"doc": "Bitwise complement", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "bit-or", &Bit_or, map[string]interface{}{"doc": "Bitwise or", "arglists": [][]string{{"x", "y"}}})
+	Intern_("cljs.core", "bit-set", &Bit_set, map[string]interface{}{"doc": "Set bit at index n", "arglists": [][]string{{"x", "n"}}})
+	Intern_("cljs.core", "bit-shift-left", &Bit_shift_left, map[string]interface{}{"doc": "Bitwise shift left", "arglists": [][]string{{"x", "n"}}})
+	Intern_("cljs.core", "bit-shift-right", &Bit_shift_right, map[string]interface{}{"doc": "Bitwise shift right", "arglists": [][]string{{"x", "n"}}})
+	Intern_("cljs.core", "bit-shift-right-zero-fill", &Bit_shift_right_zero_fill, map[string]interface{}{"doc": "DEPRECATED: Bitwise shift right with zero fill", "arglists": [][]string{{"x", "n"}}})
+	Intern_("cljs.core", "bit-test", &Bit_test, map[string]interface{}{"doc": "Test bit at index n", "arglists": [][]string{{"x", "n"}}})
+	Intern_("cljs.core", "bit-xor", &Bit_xor, map[string]interface{}{"doc": "Bitwise exclusive or", "arglists": [][]string{{"x", "y"}}})
+	Intern_("cljs.core", "bitmap-indexed-node-index", &Bitmap_indexed_node_index, map[string]interface{}{"arglists": [][]string{{"bitmap", "bit"}}})
+	Intern_("cljs.core", "bitpos", &Bitpos, map[string]interface{}{"arglists": [][]string{{"hash", "shift"}}})
+	Intern_("cljs.core", "boolean", &Boolean, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "booleans", &Booleans, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "bounded-count", &Bounded_count, map[string]interface{}{"arglists": [][]string{{"s", "n"}}})
+	Intern_("cljs.core", "build-subvec", &Build_subvec, map[string]interface{}{"arglists": [][]string{{"meta", "v", "start", "end", "__hash"}}})
+	Intern_("cljs.core", "butlast", &Butlast, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "byte", &Byte_, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "bytes", &Bytes, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "cat", &Cat, map[string]interface{}{"doc": "A transducer which concatenates the contents of each input, which must be a\ncollection, into the reduction.", "arglists": [][]string{{"rf"}}})
+	Intern_("cljs.core", "chars", &Chars, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "chunk", &Chunk, map[string]interface{}{"arglists": [][]string{{"b"}}})
+	Intern_("cljs.core", "chunk-append", &Chunk_append, map[string]interface{}{"arglists": [][]string{{"b", "x"}}})
+	Intern_("cljs.core", "chunk-buffer", &Chunk_buffer, map[string]interface{}{"arglists": [][]string{{"capacity"}}})
+	Intern_("cljs.core", "chunk-cons", &Chunk_cons, map[string]interface{}{"arglists": [][]string{{"chunk", "rest"}}})
+	Intern_("cljs.core", "chunk-first", &Chunk_first, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "chunk-next", &Chunk_next, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "chunk-rest", &Chunk_rest, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "chunked-seq", &Chunked_seq, map[string]interface{}{"arglists": [][]string{{"vec", "i", "off"}, {"vec", "node", "i", "off"}, {"vec", "node", "i", "off", "meta"}}})
+	Intern_("cljs.core", "chunked-seq?", &Chunked_seq_QMARK_, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "ci-reduce", &Ci_reduce, map[string]interface{}{"doc": "Accepts any collection which satisfies the ICount and IIndexed protocols and\nreduces them without incurring seq initialization", "arglists": [][]string{{"cicoll", "f"}, {"cicoll", "f", "val"}, {"cicoll", "f", "val", "idx"}}})
+	Intern_("cljs.core", "clone", &Clone, map[string]interface{}{"arglists": [][]string{{"value"}}})
+	Intern_("cljs.core", "clone-and-set", &Clone_and_set, map[string]interface{}{"arglists": [][]string{{"arr", "i", "a"}, {"arr", "i", "a", "j", "b"}}})
+	Intern_("cljs.core", "cloneable?", &Cloneable_QMARK_, map[string]interface{}{"arglists": [][]string{{"value"}}})
+	Intern_("cljs.core", "coll?", &Coll_QMARK_, map[string]interface{}{"doc": "Returns true if x satisfies ICollection", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "comp", &Comp, map[string]interface{}{"doc": "Takes a set of functions and returns a fn that is the composition\nof those fns.  The returned fn takes a variable number of args,\napplies the rightmost of fns to the args, the next\nfn (right-to-left) to the result, etc.", "arglists": [][]string{{}, {"f"}, {"f", "g"}, {"f", "g", "h"}, {"f1", "f2", "f3", "&", "fs"}}})
+	Intern_("cljs.core", "comparator", &Comparator, map[string]interface{}{"doc": "Returns an JavaScript compatible comparator based upon pred.", "arglists": [][]string{{"pred"}}})
+	Intern_("cljs.core", "compare", &Compare, map[string]interface{}{"doc": "Comparator. Returns a negative number, zero, or a positive number\nwhen x is logically 'less than', 'equal to', or 'greater than'\ny. Uses IComparable if available and google.array.defaultCompare for objects\nof the same type and special-cases nil to be less than any other object.", "arglists": [][]string{{"x", "y"}}})
+	Intern_("cljs.core", "compare-indexed", &Compare_indexed, map[string]interface{}{"doc": "Compare indexed collection.", "arglists": [][]string{{"xs", "ys"}, {"xs", "ys", "len", "n"}}})
+	Intern_("cljs.core", "compare-symbols", &Compare_symbols, map[string]interface{}{"arglists": [][]string{{"a", "b"}}})
+	Intern_("cljs.core", "completing", &Completing, map[string]interface{}{"arglists": [][]string{{"f"}, {"f", "cf"}}})
+	Intern_("cljs.core", "concat", &Concat, map[string]interface{}{"doc": "Returns a lazy seq representing the concatenation of the elements in the supplied colls.", "arglists": [][]string{{}, {"x"}, {"x", "y"}, {"x", "y", "&", "zs"}}})
+	Intern_("cljs.core", "conj", &Conj, map[string]interface{}{"doc": "conj[oin]. Returns a new collection with the xs\n'added'. (conj nil item) returns (item).  The 'addition' may\nhappen at different 'places' depending on the concrete type.", "arglists": [][]string{{}, {"coll"}, {"coll", "x"}, {"coll", "x", "&", "xs"}}})
+	Intern_("cljs.core", "conj!", &Conj_BANG_, map[string]interface{}{"doc": "Adds x to the transient collection, and return coll. The 'addition'\nmay happen at different 'places' depending on the concrete type.", "arglists": [][]string{{}, {"coll"}, {"tcoll", "val"}, {"tcoll", "val", "&", "vals"}}})
+	Intern_("cljs.core", "cons", &Cons, map[string]interface{}{"doc": "Returns a new seq where x is the first element and seq is the rest.", "arglists": [][]string{{"x", "coll"}}})
+	Intern_("cljs.core", "constantly", &Constantly, map[string]interface{}{"doc": "Returns a function that takes any number of arguments and returns x.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "contains?", &Contains_QMARK_, map[string]interface{}{"doc": "Returns true if key is present in the given collection, otherwise\nreturns false.  Note that for numerically indexed collections like\nvectors and arrays, this tests if the numeric key is within the\nrange of indexes. 'contains?' operates constant or logarithmic time;\nit will not perform a linear search for a value.  See also 'some'.", "arglists": [][]string{{"coll", "v"}}})
+	Intern_("cljs.core", "count", &Count, map[string]interface{}{"doc": "Returns the number of items in the collection. (count nil) returns\n0.  Also works on strings, arrays, and Maps", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "counted?", &Counted_QMARK_, map[string]interface{}{"doc": "Returns true if coll implements count in constant time", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "create-array-node-seq", &Create_array_node_seq, map[string]interface{}{"arglists": [][]string{{"nodes"}, {"meta", "nodes", "i", "s"}}})
+	Intern_("cljs.core", "create-inode-seq", &Create_inode_seq, map[string]interface{}{"arglists": [][]string{{"nodes"}, {"nodes", "i", "s"}}})
+	Intern_("cljs.core", "create-node", &Create_node, map[string]interface{}{"arglists": [][]string{{"shift", "key1", "val1", "key2hash", "key2", "val2"}, {"edit", "shift", "key1", "val1", "key2hash", "key2", "val2"}}})
+	Intern_("cljs.core", "create-tree-map-seq", &Create_tree_map_seq, map[string]interface{}{"arglists": [][]string{{"tree", "ascending?", "cnt"}}})
+	Intern_("cljs.core", "cycle", &Cycle, map[string]interface{}{"doc": "Returns a lazy (infinite!) sequence of repetitions of the items in coll.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "dec", &Dec, map[string]interface{}{"doc": "Returns a number one less than num.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "dedupe", &Dedupe, map[string]interface{}{"doc": "Returns a lazy sequence removing consecutive duplicates in coll.\nReturns a transducer when no collection is provided.", "arglists": [][]string{{}, {"coll"}}})
+	Intern_("cljs.core", "delay?", &Delay_QMARK_, map[string]interface{}{"doc": "returns true if x is a Delay created with delay", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "derive", &Derive, map[string]interface{}{"doc": "Establishes a parent/child relationship between parent and\ntag. Parent must be a namespace-qualified symbol or keyword and\nchild can be either a namespace-qualified symbol or keyword or a\nclass. h must be a hierarchy obtained from make-hierarchy, if not\nsupplied defaults to, and modifies, the global hierarchy.", "arglists": [][]string{{"tag", "parent"}, {"h", "tag", "parent"}}})
+	Intern_("cljs.core", "descendants", &Descendants, map[string]interface{}{"doc": "Returns the immediate and indirect children of tag, through a\nrelationship established via derive. h must be a hierarchy obtained\nfrom make-hierarchy, if not supplied defaults to the global\nhierarchy. Note: does not work on JavaScript type inheritance\nrelationships.", "arglists": [][]string{{"tag"}, {"h", "tag"}}})
+	Intern_("cljs.core", "disj", &Disj, map[string]interface{}{"doc": "disj[oin]. Returns a new set of the same (hashed/sorted) type, that\ndoes not contain key(s).", "arglists": [][]string{{"coll"}, {"coll", "k"}, {"coll", "k", "&", "ks"}}})
+	Intern_("cljs.core", "disj!", &Disj_BANG_, map[string]interface{}{"doc": "disj[oin]. Returns a transient set of the same (hashed/sorted) type, that\ndoes not contain key(s).", "arglists": [][]string{{"tcoll", "val"}, {"tcoll", "val", "&", "vals"}}})
+	Intern_("cljs.core", "dissoc", &Dissoc, map[string]interface{}{"doc": "dissoc[iate]. Returns a new map of the same (hashed/sorted) type,\nthat does not contain a mapping for key(s).", "arglists": [][]string{{"coll"}, {"coll", "k"}, {"coll", "k", "&", "ks"}}})
+	Intern_("cljs.core", "dissoc!", &Dissoc_BANG_, map[string]interface{}{"doc": "Returns a transient map that doesn't contain a mapping for key(s).", "arglists": [][]string{{"tcoll", "key"}, {"tcoll", "key", "&", "ks"}}})
+	Intern_("cljs.core", "distinct", &Distinct, map[string]interface{}{"doc": "Returns a lazy sequence of the elements of coll with duplicates removed", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "distinct?", &Distinct_QMARK_, map[string]interface{}{"doc": "Returns true if no two of the arguments are =", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "do-assoc", &Do_assoc, map[string]interface{}{"arglists": [][]string{{"pv", "level", "node", "i", "val"}}})
+	Intern_("cljs.core", "doall", &Doall, map[string]interface{}{"doc": "When lazy sequences are produced via functions that have side\neffects, any effects other than those needed to produce the first\nelement in the seq do not occur until the seq is consumed. doall can\nbe used to force any effects. Walks through the successive nexts of\nthe seq, retains the head and returns it, thus causing the entire\nseq to reside in memory at one time.", "arglists": [][]string{{"coll"}, {"n", "coll"}}})
+	Intern_("cljs.core", "dominates", &Dominates, map[string]interface{}{"arglists": [][]string{{"x", "y", "prefer-table"}}})
+	Intern_("cljs.core", "dorun", &Dorun, map[string]interface{}{"doc": "When lazy sequences are produced via functions that have side\neffects, any effects other than those needed to produce the first\nelement in the seq do not occur until the seq is consumed. dorun can\nbe used to force any effects. Walks through the successive nexts of\nthe seq, does not retain the head and returns nil.", "arglists": [][]string{{"coll"}, {"n", "coll"}}})
+	Intern_("cljs.core", "double", &Double, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "double-array", &Double_array, map[string]interface{}{"arglists": [][]string{{"size-or-seq"}, {"size", "init-val-or-seq"}}})
+	Intern_("cljs.core", "doubles", &Doubles, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "drop", &Drop, map[string]interface{}{"doc": "Returns a lazy sequence of all but the first n items in coll.\nReturns a stateful transducer when no collection is provided.", "arglists": [][]string{{"n"}, {"n", "coll"}}})
+	Intern_("cljs.core", "drop-last", &Drop_last, map[string]interface{}{"doc": "Return a lazy sequence of all but the last n (default 1) items in coll", "arglists": [][]string{{"s"}, {"n", "s"}}})
+	Intern_("cljs.core", "drop-while", &Drop_while, map[string]interface{}{"doc": "Returns a lazy sequence of the items in coll starting from the\nfirst item for which (pred item) returns logical false.  Returns a\nstateful transducer when no collection is provided.", "arglists": [][]string{{"pred"}, {"pred", "coll"}}})
+	Intern_("cljs.core", "edit-and-set", &Edit_and_set, map[string]interface{}{"arglists": [][]string{{"inode", "edit", "i", "a"}, {"inode", "edit", "i", "a", "j", "b"}}})
+	Intern_("cljs.core", "eduction", &Eduction, map[string]interface{}{"doc": "Returns a reducible/iterable/seqable application of\nthe transducer to the items in coll. Note that these applications\nwill be performed every time iterator/seq/reduce is called.", "arglists": [][]string{{"xform", "coll"}}})
+	Intern_("cljs.core", "empty", &Empty, map[string]interface{}{"doc": "Returns an empty collection of the same category as coll, or nil", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "empty?", &Empty_QMARK_, map[string]interface{}{"doc": "Returns true if coll has no items - same as (not (seq coll)).\nPlease use the idiom (seq x) rather than (not (empty? x))", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "ensure-reduced", &Ensure_reduced, map[string]interface{}{"doc": "If x is already reduced?, returns it, else returns (reduced x)", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "equiv-map", &Equiv_map, map[string]interface{}{"doc": "Assumes y is a map. Returns true if x equals y, otherwise returns\nfalse.", "arglists": [][]string{{"x", "y"}}})
+	Intern_("cljs.core", "equiv-sequential", &Equiv_sequential, map[string]interface{}{"doc": "Assumes x is sequential. Returns true if x equals y, otherwise\nreturns false.", "arglists": [][]string{{"x", "y"}}})
+	Intern_("cljs.core", "es6-entries-iterator", &Es6_entries_iterator, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "es6-iterator", &Es6_iterator, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "es6-iterator-seq", &Es6_iterator_seq, map[string]interface{}{"arglists": [][]string{{"iter"}}})
+	Intern_("cljs.core", "es6-set-entries-iterator", &Es6_set_entries_iterator, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "even?", &Even_QMARK_, map[string]interface{}{"doc": "Returns true if n is even, throws an exception if n is not an integer", "arglists": [][]string{{"n"}}})
+	Intern_("cljs.core", "every-pred", &Every_pred, map[string]interface{}{"doc": "Takes a set of predicates and returns a function f that returns true if all of its\ncomposing predicates return a logical true value against all of its arguments, else it returns\nfalse. Note that f is short-circuiting in that it will stop execution on the first\nargument that triggers a logical false result against the original predicates.", "arglists": [][]string{{"p"}, {"p1", "p2"}, {"p1", "p2", "p3"}, {"p1", "p2", "p3", "&", "ps"}}})
+	Intern_("cljs.core", "every?", &Every_QMARK_, map[string]interface{}{"doc": "Returns true if (pred x) is logical true for every x in coll, else\nfalse.", "arglists": [][]string{{"pred", "coll"}}})
+	Intern_("cljs.core", "ex-cause", &Ex_cause, map[string]interface{}{"doc": "Alpha - subject to change.\nReturns exception cause (an Error / ExceptionInfo) if ex is an\nExceptionInfo.\nOtherwise returns nil.", "arglists": [][]string{{"ex"}}})
+	Intern_("cljs.core", "ex-data", &Ex_data, map[string]interface{}{"doc": "Alpha - subject to change.\nReturns exception data (a map) if ex is an ExceptionInfo.\nOtherwise returns nil.", "arglists": [][]string{{"ex"}}})
+	Intern_("cljs.core", "ex-info", &Ex_info, map[string]interface{}{"doc": "Alpha - subject to change.\nCreate an instance of ExceptionInfo, an Error type that carries a\nmap of additional data.", "arglists": [][]string{{"msg", "map"}, {"msg", "map", "cause"}}})
+	Intern_("cljs.core", "ex-message", &Ex_message, map[string]interface{}{"doc": "Alpha - subject to change.\nReturns the message attached to the given Error / ExceptionInfo object.\nFor non-Errors returns nil.", "arglists": [][]string{{"ex"}}})
+	Intern_("cljs.core", "extend-object!", &Extend_object_BANG_, map[string]interface{}{"doc": "Takes a JavaScript object and a map of names to functions and\nattaches said functions as methods on the object.  Any references to\nJavaScript's implict this (via the this-as macro) will resolve to the\nobject that the function is attached.", "arglists": [][]string{{"obj", "fn-map"}}})
+	Intern_("cljs.core", "false?", &False_QMARK_, map[string]interface{}{"doc": "Returns true if x is the value false, false otherwise.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "ffirst", &Ffirst, map[string]interface{}{"doc": "Same as (first (first x))", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "filter", &Filter, map[string]interface{}{"doc": "Returns a lazy sequence of the items in coll for which\n(pred item) returns true. pred must be free of side-effects.\nReturns a transducer when no collection is provided.", "arglists": [][]string{{"pred"}, {"pred", "coll"}}})
+	Intern_("cljs.core", "filterv", &Filterv, map[string]interface{}{"doc": "Returns a vector of the items in coll for which\n(pred item) returns true. pred must be free of side-effects.", "arglists": [][]string{{"pred", "coll"}}})
+	Intern_("cljs.core", "find", &Find, map[string]interface{}{"doc": "Returns the map entry for key, or nil if key not present.", "arglists": [][]string{{"coll", "k"}}})
+	Intern_("cljs.core", "find-and-cache-best-method", &Find_and_cache_best_method, map[string]interface{}{"arglists": [][]string{{"name", "dispatch-val", "hierarchy", "method-table", "prefer-table", "method-cache", "cached-hierarchy"}}})
+	Intern_("cljs.core", "first", &First, map[string]interface{}{"doc": "Returns the first item in the collection. Calls seq on its\nargument. If coll is nil, returns nil.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "first-array-for-longvec", &First_array_for_longvec, map[string]interface{}{"arglists": [][]string{{"pv"}}})
+	Intern_("cljs.core", "fix", &Fix, map[string]interface{}{"arglists": [][]string{{"q"}}})
+	Intern_("cljs.core", "fixture1", &Fixture1, map[string]interface{}{})
+	Intern_("cljs.core", "fixture2", &Fixture2, map[string]interface{}{})
+	Intern_("cljs.core", "flatten", &Flatten, map[string]interface{}{"doc": "Takes any nested combination of sequential things (lists, vectors,\netc.) and returns their contents as a single, flat sequence.\n(flatten nil) returns nil.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "flatten1", &Flatten1, map[string]interface{}{"doc": "Take a collection of collections, and return a lazy seq\nof items from the inner collection", "arglists": [][]string{{"colls"}}})
+	Intern_("cljs.core", "float", &Float_, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "floats", &Floats, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "flush", &Flush, map[string]interface{}{"arglists": [][]string{{}}})
+	Intern_("cljs.core", "fn->comparator", &Fn__GT_comparator, map[string]interface{}{"doc": "Given a fn that might be boolean valued or a comparator,\nreturn a fn that is a comparator.", "arglists": [][]string{{"f"}}})
+	Intern_("cljs.core", "fn?", &Fn_QMARK_, map[string]interface{}{"arglists": [][]string{{"f"}}})
+	Intern_("cljs.core", "fnext", &Fnext, map[string]interface{}{"doc": "Same as (first (next x))", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "fnil", &Fnil, map[string]interface{}{"doc": "Takes a function f, and returns a function that calls f, replacing\na nil first argument to f with the supplied value x. Higher arity\nversions can replace arguments in the second and third\npositions (y, z). Note that the function f can take any number of\narguments, not just the one(s) being nil-patched.", "arglists": [][]string{{"f", "x"}, {"f", "x", "y"}, {"f", "x", "y", "z"}}})
+	Intern_("cljs.core", "force", &Force, map[string]interface{}{"doc": "If x is a Delay, returns the (possibly cached) value of its expression, else returns x", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "frequencies", &Frequencies, map[string]interface{}{"doc": "Returns a map from distinct items in coll to the number of times\nthey appear.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "gensym", &Gensym, map[string]interface{}{"doc": "Returns a new symbol with a unique name. If a prefix string is\nsupplied, the name is prefix# where # is some unique number. If\nprefix is not supplied, the prefix is 'G__'.", "arglists": [][]string{{}, {"prefix-string"}}})
+	Intern_("cljs.core", "gensym-counter", &Gensym_counter, map[string]interface{}{})
+	Intern_("cljs.core", "get-global-hierarchy", &Get_global_hierarchy, map[string]interface{}{"arglists": [][]string{{}}})
+	Intern_("cljs.core", "get-in", &Get_in, map[string]interface{}{"doc": "Returns the value in a nested associative structure,\nwhere ks is a sequence of keys. Returns nil if the key is not present,\nor the not-found value if supplied.", "arglists": [][]string{{"m", "ks"}, {"m", "ks", "not-found"}}})
+	Intern_("cljs.core", "get-method", &Get_method, map[string]interface{}{"doc": "Given a multimethod and a dispatch value, returns the dispatch fn\nthat would apply to that value, or nil if none apply and no default", "arglists": [][]string{{"multifn", "dispatch-val"}}})
+	Intern_("cljs.core", "group-by", &Group_by, map[string]interface{}{"doc": "Returns a map of the elements of coll keyed by the result of\nf on each element. The value at each key will be a vector of the\ncorresponding elements, in the order they appeared in coll.", "arglists": [][]string{{"f", "coll"}}})
+	Intern_("cljs.core", "hash", &Hash, map[string]interface{}{"arglists": [][]string{{"o"}}})
+	Intern_("cljs.core", "hash-coll", &Hash_coll, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "hash-collision-node-find-index", &Hash_collision_node_find_index, map[string]interface{}{"arglists": [][]string{{"arr", "cnt", "key"}}})
+	Intern_("cljs.core", "hash-combine", &Hash_combine, map[string]interface{}{"arglists": [][]string{{"seed", "hash"}}})
+	Intern_("cljs.core", "hash-imap", &Hash_imap, map[string]interface{}{"arglists": [][]string{{"m"}}})
+	Intern_("cljs.core", "hash-iset", &Hash_iset, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "hash-keyword", &Hash_keyword, map[string]interface{}{"arglists": [][]string{{"k"}}})
+	Intern_("cljs.core", "hash-map", &Hash_map, map[string]interface{}{"doc": "keyval => key val\nReturns a new hash map with supplied mappings.", "arglists": [][]string{{"&", "keyvals"}}})
+	Intern_("cljs.core", "hash-ordered-coll", &Hash_ordered_coll, map[string]interface{}{"doc": "Returns the hash code, consistent with =, for an external ordered\ncollection implementing Iterable.\nSee http://clojure.org/data_structures#hash for full algorithms.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "hash-set", &Hash_set, map[string]interface{}{"arglists": [][]string{{}, {"&", "keys"}}})
+	Intern_("cljs.core", "hash-string*", &Hash_string_STAR_, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "hash-symbol", &Hash_symbol, map[string]interface{}{"arglists": [][]string{{"sym"}}})
+	Intern_("cljs.core", "hash-unordered-coll", &Hash_unordered_coll, map[string]interface{}{"doc": "Returns the hash code, consistent with =, for an external unordered\ncollection implementing Iterable. For maps, the iterator should\nreturn map entries whose hash is computed as\n(hash-ordered-coll [k v]).\nSee http://clojure.org/data_structures#hash for full algorithms.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "identical?", &Identical_QMARK_, map[string]interface{}{"doc": "Tests if 2 arguments are the same object", "arglists": [][]string{{"x", "y"}}})
+	Intern_("cljs.core", "ifn?", &Ifn_QMARK_, map[string]interface{}{"arglists": [][]string{{"f"}}})
+	Intern_("cljs.core", "imul", &Imul, map[string]interface{}{})
+	Intern_("cljs.core", "inc", &Inc, map[string]interface{}{"doc": "Returns a number one greater than num.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "indexed?", &Indexed_QMARK_, map[string]interface{}{"doc": "Returns true if coll implements nth in constant time", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "inode-kv-reduce", &Inode_kv_reduce, map[string]interface{}{"arglists": [][]string{{"arr", "f", "init"}}})
+	Intern_("cljs.core", "int", &Int_, map[string]interface{}{"doc": "Coerce to int by stripping decimal places.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "int-array", &Int_array, map[string]interface{}{"arglists": [][]string{{"size-or-seq"}, {"size", "init-val-or-seq"}}})
+	Intern_("cljs.core", "int-rotate-left", &Int_rotate_left, map[string]interface{}{"arglists": [][]string{{"x", "n"}}})
+	Intern_("cljs.core", "interleave", &Interleave, map[string]interface{}{"doc": "Returns a lazy seq of the first item in each coll, then the second etc.", "arglists": [][]string{{"c1", "c2"}, {"c1", "c2", "&", "colls"}}})
+	Intern_("cljs.core", "interpose", &Interpose, map[string]interface{}{"doc": "Returns a lazy seq of the elements of coll separated by sep", "arglists": [][]string{{"sep", "coll"}}})
+	Intern_("cljs.core", "into", &Into, map[string]interface{}{"doc": "Returns a new coll consisting of to-coll with all of the items of\nfrom-coll conjoined. A transducer may be supplied.", "arglists": [][]string{{"to", "from"}, {"to", "xform", "from"}}})
+	Intern_("cljs.core", "into-array", &Into_array, map[string]interface{}{"arglists": [][]string{{"aseq"}, {"type", "aseq"}}})
+	Intern_("cljs.core", "ints", &Ints, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "isa?", &Isa_QMARK_, map[string]interface{}{"doc": "Returns true if (= child parent), or child is directly or indirectly derived from\nparent, either via a JavaScript type inheritance relationship or a\nrelationship established via derive. h must be a hierarchy obtained\nfrom make-hierarchy, if not supplied defaults to the global\nhierarchy", "arglists": [][]string{{"child", "parent"}, {"h", "child", "parent"}}})
+	Intern_("cljs.core", "iter", &Iter, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "iterable?", &Iterable_QMARK_, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "iterate", &Iterate, map[string]interface{}{"doc": "Returns a lazy sequence of x, (f x), (f (f x)) etc. f must be free of side-effects", "arglists": [][]string{{"f", "x"}}})
+	Intern_("cljs.core", "js-delete", &Js_delete, map[string]interface{}{"arglists": [][]string{{"obj", "key"}}})
+	Intern_("cljs.core", "js-invoke", &Js_invoke, map[string]interface{}{"doc": "Invoke JavaScript object method via string. Needed when the\nstring is not a valid unquoted property name.", "arglists": [][]string{{"obj", "s", "&", "args"}}})
+	Intern_("cljs.core", "js-mod", &Js_mod, map[string]interface{}{"doc": "Modulus of num and div with original javascript behavior. i.e. bug for negative numbers", "arglists": [][]string{{"n", "d"}}})
+	Intern_("cljs.core", "juxt", &Juxt, map[string]interface{}{"doc": "Takes a set of functions and returns a fn that is the juxtaposition\nof those fns.  The returned fn takes a variable number of args, and\nreturns a vector containing the result of applying each fn to the\nargs (left-to-right).\n((juxt a b c) x) => [(a x) (b x) (c x)]", "arglists": [][]string{{"f"}, {"f", "g"}, {"f", "g", "h"}, {"f", "g", "h", "&", "fs"}}})
+	Intern_("cljs.core", "keep", &Keep, map[string]interface{}{"doc": "Returns a lazy sequence of the non-nil results of (f item). Note,\nthis means false return values will be included.  f must be free of\nside-effects.  Returns a transducer when no collection is provided.", "arglists": [][]string{{"f"}, {"f", "coll"}}})
+	Intern_("cljs.core", "keep-indexed", &Keep_indexed, map[string]interface{}{"doc": "Returns a lazy sequence of the non-nil results of (f index item). Note,\nthis means false return values will be included.  f must be free of\nside-effects.  Returns a stateful transducer when no collection is\nprovided.", "arglists": [][]string{{"f"}, {"f", "coll"}}})
+	Intern_("cljs.core", "key", &Key, map[string]interface{}{"doc": "Returns the key of the map entry.", "arglists": [][]string{{"map-entry"}}})
+	Intern_("cljs.core", "key-test", &Key_test, map[string]interface{}{"arglists": [][]string{{"key", "other"}}})
+	Intern_("cljs.core", "keys", &Keys, map[string]interface{}{"doc": "Returns a sequence of the map's keys.", "arglists": [][]string{{"hash-map"}}})
+	Intern_("cljs.core", "keyword", &Keyword, map[string]interface{}{"doc": "Returns a Keyword with the given namespace and name.  Do not use :\nin the keyword strings, it will be added automatically.", "arglists": [][]string{{"name"}, {"ns", "name"}}})
+	Intern_("cljs.core", "keyword-identical?", &Keyword_identical_QMARK_, map[string]interface{}{"arglists": [][]string{{"x", "y"}}})
+	Intern_("cljs.core", "keyword?", &Keyword_QMARK_, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "last", &Last, map[string]interface{}{"doc": "Return the last item in coll, in linear time", "arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "lazy-transformer", &Lazy_transformer, map[string]interface{}{"arglists": [][]string{{"stepper"}}})
+	Intern_("cljs.core", "linear-traversal-nth", &Linear_traversal_nth, map[string]interface{}{"arglists": [][]string{{"coll", "n"}, {"coll", "n", "not-found"}}})
+	Intern_("cljs.core", "list", &List, map[string]interface{}{"arglists": [][]string{{"&", "xs"}}})
+	Intern_("cljs.core", "list*", &List_STAR_, map[string]interface{}{"doc": "Creates a new list containing the items prepended to the rest, the\nlast of which will be treated as a sequence.", "arglists": [][]string{{"args"}, {"a", "args"}, {"a", "b", "args"}, {"a", "b", "c", "args"}, {"a", "b", "c", "d", "&", "more"}}})
+	Intern_("cljs.core", "list?", &List_QMARK_, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "long", &Long, map[string]interface{}{"doc": "Coerce to long by stripping decimal places. Identical to `int'.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "long-array", &Long_array, map[string]interface{}{"arglists": [][]string{{"size-or-seq"}, {"size", "init-val-or-seq"}}})
+	Intern_("cljs.core", "longs", &Longs, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "lookup-sentinel", &Lookup_sentinel, map[string]interface{}{})
+	Intern_("cljs.core", "m3-C1", &M3_C1, map[string]interface{}{})
+	Intern_("cljs.core", "m3-C2", &M3_C2, map[string]interface{}{})
+	Intern_("cljs.core", "m3-fmix", &M3_fmix, map[string]interface{}{"arglists": [][]string{{"h1", "len"}}})
+	Intern_("cljs.core", "m3-hash-int", &M3_hash_int, map[string]interface{}{"arglists": [][]string{{"in"}}})
+	Intern_("cljs.core", "m3-hash-unencoded-chars", &M3_hash_unencoded_chars, map[string]interface{}{"arglists": [][]string{{"in"}}})
+	Intern_("cljs.core", "m3-mix-H1", &M3_mix_H1, map[string]interface{}{"arglists": [][]string{{"h1", "k1"}}})
+	Intern_("cljs.core", "m3-mix-K1", &M3_mix_K1, map[string]interface{}{"arglists": [][]string{{"k1"}}})
+	Intern_("cljs.core", "m3-seed", &M3_seed, map[string]interface{}{})
+	Intern_("cljs.core", "make-hierarchy", &Make_hierarchy, map[string]interface{}{"doc": "Creates a hierarchy object for use with derive, isa? etc.", "arglists": [][]string{{}}})
+	Intern_("cljs.core", "map", &Map_, map[string]interface{}{"doc": "Returns a lazy sequence consisting of the result of applying f to\nthe set of first items of each coll, followed by applying f to the\nset of second items in each coll, until any one of the colls is\nexhausted.  Any remaining items in other colls are ignored. Function\nf should accept number-of-colls arguments. Returns a transducer when\nno collection is provided.", "arglists": [][]string{{"f"}, {"f", "coll"}, {"f", "c1", "c2"}, {"f", "c1", "c2", "c3"}, {"f", "c1", "c2", "c3", "&", "colls"}}})
+	Intern_("cljs.core", "map-indexed", &Map_indexed, map[string]interface{}{"doc": "Returns a lazy sequence consisting of the result of applying f to 0\nand the first item of coll, followed by applying f to 1 and the second\nitem in coll, etc, until coll is exhausted. Thus function f should\naccept 2 arguments, index and item.", "arglists": [][]string{{"f", "coll"}}})
+	Intern_("cljs.core", "map?", &Map_QMARK_, map[string]interface{}{"doc": "Return true if x satisfies IMap", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "mapcat", &Mapcat, map[string]interface{}{"doc": "Returns the result of applying concat to the result of applying map\nto f and colls.  Thus function f should return a collection. Returns\na transducer when no collections are provided", "arglists": [][]string{{"f"}, {"f", "&", "colls"}}})
+	Intern_("cljs.core", "mapv", &Mapv, map[string]interface{}{"doc": "Returns a vector consisting of the result of applying f to the\nset of first items of each coll, followed by applying f to the set\nof second items in each coll, until any one of the colls is\nexhausted.  Any remaining items in other colls are ignored. Function\nf should accept number-of-colls arguments.", "arglists": [][]string{{"f", "coll"}, {"f", "c1", "c2"}, {"f", "c1", "c2", "c3"}, {"f", "c1", "c2", "c3", "&", "colls"}}})
+	Intern_("cljs.core", "mask", &Mask, map[string]interface{}{"arglists": [][]string{{"hash", "shift"}}})
+	Intern_("cljs.core", "max", &Max, map[string]interface{}{"doc": "Returns the greatest of the nums.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "max-key", &Max_key, map[string]interface{}{"doc": "Returns the x for which (k x), a number, is greatest.", "arglists": [][]string{{"k", "x"}, {"k", "x", "y"}, {"k", "x", "y", "&", "more"}}})
+	Intern_("cljs.core", "memoize", &Memoize, map[string]interface{}{"doc": "Returns a memoized version of a referentially transparent function. The\nmemoized version of the function keeps a cache of the mapping from arguments\nto results and, when calls with the same arguments are repeated often, has\nhigher performance at the expense of higher memory use.", "arglists": [][]string{{"f"}}})
+	Intern_("cljs.core", "merge", &Merge, map[string]interface{}{"doc": "Returns a map that consists of the rest of the maps conj-ed onto\nthe first.  If a key occurs in more than one map, the mapping from\nthe latter (left-to-right) will be the mapping in the result.", "arglists": [][]string{{"&", "maps"}}})
+	Intern_("cljs.core", "merge-with", &Merge_with, map[string]interface{}{"doc": "Returns a map that consists of the rest of the maps conj-ed onto\nthe first.  If a key occurs in more than one map, the mapping(s)\nfrom the latter (left-to-right) will be combined with the mapping in\nthe result by calling (f val-in-result val-in-latter).", "arglists": [][]string{{"f", "&", "maps"}}})
+	Intern_("cljs.core", "meta", &Meta, map[string]interface{}{"doc": "Returns the metadata of obj, returns nil if there is no metadata.", "arglists": [][]string{{"o"}}})
+	Intern_("cljs.core", "methods", &Methods, map[string]interface{}{"doc": "Given a multimethod, returns a map of dispatch values -> dispatch fns", "arglists": [][]string{{"multifn"}}})
+	Intern_("cljs.core", "min", &Min, map[string]interface{}{"doc": "Returns the least of the nums.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "min-key", &Min_key, map[string]interface{}{"doc": "Returns the x for which (k x), a number, is least.", "arglists": [][]string{{"k", "x"}, {"k", "x", "y"}, {"k", "x", "y", "&", "more"}}})
+	Intern_("cljs.core", "mix-collection-hash", &Mix_collection_hash, map[string]interface{}{"doc": "Mix final collection hash for ordered or unordered collections.\nhash-basis is the combined collection hash, count is the number\nof elements included in the basis. Note this is the hash code\nconsistent with =, different from .hashCode.\nSee http://clojure.org/data_structures#hash for full algorithms.", "arglists": [][]string{{"hash-basis", "count"}}})
+	Intern_("cljs.core", "mk-bound-fn", &Mk_bound_fn, map[string]interface{}{"arglists": [][]string{{"sc", "test", "key"}}})
+	Intern_("cljs.core", "mod", &Mod, map[string]interface{}{"doc": "Modulus of num and div. Truncates toward negative infinity.", "arglists": [][]string{{"n", "d"}}})
+	Intern_("cljs.core", "multi-stepper", &Multi_stepper, map[string]interface{}{"arglists": [][]string{{"xform", "iters"}, {"xform", "iters", "nexts"}}})
+	Intern_("cljs.core", "name", &Name, map[string]interface{}{"doc": "Returns the name String of a string, symbol or keyword.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "namespace", &Namespace, map[string]interface{}{"doc": "Returns the namespace String of a symbol or keyword, or nil if not present.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "neg?", &Neg_QMARK_, map[string]interface{}{"doc": "Returns true if num is less than zero, else false", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "never-equiv", &Never_equiv, map[string]interface{}{})
+	Intern_("cljs.core", "new-path", &New_path, map[string]interface{}{"arglists": [][]string{{"edit", "level", "node"}}})
+	Intern_("cljs.core", "newline", &Newline, map[string]interface{}{"arglists": [][]string{{"opts"}}})
+	Intern_("cljs.core", "next", &Next, map[string]interface{}{"doc": "Returns a seq of the items after the first. Calls seq on its\nargument.  If there are no more items, returns nil", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "nfirst", &Nfirst, map[string]interface{}{"doc": "Same as (next (first x))", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "nil-iter", &Nil_iter, map[string]interface{}{"arglists": [][]string{{}}})
+	Intern_("cljs.core", "nil?", &Nil_QMARK_, map[string]interface{}{"doc": "Returns true if x is nil, false otherwise.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "nnext", &Nnext, map[string]interface{}{"doc": "Same as (next (next x))", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "not", &Not, map[string]interface{}{"doc": "Returns true if x is logical false, false otherwise.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "not-any?", &Not_any_QMARK_, map[string]interface{}{"doc": "Returns false if (pred x) is logical true for any x in coll,\nelse true.", "arglists": [][]string{{"pred", "coll"}}})
+	Intern_("cljs.core", "not-empty", &Not_empty, map[string]interface{}{"doc": "If coll is empty, returns nil, else coll", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "not-every?", &Not_every_QMARK_, map[string]interface{}{"doc": "Returns false if (pred x) is logical true for every x in\ncoll, else true.", "arglists": [][]string{{"pred", "coll"}}})
+	Intern_("cljs.core", "not-native", &Not_native, map[string]interface{}{})
+	Intern_("cljs.core", "not=", &Not_EQ_, map[string]interface{}{"doc": "Same as (not (= obj1 obj2))", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "nth", &Nth, map[string]interface{}{"doc": "Returns the value at the index. get returns nil if index out of\nbounds, nth throws an exception unless not-found is supplied.  nth\nalso works for strings, arrays, regex Matchers and Lists, and,\nin O(n) time, for sequences.", "arglists": [][]string{{"coll", "n"}, {"coll", "n", "not-found"}}})
+	Intern_("cljs.core", "nthnext", &Nthnext, map[string]interface{}{"doc": "Returns the nth next of coll, (seq coll) when n is 0.", "arglists": [][]string{{"coll", "n"}}})
+	Intern_("cljs.core", "nthrest", &Nthrest, map[string]interface{}{"doc": "Returns the nth rest of coll, coll when n is 0.", "arglists": [][]string{{"coll", "n"}}})
+	Intern_("cljs.core", "number?", &Number_QMARK_, map[string]interface{}{"arglists": [][]string{{"n"}}})
+	Intern_("cljs.core", "obj-map->hash-map", &Obj_map__GT_hash_map, map[string]interface{}{"arglists": [][]string{{"m", "k", "v"}}})
+	Intern_("cljs.core", "obj-map-compare-keys", &Obj_map_compare_keys, map[string]interface{}{"arglists": [][]string{{"a", "b"}}})
+	Intern_("cljs.core", "object-array", &Object_array, map[string]interface{}{"arglists": [][]string{{"size-or-seq"}, {"size", "init-val-or-seq"}}})
+	Intern_("cljs.core", "odd?", &Odd_QMARK_, map[string]interface{}{"doc": "Returns true if n is odd, throws an exception if n is not an integer", "arglists": [][]string{{"n"}}})
+	Intern_("cljs.core", "pack-array-node", &Pack_array_node, map[string]interface{}{"arglists": [][]string{{"array-node", "edit", "idx"}}})
+	Intern_("cljs.core", "parents", &Parents, map[string]interface{}{"doc": "Returns the immediate parents of tag, either via a JavaScript type\ninheritance relationship or a relationship established via derive. h\nmust be a hierarchy obtained from make-hierarchy, if not supplied\ndefaults to the global hierarchy", "arglists": [][]string{{"tag"}, {"h", "tag"}}})
+	Intern_("cljs.core", "partial", &Partial, map[string]interface{}{"doc": "Takes a function f and fewer than the normal arguments to f, and\nreturns a fn that takes a variable number of additional args. When\ncalled, the returned function calls f with args + additional args.", "arglists": [][]string{{"f"}, {"f", "arg1"}, {"f", "arg1", "arg2"}, {"f", "arg1", "arg2", "arg3"}, {"f", "arg1", "arg2", "arg3", "&", "more"}}})
+	Intern_("cljs.core", "partition", &Partition, map[string]interface{}{"doc": "Returns a lazy sequence of lists of n items each, at offsets step\napart. If step is not supplied, defaults to n, i.e. the partitions\ndo not overlap. If a pad collection is supplied, use its elements as\nnecessary to complete last partition upto n items. In case there are\nnot enough padding elements, return a partition with less than n items.", "arglists": [][]string{{"n", "coll"}, {"n", "step", "coll"}, {"n", "step", "pad", "coll"}}})
+	Intern_("cljs.core", "partition-all", &Partition_all, map[string]interface{}{"doc": "Returns a lazy sequence of lists like partition, but may include\npartitions with fewer than n items at the end.  Returns a stateful\ntransducer when no collection is provided.", "arglists": [][]string{{"n"}, {"n", "coll"}, {"n", "step", "coll"}}})
+	Intern_("cljs.core", "partition-by", &Partition_by, map[string]interface{}{"doc": "Applies f to each value in coll, splitting it each time f returns a\nnew value.  Returns a lazy seq of partitions.  Returns a stateful\ntransducer when no collection is provided.", "arglists": [][]string{{"f"}, {"f", "coll"}}})
+	Intern_("cljs.core", "peek", &Peek, map[string]interface{}{"doc": "For a list or queue, same as first, for a vector, same as, but much\nmore efficient than, last. If the collection is empty, returns nil.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "persistent!", &Persistent_BANG_, map[string]interface{}{"doc": "Returns a new, persistent version of the transient collection, in\nconstant time. The transient collection cannot be used after this\ncall, any such use will throw an exception.", "arglists": [][]string{{"tcoll"}}})
+	Intern_("cljs.core", "persistent-array-map-seq", &Persistent_array_map_seq, map[string]interface{}{"arglists": [][]string{{"arr", "i", "_meta"}}})
+	Intern_("cljs.core", "pop", &Pop, map[string]interface{}{"doc": "For a list or queue, returns a new list/queue without the first\nitem, for a vector, returns a new vector without the last item.\nNote - not the same as next/butlast.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "pop!", &Pop_BANG_, map[string]interface{}{"doc": "Removes the last item from a transient vector. If\nthe collection is empty, throws an exception. Returns coll", "arglists": [][]string{{"tcoll"}}})
+	Intern_("cljs.core", "pop-tail", &Pop_tail, map[string]interface{}{"arglists": [][]string{{"pv", "level", "node"}}})
+	Intern_("cljs.core", "pos?", &Pos_QMARK_, map[string]interface{}{"doc": "Returns true if num is greater than zero, else false", "arglists": [][]string{{"n"}}})
+	Intern_("cljs.core", "pr", &Pr, map[string]interface{}{"doc": "Prints the object(s) using string-print.  Prints the\nobject(s), separated by spaces if there is more than one.\nBy default, pr and prn print in a way that objects can be\nread by the reader", "arglists": [][]string{{"&", "objs"}}})
+	Intern_("cljs.core", "pr-opts", &Pr_opts, map[string]interface{}{"arglists": [][]string{{}}})
+	Intern_("cljs.core", "pr-sb-with-opts", &Pr_sb_with_opts, map[string]interface{}{"arglists": [][]string{{"objs", "opts"}}})
+	Intern_("cljs.core", "pr-seq-writer", &Pr_seq_writer, map[string]interface{}{"arglists": [][]string{{"objs", "writer", "opts"}}})
+	Intern_("cljs.core", "pr-str", &Pr_str, map[string]interface{}{"doc": "pr to a string, returning it. Fundamental entrypoint to IPrintWithWriter.", "arglists": [][]string{{"&", "objs"}}})
+	Intern_("cljs.core", "pr-str*", &Pr_str_STAR_, map[string]interface{}{"doc": "Support so that collections can implement toString without\nloading all the printing machinery.", "arglists": [][]string{{"obj"}}})
+	Intern_("cljs.core", "pr-str-with-opts", &Pr_str_with_opts, map[string]interface{}{"doc": "Prints a sequence of objects to a string, observing all the\noptions given in opts", "arglists": [][]string{{"objs", "opts"}}})
+	Intern_("cljs.core", "pr-with-opts", &Pr_with_opts, map[string]interface{}{"doc": "Prints a sequence of objects using string-print, observing all\nthe options given in opts", "arglists": [][]string{{"objs", "opts"}}})
+	Intern_("cljs.core", "prefer-method", &Prefer_method, map[string]interface{}{"doc": "Causes the multimethod to prefer matches of dispatch-val-x over dispatch-val-y\nwhen there is a conflict", "arglists": [][]string{{"multifn", "dispatch-val-x", "dispatch-val-y"}}})
+	Intern_("cljs.core", "prefers", &Prefers, map[string]interface{}{"doc": "Given a multimethod, returns a map of preferred value -> set of other values", "arglists": [][]string{{"multifn"}}})
+	Intern_("cljs.core", "prefers*", &Prefers_STAR_, map[string]interface{}{"arglists": [][]string{{"x", "y", "prefer-table"}}})
+	Intern_("cljs.core", "preserving-reduced", &Preserving_reduced, map[string]interface{}{"arglists": [][]string{{"rf"}}})
+	Intern_("cljs.core", "prim-seq", &Prim_seq, map[string]interface{}{"arglists": [][]string{{"prim"}, {"prim", "i"}}})
+	Intern_("cljs.core", "print", &Print, map[string]interface{}{"doc": "Prints the object(s) using string-print.\nprint and println produce output for human consumption.", "arglists": [][]string{{"&", "objs"}}})
+	Intern_("cljs.core", "print-map", &Print_map, map[string]interface{}{"arglists": [][]string{{"m", "print-one", "writer", "opts"}}})
+	Intern_("cljs.core", "print-str", &Print_str, map[string]interface{}{"doc": "print to a string, returning it", "arglists": [][]string{{"&", "objs"}}})
+	Intern_("cljs.core", "println", &Println, map[string]interface{}{"doc": "Same as print followed by (newline)", "arglists": [][]string{{"&", "objs"}}})
+	Intern_("cljs.core", "println-str", &Println_str, map[string]interface{}{"doc": "println to a string, returning it", "arglists": [][]string{{"&", "objs"}}})
+	Intern_("cljs.core", "prn", &Prn, map[string]interface{}{"doc": "Same as pr followed by (newline).", "arglists": [][]string{{"&", "objs"}}})
+	Intern_("cljs.core", "prn-str", &Prn_str, map[string]interface{}{"doc": "Same as pr-str followed by (newline)", "arglists": [][]string{{"&", "objs"}}})
+	Intern_("cljs.core", "prn-str-with-opts", &Prn_str_with_opts, map[string]interface{}{"doc": "Same as pr-str-with-opts followed by (newline)", "arglists": [][]string{{"objs", "opts"}}})
+	Intern_("cljs.core", "push-tail", &Push_tail, map[string]interface{}{"arglists": [][]string{{"pv", "level", "parent", "tailnode"}}})
+	Intern_("cljs.core", "pv-aget", &Pv_aget, map[string]interface{}{"arglists": [][]string{{"node", "idx"}}})
+	Intern_("cljs.core", "pv-aset", &Pv_aset, map[string]interface{}{"arglists": [][]string{{"node", "idx", "val"}}})
+	Intern_("cljs.core", "pv-clone-node", &Pv_clone_node, map[string]interface{}{"arglists": [][]string{{"node"}}})
+	Intern_("cljs.core", "pv-fresh-node", &Pv_fresh_node, map[string]interface{}{"arglists": [][]string{{"edit"}}})
+	Intern_("cljs.core", "quot", &Quot, map[string]interface{}{"doc": "quot[ient] of dividing numerator by denominator.", "arglists": [][]string{{"n", "d"}}})
+	Intern_("cljs.core", "rand-int", &Rand_int, map[string]interface{}{"doc": "Returns a random integer between 0 (inclusive) and n (exclusive).", "arglists": [][]string{{"n"}}})
+	Intern_("cljs.core", "rand-nth", &Rand_nth, map[string]interface{}{"doc": "Return a random element of the (sequential) collection. Will have\nthe same performance characteristics as nth for the given\ncollection.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "random-sample", &Random_sample, map[string]interface{}{"doc": "Returns items from coll with random probability of prob (0.0 -\n1.0).  Returns a transducer when no collection is provided.", "arglists": [][]string{{"prob"}, {"prob", "coll"}}})
+	Intern_("cljs.core", "range", &Range_, map[string]interface{}{"doc": "Returns a lazy seq of nums from start (inclusive) to end\n(exclusive), by step, where start defaults to 0, step to 1,\nand end to infinity.", "arglists": [][]string{{}, {"end"}, {"start", "end"}, {"start", "end", "step"}}})
+	Intern_("cljs.core", "ranged-iterator", &Ranged_iterator, map[string]interface{}{"arglists": [][]string{{"v", "start", "end"}}})
+	Intern_("cljs.core", "re-find", &Re_find, map[string]interface{}{"doc": "Returns the first regex match, if any, of s to re, using\nre.exec(s). Returns a vector, containing first the matching\nsubstring, then any capturing groups if the regular expression contains\ncapturing groups.", "arglists": [][]string{{"re", "s"}}})
+	Intern_("cljs.core", "re-matches", &Re_matches, map[string]interface{}{"doc": "Returns the result of (re-find re s) if re fully matches s.", "arglists": [][]string{{"re", "s"}}})
+	Intern_("cljs.core", "re-pattern", &Re_pattern, map[string]interface{}{"doc": "Returns an instance of RegExp which has compiled the provided string.", "arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "re-seq", &Re_seq, map[string]interface{}{"doc": "Returns a lazy sequence of successive matches of re in s.", "arglists": [][]string{{"re", "s"}}})
+	Intern_("cljs.core", "realized?", &Realized_QMARK_, map[string]interface{}{"doc": "Returns true if a value has been produced for a promise, delay, future or lazy sequence.", "arglists": [][]string{{"d"}}})
+	Intern_("cljs.core", "reduce", &Reduce, map[string]interface{}{"doc": "f should be a function of 2 arguments. If val is not supplied,\nreturns the result of applying f to the first 2 items in coll, then\napplying f to that result and the 3rd item, etc. If coll contains no\nitems, f must accept no arguments as well, and reduce returns the\nresult of calling f with no arguments.  If coll has only 1 item, it\nis returned and f is not called.  If val is supplied, returns the\nresult of applying f to val and the first item in coll, then\napplying f to that result and the 2nd item, etc. If coll contains no\nitems, returns val and f is not called.", "arglists": [][]string{{"f", "coll"}, {"f", "val", "coll"}}})
+	Intern_("cljs.core", "reduce-kv", &Reduce_kv, map[string]interface{}{"doc": "Reduces an associative collection. f should be a function of 3\narguments. Returns the result of applying f to init, the first key\nand the first value in coll, then applying f to that result and the\n2nd key and value, etc. If coll contains no entries, returns init\nand f is not called. Note that reduce-kv is supported on vectors,\nwhere the keys will be the ordinals.", "arglists": [][]string{{"f", "init", "coll"}}})
+	Intern_("cljs.core", "reduceable?", &Reduceable_QMARK_, map[string]interface{}{"doc": "Returns true if coll satisfies IReduce", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "reduced", &Reduced, map[string]interface{}{"doc": "Wraps x in a way such that a reduce will terminate with the value x", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "reduced?", &Reduced_QMARK_, map[string]interface{}{"doc": "Returns true if x is the result of a call to reduced", "arglists": [][]string{{"r"}}})
+	Intern_("cljs.core", "reductions", &Reductions, map[string]interface{}{"doc": "Returns a lazy seq of the intermediate values of the reduction (as\nper reduce) of coll by f, starting with init.", "arglists": [][]string{{"f", "coll"}, {"f", "init", "coll"}}})
+	Intern_("cljs.core", "regexp?", &Regexp_QMARK_, map[string]interface{}{"arglists": [][]string{{"o"}}})
+	Intern_("cljs.core", "rem", &Rem, map[string]interface{}{"doc": "remainder of dividing numerator by denominator.", "arglists": [][]string{{"n", "d"}}})
+	Intern_("cljs.core", "remove-all-methods", &Remove_all_methods, map[string]interface{}{"doc": "Removes all of the methods of multimethod.", "arglists": [][]string{{"multifn"}}})
+	Intern_("cljs.core", "remove-method", &Remove_method, map[string]interface{}{"doc": "Removes the method of multimethod associated with dispatch-value.", "arglists": [][]string{{"multifn", "dispatch-val"}}})
+	Intern_("cljs.core", "remove-pair", &Remove_pair, map[string]interface{}{"arglists": [][]string{{"arr", "i"}}})
+	Intern_("cljs.core", "remove-watch", &Remove_watch, map[string]interface{}{"doc": "Alpha - subject to change.\n\nRemoves a watch (set by add-watch) from a reference", "arglists": [][]string{{"iref", "key"}}})
+	Intern_("cljs.core", "repeat", &Repeat, map[string]interface{}{"doc": "Returns a lazy (infinite!, or length n if supplied) sequence of xs.", "arglists": [][]string{{"x"}, {"n", "x"}}})
+	Intern_("cljs.core", "repeatedly", &Repeatedly, map[string]interface{}{"doc": "Takes a function of no args, presumably with side effects, and\nreturns an infinite (or length n if supplied) lazy sequence of calls\nto it", "arglists": [][]string{{"f"}, {"n", "f"}}})
+	Intern_("cljs.core", "replace", &Replace, map[string]interface{}{"doc": "Given a map of replacement pairs and a vector/collection, returns a\nvector/seq with any elements = a key in smap replaced with the\ncorresponding val in smap.  Returns a transducer when no collection\nis provided.", "arglists": [][]string{{"smap"}, {"smap", "coll"}}})
+	Intern_("cljs.core", "replicate", &Replicate, map[string]interface{}{"doc": "Returns a lazy seq of n xs.", "arglists": [][]string{{"n", "x"}}})
+	Intern_("cljs.core", "reset-cache", &Reset_cache, map[string]interface{}{"arglists": [][]string{{"method-cache", "method-table", "cached-hierarchy", "hierarchy"}}})
+	Intern_("cljs.core", "reset-meta!", &Reset_meta_BANG_, map[string]interface{}{"doc": "Atomically resets the metadata for an atom", "arglists": [][]string{{"iref", "m"}}})
+	Intern_("cljs.core", "rest", &Rest, map[string]interface{}{"doc": "Returns a possibly empty seq of the items after the first. Calls seq on its\nargument.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "reverse", &Reverse, map[string]interface{}{"doc": "Returns a seq of the items in coll in reverse order. Not lazy.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "reversible?", &Reversible_QMARK_, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "rseq", &Rseq, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "rsubseq", &Rsubseq, map[string]interface{}{"doc": "sc must be a sorted collection, test(s) one of <, <=, > or\n>=. Returns a reverse seq of those entries with keys ek for\nwhich (test (.. sc comparator (compare ek key)) 0) is true", "arglists": [][]string{{"sc", "test", "key"}, {"sc", "start-test", "start-key", "end-test", "end-key"}}})
+	Intern_("cljs.core", "run!", &Run_BANG_, map[string]interface{}{"doc": "Runs the supplied procedure (via reduce), for purposes of side\neffects, on successive items in the collection. Returns nil", "arglists": [][]string{{"proc", "coll"}}})
+	Intern_("cljs.core", "scan-array", &Scan_array, map[string]interface{}{"arglists": [][]string{{"incr", "k", "array"}}})
+	Intern_("cljs.core", "second", &Second, map[string]interface{}{"doc": "Same as (first (next x))", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "select-keys", &Select_keys, map[string]interface{}{"doc": "Returns a map containing only those entries in map whose key is in keys", "arglists": [][]string{{"map", "keyseq"}}})
+	Intern_("cljs.core", "seq", &Seq, map[string]interface{}{"doc": "Returns a seq on the collection. If the collection is\nempty, returns nil.  (seq nil) returns nil. seq also works on\nStrings.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "seq-iter", &Seq_iter, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "seq-reduce", &Seq_reduce, map[string]interface{}{"arglists": [][]string{{"f", "coll"}, {"f", "val", "coll"}}})
+	Intern_("cljs.core", "seq?", &Seq_QMARK_, map[string]interface{}{"doc": "Return true if s satisfies ISeq", "arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "seqable?", &Seqable_QMARK_, map[string]interface{}{"doc": "Return true if s satisfies ISeqable", "arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "sequence", &Sequence, map[string]interface{}{"doc": "Coerces coll to a (possibly empty) sequence, if it is not already\none. Will not force a lazy seq. (sequence nil) yields ()", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "sequential?", &Sequential_QMARK_, map[string]interface{}{"doc": "Returns true if coll satisfies ISequential", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "set", &Set, map[string]interface{}{"doc": "Returns a set of the distinct elements of coll.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "set-from-indexed-seq", &Set_from_indexed_seq, map[string]interface{}{"arglists": [][]string{{"iseq"}}})
+	Intern_("cljs.core", "set?", &Set_QMARK_, map[string]interface{}{"doc": "Returns true if x satisfies ISet", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "short", &Short, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "shorts", &Shorts, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "shuffle", &Shuffle, map[string]interface{}{"doc": "Return a random permutation of coll", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "some", &Some, map[string]interface{}{"doc": "Returns the first logical true value of (pred x) for any x in coll,\nelse nil.  One common idiom is to use a set as pred, for example\nthis will return :fred if :fred is in the sequence, otherwise nil:\n(some #{:fred} coll)", "arglists": [][]string{{"pred", "coll"}}})
+	Intern_("cljs.core", "some-fn", &Some_fn, map[string]interface{}{"doc": "Takes a set of predicates and returns a function f that returns the first logical true value\nreturned by one of its composing predicates against any of its arguments, else it returns\nlogical false. Note that f is short-circuiting in that it will stop execution on the first\nargument that triggers a logical true result against the original predicates.", "arglists": [][]string{{"p"}, {"p1", "p2"}, {"p1", "p2", "p3"}, {"p1", "p2", "p3", "&", "ps"}}})
+	Intern_("cljs.core", "some?", &Some_QMARK_, map[string]interface{}{"doc": "Returns true if x is not nil, false otherwise.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "sort-by", &Sort_by, map[string]interface{}{"doc": "Returns a sorted sequence of the items in coll, where the sort\norder is determined by comparing (keyfn item).  Comp can be\nboolean-valued comparison funcion, or a -/0/+ valued comparator.\nComp defaults to compare.", "arglists": [][]string{{"keyfn", "coll"}, {"keyfn", "comp", "coll"}}})
+	Intern_("cljs.core", "sorted-map", &Sorted_map, map[string]interface{}{"doc": "keyval => key val\nReturns a new sorted map with supplied mappings.", "arglists": [][]string{{"&", "keyvals"}}})
+	Intern_("cljs.core", "sorted-map-by", &Sorted_map_by, map[string]interface{}{"doc": "keyval => key val\nReturns a new sorted map with supplied mappings, using the supplied comparator.", "arglists": [][]string{{"comparator", "&", "keyvals"}}})
+	Intern_("cljs.core", "sorted-set", &Sorted_set, map[string]interface{}{"doc": "Returns a new sorted set with supplied keys.", "arglists": [][]string{{"&", "keys"}}})
+	Intern_("cljs.core", "sorted-set-by", &Sorted_set_by, map[string]interface{}{"doc": "Returns a new sorted set with supplied keys, using the supplied comparator.", "arglists": [][]string{{"comparator", "&", "keys"}}})
+	Intern_("cljs.core", "sorted?", &Sorted_QMARK_, map[string]interface{}{"doc": "Returns true if coll satisfies ISorted", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "special-symbol?", &Special_symbol_QMARK_, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "split-at", &Split_at, map[string]interface{}{"doc": "Returns a vector of [(take n coll) (drop n coll)]", "arglists": [][]string{{"n", "coll"}}})
+	Intern_("cljs.core", "split-with", &Split_with, map[string]interface{}{"doc": "Returns a vector of [(take-while pred coll) (drop-while pred coll)]", "arglists": [][]string{{"pred", "coll"}}})
+	Intern_("cljs.core", "spread", &Spread, map[string]interface{}{"arglists": [][]string{{"arglist"}}})
+	Intern_("cljs.core", "stepper", &Stepper, map[string]interface{}{"arglists": [][]string{{"xform", "iter"}}})
+	Intern_("cljs.core", "str", &Str, map[string]interface{}{"doc": "With no args, returns the empty string. With one arg x, returns\nx.toString().  (str nil) returns the empty string. With more than\none arg, returns the concatenation of the str values of the args.", "arglists": [][]string{{}, {"x"}, {"x", "&", "ys"}}})
+	Intern_("cljs.core", "string-hash-cache-count", &String_hash_cache_count, map[string]interface{}{})
+	Intern_("cljs.core", "string-iter", &String_iter, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "string-print", &String_print, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "string?", &String_QMARK_, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "subs", &Subs, map[string]interface{}{"doc": "Returns the substring of s beginning at start inclusive, and ending\nat end (defaults to length of string), exclusive.", "arglists": [][]string{{"s", "start"}, {"s", "start", "end"}}})
+	Intern_("cljs.core", "subseq", &Subseq, map[string]interface{}{"doc": "sc must be a sorted collection, test(s) one of <, <=, > or\n>=. Returns a seq of those entries with keys ek for\nwhich (test (.. sc comparator (compare ek key)) 0) is true", "arglists": [][]string{{"sc", "test", "key"}, {"sc", "start-test", "start-key", "end-test", "end-key"}}})
+	Intern_("cljs.core", "subvec", &Subvec, map[string]interface{}{"doc": "Returns a persistent vector of the items in vector from\nstart (inclusive) to end (exclusive).  If end is not supplied,\ndefaults to (count vector). This operation is O(1) and very fast, as\nthe resulting vector shares structure with the original and no\ntrimming is done.", "arglists": [][]string{{"v", "start"}, {"v", "start", "end"}}})
+	Intern_("cljs.core", "swap-global-hierarchy!", &Swap_global_hierarchy_BANG_, map[string]interface{}{"arglists": [][]string{{"f", "&", "args"}}})
+	Intern_("cljs.core", "symbol", &Symbol, map[string]interface{}{"arglists": [][]string{{"name"}, {"ns", "name"}}})
+	Intern_("cljs.core", "tail-off", &Tail_off, map[string]interface{}{"arglists": [][]string{{"pv"}}})
+	Intern_("cljs.core", "take", &Take, map[string]interface{}{"doc": "Returns a lazy sequence of the first n items in coll, or all items if\nthere are fewer than n.  Returns a stateful transducer when\nno collection is provided.", "arglists": [][]string{{"n"}, {"n", "coll"}}})
+	Intern_("cljs.core", "take-last", &Take_last, map[string]interface{}{"doc": "Returns a seq of the last n items in coll.  Depending on the type\nof coll may be no better than linear time.  For vectors, see also subvec.", "arglists": [][]string{{"n", "coll"}}})
+	Intern_("cljs.core", "take-nth", &Take_nth, map[string]interface{}{"doc": "Returns a lazy seq of every nth item in coll.  Returns a stateful\ntransducer when no collection is provided.", "arglists": [][]string{{"n"}, {"n", "coll"}}})
+	Intern_("cljs.core", "take-while", &Take_while, map[string]interface{}{"doc": "Returns a lazy sequence of successive items from coll while\n(pred item) returns true. pred must be free of side-effects.\nReturns a transducer when no collection is provided.", "arglists": [][]string{{"pred"}, {"pred", "coll"}}})
+	Intern_("cljs.core", "throw-no-method-error", &Throw_no_method_error, map[string]interface{}{"arglists": [][]string{{"name", "dispatch-val"}}})
+	Intern_("cljs.core", "to-array", &To_array, map[string]interface{}{"doc": "Naive impl of to-array as a start.", "arglists": [][]string{{"s"}}})
+	Intern_("cljs.core", "to-array-2d", &To_array_2d, map[string]interface{}{"doc": "Returns a (potentially-ragged) 2-dimensional array\ncontaining the contents of coll.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "trampoline", &Trampoline, map[string]interface{}{"doc": "trampoline can be used to convert algorithms requiring mutual\nrecursion without stack consumption. Calls f with supplied args, if\nany. If f returns a fn, calls that fn with no arguments, and\ncontinues to repeat, until the return value is not a fn, then\nreturns that non-fn value. Note that if you want to return a fn as a\nfinal value, you must wrap it in some data structure and unpack it\nafter trampoline returns.", "arglists": [][]string{{"f"}, {"f", "&", "args"}}})
+	Intern_("cljs.core", "transduce", &Transduce, map[string]interface{}{"doc": "reduce with a transformation of f (xf). If init is not\nsupplied, (f) will be called to produce it. f should be a reducing\nstep function that accepts both 1 and 2 arguments, if it accepts\nonly 2 you can add the arity-1 with 'completing'. Returns the result\nof applying (the transformed) xf to init and the first item in coll,\nthen applying xf to that result and the 2nd item, etc. If coll\ncontains no items, returns init and f is not called. Note that\ncertain transforms may inject or skip items.", "arglists": [][]string{{"xform", "f", "coll"}, {"xform", "f", "init", "coll"}}})
+	Intern_("cljs.core", "transient", &Transient, map[string]interface{}{"doc": "Returns a new, transient version of the collection, in constant time.", "arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "tree-map-add", &Tree_map_add, map[string]interface{}{"arglists": [][]string{{"comp", "tree", "k", "v", "found"}}})
+	Intern_("cljs.core", "tree-map-append", &Tree_map_append, map[string]interface{}{"arglists": [][]string{{"left", "right"}}})
+	Intern_("cljs.core", "tree-map-kv-reduce", &Tree_map_kv_reduce, map[string]interface{}{"arglists": [][]string{{"node", "f", "init"}}})
+	Intern_("cljs.core", "tree-map-remove", &Tree_map_remove, map[string]interface{}{"arglists": [][]string{{"comp", "tree", "k", "found"}}})
+	Intern_("cljs.core", "tree-map-replace", &Tree_map_replace, map[string]interface{}{"arglists": [][]string{{"comp", "tree", "k", "v"}}})
+	Intern_("cljs.core", "tree-map-seq-push", &Tree_map_seq_push, map[string]interface{}{"arglists": [][]string{{"node", "stack", "ascending?"}}})
+	Intern_("cljs.core", "tree-seq", &Tree_seq, map[string]interface{}{"doc": "Returns a lazy sequence of the nodes in a tree, via a depth-first walk.\nbranch? must be a fn of one arg that returns true if passed a node\nthat can have children (but may not).  children must be a fn of one\narg that returns a sequence of the children. Will only be called on\nnodes for which branch? returns true. Root is the root node of the\ntree.", "arglists": [][]string{{"branch?", "children", "root"}}})
+	Intern_("cljs.core", "true?", &True_QMARK_, map[string]interface{}{"doc": "Returns true if x is the value true, false otherwise.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "tv-editable-root", &Tv_editable_root, map[string]interface{}{"arglists": [][]string{{"node"}}})
+	Intern_("cljs.core", "tv-editable-tail", &Tv_editable_tail, map[string]interface{}{"arglists": [][]string{{"tl"}}})
+	Intern_("cljs.core", "tv-ensure-editable", &Tv_ensure_editable, map[string]interface{}{"arglists": [][]string{{"edit", "node"}}})
+	Intern_("cljs.core", "tv-pop-tail", &Tv_pop_tail, map[string]interface{}{"arglists": [][]string{{"tv", "level", "node"}}})
+	Intern_("cljs.core", "tv-push-tail", &Tv_push_tail, map[string]interface{}{"arglists": [][]string{{"tv", "level", "parent", "tail-node"}}})
+	Intern_("cljs.core", "unchecked-add", &Unchecked_add, map[string]interface{}{"doc": "Returns the sum of nums. (+) returns 0.", "arglists": [][]string{{}, {"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "unchecked-add-int", &Unchecked_add_int, map[string]interface{}{"doc": "Returns the sum of nums. (+) returns 0.", "arglists": [][]string{{}, {"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "unchecked-array-for", &Unchecked_array_for, map[string]interface{}{"arglists": [][]string{{"pv", "i"}}})
+	Intern_("cljs.core", "unchecked-byte", &Unchecked_byte, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-char", &Unchecked_char, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-dec", &Unchecked_dec, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-dec-int", &Unchecked_dec_int, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-divide-int", &Unchecked_divide_int, map[string]interface{}{"doc": "If no denominators are supplied, returns 1/numerator,\nelse returns numerator divided by all of the denominators.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "unchecked-double", &Unchecked_double, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-editable-array-for", &Unchecked_editable_array_for, map[string]interface{}{"arglists": [][]string{{"tv", "i"}}})
+	Intern_("cljs.core", "unchecked-float", &Unchecked_float, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-inc", &Unchecked_inc, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-inc-int", &Unchecked_inc_int, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-int", &Unchecked_int, map[string]interface{}{"doc": "Coerce to int by stripping decimal places.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-long", &Unchecked_long, map[string]interface{}{"doc": "Coerce to long by stripping decimal places. Identical to `int'.", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-multiply", &Unchecked_multiply, map[string]interface{}{"doc": "Returns the product of nums. (*) returns 1.", "arglists": [][]string{{}, {"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "unchecked-multiply-int", &Unchecked_multiply_int, map[string]interface{}{"doc": "Returns the product of nums. (*) returns 1.", "arglists": [][]string{{}, {"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "unchecked-negate", &Unchecked_negate, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-negate-int", &Unchecked_negate_int, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-remainder-int", &Unchecked_remainder_int, map[string]interface{}{"arglists": [][]string{{"x", "n"}}})
+	Intern_("cljs.core", "unchecked-short", &Unchecked_short, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unchecked-subtract", &Unchecked_subtract, map[string]interface{}{"doc": "If no ys are supplied, returns the negation of x, else subtracts\nthe ys from x and returns the result.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "unchecked-subtract-int", &Unchecked_subtract_int, map[string]interface{}{"doc": "If no ys are supplied, returns the negation of x, else subtracts\nthe ys from x and returns the result.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "undefined?", &Undefined_QMARK_, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "underive", &Underive, map[string]interface{}{"doc": "Removes a parent/child relationship between parent and\ntag. h must be a hierarchy obtained from make-hierarchy, if not\nsupplied defaults to, and modifies, the global hierarchy.", "arglists": [][]string{{"tag", "parent"}, {"h", "tag", "parent"}}})
+	Intern_("cljs.core", "unreduced", &Unreduced, map[string]interface{}{"doc": "If x is reduced?, returns (deref x), else returns x", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "unsigned-bit-shift-right", &Unsigned_bit_shift_right, map[string]interface{}{"doc": "Bitwise shift right with zero fill", "arglists": [][]string{{"x", "n"}}})
+	Intern_("cljs.core", "update", &Update, map[string]interface{}{"doc": "'Updates' a value in an associative structure, where k is a\nkey and f is a function that will take the old value\nand any supplied args and return the new value, and returns a new\nstructure.  If the key does not exist, nil is passed as the old value.", "arglists": [][]string{{"m", "k", "f"}, {"m", "k", "f", "x"}, {"m", "k", "f", "x", "y"}, {"m", "k", "f", "x", "y", "z"}, {"m", "k", "f", "x", "y", "z", "&", "more"}}})
+	Intern_("cljs.core", "update-in", &Update_in, map[string]interface{}{"doc": "'Updates' a value in a nested associative structure, where ks is a\nsequence of keys and f is a function that will take the old value\nand any supplied args and return the new value, and returns a new\nnested structure.  If any levels do not exist, hash-maps will be\ncreated.", "arglists": [][]string{{"m", "p__6088", "f"}, {"m", "p__6089", "f", "a"}, {"m", "p__6090", "f", "a", "b"}, {"m", "p__6091", "f", "a", "b", "c"}, {"m", "p__6092", "f", "a", "b", "c", "&", "args"}}})
+	Intern_("cljs.core", "val", &Val, map[string]interface{}{"doc": "Returns the value in the map entry.", "arglists": [][]string{{"map-entry"}}})
+	Intern_("cljs.core", "vals", &Vals, map[string]interface{}{"doc": "Returns a sequence of the map's values.", "arglists": [][]string{{"hash-map"}}})
+	Intern_("cljs.core", "vary-meta", &Vary_meta, map[string]interface{}{"doc": "Returns an object of the same type and value as obj, with\n(apply f (meta obj) args) as its metadata.", "arglists": [][]string{{"obj", "f"}, {"obj", "f", "a"}, {"obj", "f", "a", "b"}, {"obj", "f", "a", "b", "c"}, {"obj", "f", "a", "b", "c", "d"}, {"obj", "f", "a", "b", "c", "d", "&", "args"}}})
+	Intern_("cljs.core", "vec", &Vec, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	Intern_("cljs.core", "vector", &Vector, map[string]interface{}{"arglists": [][]string{{"&", "args"}}})
+	Intern_("cljs.core", "vector-index-out-of-bounds", &Vector_index_out_of_bounds, map[string]interface{}{"arglists": [][]string{{"i", "cnt"}}})
+	Intern_("cljs.core", "vector?", &Vector_QMARK_, map[string]interface{}{"doc": "Return true if x satisfies IVector", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "with-meta", &With_meta, map[string]interface{}{"doc": "Returns an object of the same type and value as obj, with\nmap m as its metadata.", "arglists": [][]string{{"o", "meta"}}})
+	Intern_("cljs.core", "write-all", &Write_all, map[string]interface{}{"arglists": [][]string{{"writer", "&", "ss"}}})
+	Intern_("cljs.core", "zero?", &Zero_QMARK_, map[string]interface{}{"arglists": [][]string{{"n"}}})
+	Intern_("cljs.core", "zipmap", &Zipmap, map[string]interface{}{"doc": "Returns a map with the keys mapped to the corresponding vals.", "arglists": [][]string{{"keys", "vals"}}})
 }
 
 var X_STAR_unchecked_if_STAR_ bool
@@ -12627,8 +13082,6 @@ var Ensure_reduced *AFn
 // If x is reduced?, returns (deref x), else returns x
 var Unreduced *AFn
 
-var Deref *AFn
-
 // Accepts any collection which satisfies the ICount and IIndexed protocols and
 // reduces them without incurring seq initialization
 var Ci_reduce *AFn
@@ -15163,171 +15616,6 @@ var Map_indexed *AFn
 // side-effects.  Returns a transducer when no collection is provided.
 var Keep *AFn
 
-type CljsCoreAtom struct {
-	State     interface{}
-	Meta      interface{}
-	Validator interface{}
-	Watches   interface{}
-}
-
-func (_ *CljsCoreAtom) CljsCoreIHash__() {}
-func (this *CljsCoreAtom) X_hash_Arity1() interface{} {
-	{
-		var G__5005 = this
-		_ = G__5005
-		return Native_invoke_func.X_invoke_Arity2(goog.GetUid, []interface{}{G__5005})
-	}
-}
-
-func (_ *CljsCoreAtom) CljsCoreIWatchable__() {}
-func (this *CljsCoreAtom) X_notify_watches_Arity3(oldval interface{}, newval interface{}) interface{} {
-	{
-		var seq__5020 interface{} = Seq.Arity1IQ(this.Watches)
-		var chunk__5021 interface{} = nil
-		var count__5022 = float64(0)
-		var i__5023 = float64(0)
-		_, _, _, _ = seq__5020, chunk__5021, count__5022, i__5023
-		for {
-			if i__5023 < count__5022 {
-				{
-					var vec__5024 = Decorate_(chunk__5021).(CljsCoreIIndexed).X_nth_Arity2(i__5023)
-					var key = Nth.X_invoke_Arity3(vec__5024, float64(0), nil)
-					var f = Nth.X_invoke_Arity3(vec__5024, float64(1), nil)
-					_, _, _ = vec__5024, key, f
-					{
-						var G__5025_7965 = key
-						var G__5026_7966 = this
-						var G__5027_7967 = oldval
-						var G__5028_7968 = newval
-						_, _, _, _ = G__5025_7965, G__5026_7966, G__5027_7967, G__5028_7968
-						f.(CljsCoreIFn).X_invoke_Arity4(G__5025_7965, G__5026_7966, G__5027_7967, G__5028_7968)
-					}
-					seq__5020, chunk__5021, count__5022, i__5023 = seq__5020, chunk__5021, count__5022, (i__5023 + float64(1))
-					continue
-				}
-			} else {
-				{
-					var temp__4388__auto__ = Seq.Arity1IQ(seq__5020)
-					_ = temp__4388__auto__
-					if Truth_(temp__4388__auto__) {
-						{
-							var seq__5020___1 = temp__4388__auto__
-							_ = seq__5020___1
-							if Chunked_seq_QMARK_.Arity1IB(seq__5020___1) {
-								{
-									var c__970__auto__ = Chunk_first.X_invoke_Arity1(seq__5020___1)
-									_ = c__970__auto__
-									seq__5020, chunk__5021, count__5022, i__5023 = Chunk_rest.X_invoke_Arity1(seq__5020___1), c__970__auto__, Count.X_invoke_Arity1(c__970__auto__).(float64), float64(0)
-									continue
-								}
-							} else {
-								{
-									var vec__5029 = First.X_invoke_Arity1(seq__5020___1)
-									var key = Nth.X_invoke_Arity3(vec__5029, float64(0), nil)
-									var f = Nth.X_invoke_Arity3(vec__5029, float64(1), nil)
-									_, _, _ = vec__5029, key, f
-									{
-										var G__5030_7969 = key
-										var G__5031_7970 = this
-										var G__5032_7971 = oldval
-										var G__5033_7972 = newval
-										_, _, _, _ = G__5030_7969, G__5031_7970, G__5032_7971, G__5033_7972
-										f.(CljsCoreIFn).X_invoke_Arity4(G__5030_7969, G__5031_7970, G__5032_7971, G__5033_7972)
-									}
-									seq__5020, chunk__5021, count__5022, i__5023 = Next.Arity1IQ(seq__5020___1), nil, float64(0), float64(0)
-									continue
-								}
-							}
-						}
-					} else {
-						return nil
-					}
-				}
-			}
-		}
-	}
-}
-
-func (this *CljsCoreAtom) X_add_watch_Arity3(key interface{}, f interface{}) interface{} {
-	this.Watches = Assoc.X_invoke_Arity3(this.Watches, key, f)
-
-	return this
-}
-
-func (this *CljsCoreAtom) X_remove_watch_Arity2(key interface{}) interface{} {
-	return func() interface{} {
-		var return__7973 = Dissoc.X_invoke_Arity2(this.Watches, key)
-		this.Watches = return__7973
-		return return__7973
-	}()
-}
-
-func (_ *CljsCoreAtom) CljsCoreIMeta__() {}
-func (___ *CljsCoreAtom) X_meta_Arity1() interface{} {
-	return ___.Meta
-}
-
-func (_ *CljsCoreAtom) CljsCoreIDeref__() {}
-func (___ *CljsCoreAtom) X_deref_Arity1() interface{} {
-	return ___.State
-}
-
-func (_ *CljsCoreAtom) CljsCoreIEquiv__() {}
-func (o *CljsCoreAtom) X_equiv_Arity2(other interface{}) bool {
-	return reflect.DeepEqual(o, other)
-}
-
-func (_ *CljsCoreAtom) CljsCoreIAtom__()  {}
-func (_ *CljsCoreAtom) CljsCoreObject__() {}
-func (this *CljsCoreAtom) Equiv(other interface{}) bool {
-	return this.X_equiv_Arity2(other)
-}
-
-var X__GT_Atom *AFn
-
-// Creates and returns an Atom with an initial value of x and zero or
-// more options (in any order):
-//
-// :meta metadata-map
-//
-// :validator validate-fn
-//
-// If metadata-map is supplied, it will be come the metadata on the
-// atom. validate-fn must be nil or a side-effect-free fn of one
-// argument, which will be passed the intended new state on any state
-// change. If the new state is unacceptable, the validate-fn should
-// return false or throw an Error.  If either of these error conditions
-// occur, then the value of the atom will not change.
-// @param {...*} var_args
-var Atom *AFn
-
-// Sets the value of atom to newval without regard for the
-// current value. Returns newval.
-var Reset_BANG_ *AFn
-
-// Atomically swaps the value of atom to be:
-// (apply f current-value-of-atom args). Note that f may be called
-// multiple times, and thus should be free of side effects.  Returns
-// the value that was swapped in.
-// @param {...*} var_args
-var Swap_BANG_ *AFn
-
-// Atomically sets the value of atom to newval if and only if the
-// current value of the atom is identical to oldval. Returns true if
-// set happened, else false.
-var Compare_and_set_BANG_ *AFn
-
-// Sets the validator-fn for an atom. validator-fn must be nil or a
-// side-effect-free fn of one argument, which will be passed the intended
-// new state on any state change. If the new state is unacceptable, the
-// validator-fn should return false or throw an Error. If the current state
-// is not acceptable to the new validator, an Error will be thrown and the
-// validator will not be changed.
-var Set_validator_BANG_ *AFn
-
-// Gets the validator-fn for a var/ref/agent/atom.
-var Get_validator *AFn
-
 // Returns a lazy sequence of the non-nil results of (f index item). Note,
 // this means false return values will be included.  f must be free of
 // side-effects.  Returns a stateful transducer when no collection is
@@ -22293,14 +22581,6 @@ func (coll *CljsCoreChunkedCons) X_pr_writer_Arity3(writer interface{}, opts int
 	return Pr_sequential_writer.X_invoke_Arity7(writer, Pr_writer, "(", " ", ")", opts, coll)
 }
 
-func (_ *CljsCoreAtom) CljsCoreIPrintWithWriter__() {}
-
-func (a *CljsCoreAtom) X_pr_writer_Arity3(writer interface{}, opts interface{}) interface{} {
-	Decorate_(writer).(CljsCoreIWriter).X_write_Arity2("#<Atom: ")
-	Pr_writer.X_invoke_Arity3(a.State, writer, opts)
-	return Decorate_(writer).(CljsCoreIWriter).X_write_Arity2(">")
-}
-
 func (_ *CljsCoreValSeq) CljsCoreIPrintWithWriter__() {}
 
 func (coll *CljsCoreValSeq) X_pr_writer_Arity3(writer interface{}, opts interface{}) interface{} {
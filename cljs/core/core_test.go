@@ -1,8 +1,15 @@
 package core
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"reflect"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"testing"
 )
@@ -174,6 +181,838 @@ func Test_InteropViaReflection(t *testing.T) {
 		"(.fromCharCode js/String 65 66 67)")
 }
 
+func Test_AtomConcurrency(t *testing.T) {
+	a := Atom.X_invoke_Arity1(0.0)
+	var notified int32
+	Add_watch.X_invoke_Arity3(a, Keyword.X_invoke_Arity1("count"), Fn(func(_, _, oldval, newval interface{}) interface{} {
+		atomic.AddInt32(&notified, 1)
+		return nil
+	}))
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				Swap_BANG_.X_invoke_Arity2(a, Inc)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1000.0, Deref.X_invoke_Arity1(a))
+
+	assert.False(t, Compare_and_set_BANG_.X_invoke_Arity3(a, 0.0, 1.0).(bool))
+	assert.True(t, Compare_and_set_BANG_.X_invoke_Arity3(a, 1000.0, 1.0).(bool))
+	assert.Equal(t, int32(1001), atomic.LoadInt32(&notified))
+
+	assert.Equal(t, "[1 2]", Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{Swap_vals_BANG_.X_invoke_Arity2(a, Inc)})))
+	assert.Equal(t, "[2 5]", Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{Reset_vals_BANG_.X_invoke_Arity2(a, 5.0)})))
+
+	Set_validator_BANG_.X_invoke_Arity2(a, Fn(func(x interface{}) interface{} { return x.(float64) < 10 }))
+	PanicsWith(t, "Assert failed: Validator rejected reference state\n(validate new-value)", func() { Reset_BANG_.X_invoke_Arity2(a, 10.0) })
+	assert.Equal(t, 5.0, Deref.X_invoke_Arity1(a))
+	assert.Equal(t, "#<Atom: 5>", Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{a})))
+}
+
+// A reference implementing the atom protocols, which the atom functions fall back to.
+type lockedRef struct {
+	sync.Mutex
+	val interface{}
+}
+
+func (this *lockedRef) swap(f func(interface{}) interface{}) interface{} {
+	this.Lock()
+	defer this.Unlock()
+	this.val = f(this.val)
+	return this.val
+}
+
+func (_ *lockedRef) CljsCoreIDeref__() {}
+func (this *lockedRef) X_deref_Arity1() interface{} {
+	return this.swap(func(x interface{}) interface{} { return x })
+}
+
+func (_ *lockedRef) CljsCoreIReset__() {}
+func (this *lockedRef) X_reset_BANG__Arity2(new_value interface{}) interface{} {
+	return this.swap(func(interface{}) interface{} { return new_value })
+}
+
+func (_ *lockedRef) CljsCoreISwap__() {}
+func (this *lockedRef) X_swap_BANG__Arity2(f interface{}) interface{} {
+	return this.swap(func(x interface{}) interface{} { return f.(CljsCoreIFn).X_invoke_Arity1(x) })
+}
+
+func (this *lockedRef) X_swap_BANG__Arity3(f interface{}, a interface{}) interface{} {
+	return this.swap(func(x interface{}) interface{} { return f.(CljsCoreIFn).X_invoke_Arity2(x, a) })
+}
+
+func (this *lockedRef) X_swap_BANG__Arity4(f interface{}, a interface{}, b interface{}) interface{} {
+	return this.swap(func(x interface{}) interface{} { return f.(CljsCoreIFn).X_invoke_Arity3(x, a, b) })
+}
+
+func (this *lockedRef) X_swap_BANG__Arity5(f interface{}, a interface{}, b interface{}, xs interface{}) interface{} {
+	return this.swap(func(x interface{}) interface{} { return Apply.X_invoke_Arity5(f, x, a, b, xs) })
+}
+
+func Test_AtomProtocols(t *testing.T) {
+	pr := func(x interface{}) string {
+		return Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{x})).(string)
+	}
+	r := &lockedRef{val: 1.0}
+	assert.Equal(t, 2.0, Swap_BANG_.X_invoke_Arity2(r, Inc))
+	assert.Equal(t, "[2 3]", pr(Swap_vals_BANG_.X_invoke_Arity2(r, Inc)))
+	assert.Equal(t, "[3 6]", pr(Swap_vals_BANG_.X_invoke_ArityVariadic(r, X_PLUS_, Array_seq.X_invoke_Arity1([]interface{}{1.0, 2.0}))))
+	assert.Equal(t, "[6 0]", pr(Reset_vals_BANG_.X_invoke_Arity2(r, 0.0)))
+	assert.False(t, Compare_and_set_BANG_.X_invoke_Arity3(r, 1.0, 2.0).(bool))
+	assert.True(t, Compare_and_set_BANG_.X_invoke_Arity3(r, 0.0, 2.0).(bool))
+	assert.Equal(t, 2.0, Deref.X_invoke_Arity1(r))
+
+	rr := &resetRef{val: 1.0}
+	assert.False(t, Compare_and_set_BANG_.X_invoke_Arity3(rr, 0.0, 2.0).(bool))
+	assert.Equal(t, 0, rr.resets)
+	assert.True(t, Compare_and_set_BANG_.X_invoke_Arity3(rr, 1.0, 2.0).(bool))
+	assert.Equal(t, 1, rr.resets)
+	assert.Equal(t, 2.0, Deref.X_invoke_Arity1(rr))
+}
+
+// A reference implementing only IDeref and IReset.
+type resetRef struct {
+	val    interface{}
+	resets int
+}
+
+func (_ *resetRef) CljsCoreIDeref__() {}
+func (this *resetRef) X_deref_Arity1() interface{} {
+	return this.val
+}
+
+func (_ *resetRef) CljsCoreIReset__() {}
+func (this *resetRef) X_reset_BANG__Arity2(new_value interface{}) interface{} {
+	this.resets++
+	this.val = new_value
+	return new_value
+}
+
+func Test_FuturesAndPromises(t *testing.T) {
+	p := Promise.X_invoke_Arity0()
+	f := Future_call.X_invoke_Arity1(Fn(func() interface{} {
+		return Deref.X_invoke_Arity1(p).(float64) * 2
+	}))
+	assert.True(t, Future_QMARK_.Arity1IB(f))
+	assert.False(t, Future_QMARK_.Arity1IB(p))
+	assert.False(t, Realized_QMARK_.Arity1IB(p))
+	assert.False(t, Future_done_QMARK_.Arity1IB(f))
+	assert.Equal(t, "timeout", Deref.X_invoke_Arity3(f, 10.0, "timeout"))
+	assert.Equal(t, "#<Future: :pending>", Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{f})))
+
+	assert.Equal(t, p, Deliver.X_invoke_Arity2(p, 21.0))
+	assert.Nil(t, Deliver.X_invoke_Arity2(p, 1.0))
+	assert.True(t, Realized_QMARK_.Arity1IB(p))
+	assert.Equal(t, 21.0, Deref.X_invoke_Arity3(p, 10.0, "timeout"))
+	assert.Equal(t, 42.0, Deref.X_invoke_Arity1(f))
+	assert.True(t, Future_done_QMARK_.Arity1IB(f))
+	assert.False(t, Future_cancel.Arity1IB(f))
+	assert.Equal(t, "#<Promise: 21>", Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{p})))
+
+	f = Future_call.X_invoke_Arity1(Fn(func() interface{} {
+		panic(&js.Error{"Boom"})
+	}))
+	PanicsWith(t, "Boom", func() { Deref.X_invoke_Arity1(f) })
+	PanicsWith(t, "Boom", func() { Deref.X_invoke_Arity3(f, 10.0, nil) })
+
+	f = Future_call.X_invoke_Arity1(Fn(func() interface{} {
+		time.Sleep(time.Second)
+		return "done"
+	}))
+	assert.True(t, Future_cancel.Arity1IB(f))
+	assert.False(t, Future_cancel.Arity1IB(f))
+	assert.True(t, Future_cancelled_QMARK_.Arity1IB(f))
+	assert.True(t, Future_done_QMARK_.Arity1IB(f))
+	PanicsWith(t, "Future was cancelled", func() { Deref.X_invoke_Arity1(f) })
+}
+
+func Test_BindingPrintVarsToNil(t *testing.T) {
+	prStr := func(bindings ...interface{}) interface{} {
+		Push_thread_bindings.X_invoke_Arity1(Hash_map.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1(bindings)))
+		defer Pop_thread_bindings.X_invoke_Arity0()
+		return Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{
+			With_meta.X_invoke_Arity2(Vector.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{1.0, Vector.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{2.0}))})), Hash_map.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{Keyword.X_invoke_Arity1("m"), true})))}))
+	}
+	assert.Equal(t, "[1 [2]]", prStr())
+	assert.Equal(t, "[1 [2]]", prStr("cljs.core/*print-length*", nil, "cljs.core/*print-level*", nil, "cljs.core/*print-meta*", nil))
+	assert.Equal(t, "[1 ...]", prStr("cljs.core/*print-length*", 1.0))
+	assert.Equal(t, "[1 #]", prStr("cljs.core/*print-level*", 1.0))
+	assert.Equal(t, "^{:m true} [1 [2]]", prStr("cljs.core/*print-meta*", "yes"))
+}
+
+func Test_ThreadBindings(t *testing.T) {
+	printLength := func() interface{} {
+		return Dynamic_("cljs.core/*print-length*", X_STAR_print_length_STAR_)
+	}
+	prStr := func(x interface{}) interface{} {
+		return Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{x}))
+	}
+	assert.Equal(t, "{}", prStr(Get_thread_bindings.X_invoke_Arity0()))
+	assert.False(t, Thread_bound_QMARK_.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{"cljs.core/*print-length*"})).(bool))
+
+	Push_thread_bindings.X_invoke_Arity1(Hash_map.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{"cljs.core/*print-length*", 1.0})))
+	assert.Equal(t, 1.0, printLength())
+	assert.Equal(t, "[1 ...]", prStr(Vector.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{1.0, 2.0}))))
+	assert.Equal(t, "{cljs.core/*print-length* 1}", prStr(Get_thread_bindings.X_invoke_Arity0()))
+	assert.True(t, Thread_bound_QMARK_.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{Symbol.X_invoke_Arity1("cljs.core/*print-length*")})).(bool))
+
+	other := make(chan interface{})
+	go func() { other <- printLength() }()
+	assert.Nil(t, <-other)
+	assert.Equal(t, 1.0, Deref.X_invoke_Arity1(Future_call.X_invoke_Arity1(Fn(printLength))))
+	PanicsWith(t, "Can't set!: cljs.core/*print-length* from non-binding goroutine", func() {
+		Deref.X_invoke_Arity1(Future_call.X_invoke_Arity1(Fn(func() interface{} {
+			return Set_dynamic_("cljs.core/*print-length*", 2.0)
+		})))
+	})
+
+	bound := Bound_fn_STAR_.X_invoke_Arity1(Fn(printLength))
+	assert.True(t, Set_dynamic_("cljs.core/*print-length*", 2.0))
+	assert.Equal(t, 2.0, printLength())
+	assert.Equal(t, 3.0, With_bindings_STAR_.X_invoke_Arity2(Hash_map.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{"cljs.core/*print-length*", 3.0})), Fn(printLength)))
+	assert.Equal(t, 2.0, printLength())
+	Pop_thread_bindings.X_invoke_Arity0()
+
+	assert.Nil(t, printLength())
+	assert.Equal(t, 1.0, bound.(CljsCoreIFn).X_invoke_Arity0())
+	assert.Nil(t, printLength())
+	PanicsWith(t, "Pop without matching push", func() { Pop_thread_bindings.X_invoke_Arity0() })
+}
+
+func Test_LeakedBindingFrames(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			Push_thread_bindings.X_invoke_Arity1(Hash_map.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{"cljs.core/*leaked*", 1.0})))
+		}()
+		<-done
+	}
+	assert.True(t, bindingFrames.bound < 64)
+	time.Sleep(10 * time.Millisecond)
+	sweepFrames()
+	assert.Equal(t, 0, bindingFrames.bound)
+	assert.Equal(t, int64(0), atomic.LoadInt64(&boundGoroutines))
+	assert.False(t, varBound("cljs.core/*leaked*"))
+	assert.Nil(t, Dynamic_("cljs.core/*leaked*", nil))
+}
+
+func Benchmark_DynamicUnbound(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Dynamic_("cljs.core/*unbound*", 1.0)
+	}
+}
+
+func Benchmark_DynamicBound(b *testing.B) {
+	Push_thread_bindings.X_invoke_Arity1(Hash_map.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{"cljs.core/*bound*", 1.0})))
+	defer Pop_thread_bindings.X_invoke_Arity0()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Dynamic_("cljs.core/*bound*", nil)
+	}
+}
+
+func Benchmark_BindingManyGoroutines(b *testing.B) {
+	bindings := Hash_map.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{"cljs.core/*bound*", 1.0}))
+	b.SetParallelism(64)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			Push_thread_bindings.X_invoke_Arity1(bindings)
+			for i := 0; i < 10; i++ {
+				Dynamic_("cljs.core/*bound*", nil)
+			}
+			Pop_thread_bindings.X_invoke_Arity0()
+		}
+	})
+}
+
+func Test_DynamicOrZero(t *testing.T) {
+	var root *AFn = Identity
+	Push_thread_bindings.X_invoke_Arity1(Hash_map.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{"cljs.core/*n*", nil, "cljs.core/*f*", nil})))
+	defer Pop_thread_bindings.X_invoke_Arity0()
+	assert.Equal(t, 0.0, Dynamic_or_zero_("cljs.core/*n*", 1.0).(float64))
+	assert.Nil(t, Dynamic_or_zero_("cljs.core/*f*", root).(*AFn))
+	assert.Equal(t, Identity, Dynamic_or_zero_("cljs.core/*unbound*", root).(*AFn))
+	assert.Nil(t, Dynamic_or_zero_("cljs.core/*n*", nil))
+}
+
+func Test_Agents(t *testing.T) {
+	seq := func(xs ...interface{}) interface{} {
+		return Array_seq.X_invoke_Arity1(xs)
+	}
+	a := Agent.X_invoke_Arity1(0.0)
+	var notified int32
+	Add_watch.X_invoke_Arity3(a, Keyword.X_invoke_Arity1("count"), Fn(func(_, _, oldval, newval interface{}) interface{} {
+		atomic.AddInt32(&notified, 1)
+		return nil
+	}))
+	for i := 0; i < 100; i++ {
+		Send.X_invoke_Arity2(a, Inc)
+		Send_off.X_invoke_ArityVariadic(a, X_PLUS_, seq(2.0))
+	}
+	Await.X_invoke_ArityVariadic(seq(a))
+	assert.Equal(t, 300.0, Deref.X_invoke_Arity1(a))
+	assert.Equal(t, int32(200), atomic.LoadInt32(&notified))
+	assert.Equal(t, "#<Agent: 300>", Pr_str.X_invoke_ArityVariadic(seq(a)))
+
+	b := Agent.X_invoke_Arity1(0.0)
+	Send.X_invoke_Arity2(a, Fn(func(x interface{}) interface{} {
+		assert.Equal(t, a, Dynamic_("cljs.core/*agent*", X_STAR_agent_STAR_))
+		Send.X_invoke_Arity2(b, Fn(func(_ interface{}) interface{} {
+			return Deref.X_invoke_Arity1(a)
+		}))
+		return "done"
+	}))
+	assert.Equal(t, true, Await_for.X_invoke_ArityVariadic(1000.0, seq(a)))
+	Await.X_invoke_ArityVariadic(seq(b))
+	assert.Equal(t, "done", Deref.X_invoke_Arity1(b))
+	assert.Nil(t, Dynamic_("cljs.core/*agent*", X_STAR_agent_STAR_))
+
+	block := make(chan struct{})
+	Send_off.X_invoke_Arity2(a, Fn(func(x interface{}) interface{} {
+		<-block
+		return x
+	}))
+	assert.Equal(t, false, Await_for.X_invoke_ArityVariadic(10.0, seq(a)))
+	close(block)
+
+	Set_validator_BANG_.X_invoke_Arity2(a, String_QMARK_)
+	Send.X_invoke_Arity2(a, Fn(func(_ interface{}) interface{} { return 1.0 }))
+	for Agent_error.X_invoke_Arity1(a) == nil {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(t, "done", Deref.X_invoke_Arity1(a))
+	assert.Equal(t, "Assert failed: Validator rejected reference state\n(validate new-value)", fmt.Sprint(Agent_error.X_invoke_Arity1(a)))
+	PanicsWith(t, "Agent is failed, needs restart", func() { Send.X_invoke_Arity2(a, Identity) })
+	Restart_agent.X_invoke_Arity2(a, "restarted")
+	Await.X_invoke_ArityVariadic(seq(a))
+	assert.Nil(t, Agent_error.X_invoke_Arity1(a))
+	assert.Equal(t, "restarted", Deref.X_invoke_Arity1(a))
+	PanicsWith(t, "Agent does not need a restart", func() { Restart_agent.X_invoke_Arity2(a, "again") })
+
+	errors := make(chan interface{}, 1)
+	c := Agent.X_invoke_ArityVariadic(1.0, seq(Keyword.X_invoke_Arity1("error-handler"), Fn(func(_, err interface{}) interface{} {
+		errors <- err
+		return nil
+	})))
+	assert.True(t, X_EQ_.Arity2IIB(Keyword.X_invoke_Arity1("continue"), Error_mode.X_invoke_Arity1(c)))
+	Send.X_invoke_Arity2(c, Fn(func(_ interface{}) interface{} { panic(&js.Error{"Boom"}) }))
+	Send.X_invoke_Arity2(c, Inc)
+	assert.Equal(t, "Boom", fmt.Sprint(<-errors))
+	Await.X_invoke_ArityVariadic(seq(c))
+	assert.Equal(t, 2.0, Deref.X_invoke_Arity1(c))
+	assert.Nil(t, Agent_error.X_invoke_Arity1(c))
+}
+
+func Test_ShutdownAgents(t *testing.T) {
+	defer atomic.StoreInt32(&agentsShutdown, 0)
+	a := Agent.X_invoke_Arity1(0.0)
+	block := make(chan struct{})
+	Send_off.X_invoke_Arity2(a, Fn(func(x interface{}) interface{} {
+		<-block
+		return x
+	}))
+	for i := 0; i < 10; i++ {
+		Send.X_invoke_Arity2(a, Inc)
+	}
+	Shutdown_agents.X_invoke_Arity0()
+	PanicsWith(t, "Agents have been shut down", func() { Send.X_invoke_Arity2(a, Inc) })
+	PanicsWith(t, "Agents have been shut down", func() { Send_off.X_invoke_Arity2(a, Inc) })
+	close(block)
+	Await.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{a}))
+	assert.Equal(t, 10.0, Deref.X_invoke_Arity1(a))
+	assert.Nil(t, Agent_error.X_invoke_Arity1(a))
+}
+
+func Test_Refs(t *testing.T) {
+	seq := func(xs ...interface{}) interface{} {
+		return Array_seq.X_invoke_Arity1(xs)
+	}
+	dosync := func(f func() interface{}) interface{} {
+		return Run_in_transaction.X_invoke_Arity1(Fn(f))
+	}
+	a, b := Ref.X_invoke_Arity1(100.0), Ref.X_invoke_Arity1(100.0)
+	counter := Ref.X_invoke_Arity1(0.0)
+	var notified int32
+	Add_watch.X_invoke_Arity3(counter, Keyword.X_invoke_Arity1("count"), Fn(func(_, _, oldval, newval interface{}) interface{} {
+		atomic.AddInt32(&notified, 1)
+		return nil
+	}))
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				dosync(func() interface{} {
+					from, to := a, b
+					if i%2 == 0 {
+						from, to = b, a
+					}
+					Alter.X_invoke_Arity2(from, Dec)
+					Alter.X_invoke_Arity2(to, Inc)
+					Ensure.X_invoke_Arity1(counter)
+					return nil
+				})
+				dosync(func() interface{} {
+					return Commute.X_invoke_Arity2(counter, Inc)
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, 200.0, Deref.X_invoke_Arity1(a).(float64)+Deref.X_invoke_Arity1(b).(float64))
+	assert.Equal(t, 200.0, Deref.X_invoke_Arity1(counter))
+	assert.Equal(t, int32(200), atomic.LoadInt32(&notified))
+
+	PanicsWith(t, "No transaction running", func() { Ref_set.X_invoke_Arity2(a, 0.0) })
+	PanicsWith(t, "Boom", func() {
+		dosync(func() interface{} {
+			Ref_set.X_invoke_Arity2(a, 0.0)
+			assert.Equal(t, 0.0, Deref.X_invoke_Arity1(a))
+			panic(&js.Error{"Boom"})
+		})
+	})
+	assert.NotEqual(t, 0.0, Deref.X_invoke_Arity1(a))
+
+	v := Ref.X_invoke_ArityVariadic(1.0, seq(Keyword.X_invoke_Arity1("validator"), Pos_QMARK_, Keyword.X_invoke_Arity1("min-history"), 2.0))
+	PanicsWith(t, "Assert failed: Validator rejected reference state\n(validate new-value)", func() {
+		dosync(func() interface{} { return Ref_set.X_invoke_Arity2(v, -1.0) })
+	})
+	assert.Equal(t, 1.0, Deref.X_invoke_Arity1(v))
+	assert.Equal(t, 0.0, Ref_history_count.X_invoke_Arity1(v))
+	for i := 2.0; i <= 4; i++ {
+		dosync(func() interface{} { return Ref_set.X_invoke_Arity2(v, i) })
+	}
+	assert.Equal(t, 2.0, Ref_history_count.X_invoke_Arity1(v))
+	assert.Equal(t, 2.0, Ref_min_history.X_invoke_Arity1(v))
+	assert.Equal(t, 10.0, Ref_max_history.X_invoke_Arity1(Ref_max_history.X_invoke_Arity2(v, 10.0)))
+	assert.Equal(t, "#<Ref: 4>", Pr_str.X_invoke_ArityVariadic(seq(v)))
+
+	ag := Agent.X_invoke_Arity1(0.0)
+	dosync(func() interface{} {
+		Send.X_invoke_Arity2(ag, Inc)
+		time.Sleep(10 * time.Millisecond)
+		assert.Equal(t, 0.0, Deref.X_invoke_Arity1(ag))
+		return nil
+	})
+	Await.X_invoke_ArityVariadic(seq(ag))
+	assert.Equal(t, 1.0, Deref.X_invoke_Arity1(ag))
+}
+
+func Test_RefCreatedInTransaction(t *testing.T) {
+	other := Ref.X_invoke_Arity1(0.0)
+	commitOther := func() {
+		done := make(chan bool)
+		go func() {
+			Run_in_transaction.X_invoke_Arity1(Fn(func() interface{} { return Alter.X_invoke_Arity2(other, Inc) }))
+			close(done)
+		}()
+		<-done
+	}
+	attempts := 0
+	val := Run_in_transaction.X_invoke_Arity1(Fn(func() interface{} {
+		if attempts++; attempts < 5 {
+			commitOther()
+		}
+		r := Ref.X_invoke_Arity1(1.0)
+		return Alter.X_invoke_Arity2(r, Inc)
+	}))
+	assert.Equal(t, 2.0, val)
+	assert.Equal(t, 1, attempts)
+}
+
+func Test_Pmap(t *testing.T) {
+	seq := func(xs ...interface{}) interface{} {
+		return Array_seq.X_invoke_Arity1(xs)
+	}
+	var running, maxRunning int32
+	slowInc := Fn(func(x interface{}) interface{} {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return x.(float64) + 1
+	})
+	xs := Pmap.X_invoke_Arity2(slowInc, Range_.X_invoke_Arity1(100.0))
+	assert.Equal(t, Vec.X_invoke_Arity1(Range_.X_invoke_Arity2(1.0, 101.0)), Vec.X_invoke_Arity1(xs))
+	assert.True(t, atomic.LoadInt32(&maxRunning) > 1)
+	assert.True(t, atomic.LoadInt32(&maxRunning) <= int32(pmapLookahead())+1)
+
+	atomic.StoreInt32(&maxRunning, 0)
+	xs = Pmap.X_invoke_Arity2(slowInc, Vec.X_invoke_Arity1(Range_.X_invoke_Arity1(100.0)))
+	assert.Equal(t, 1.0, First.X_invoke_Arity1(xs))
+	assert.True(t, atomic.LoadInt32(&maxRunning) <= int32(pmapLookahead())+1, "chunked seqs")
+	Doall.X_invoke_Arity1(xs)
+
+	assert.Equal(t, "(5 7 9)", Pr_str.X_invoke_ArityVariadic(seq(Pmap.X_invoke_ArityVariadic(X_PLUS_, Range_.X_invoke_Arity2(1.0, 4.0), seq(Range_.X_invoke_Arity2(4.0, 10.0))))))
+	assert.Equal(t, "(1 2)", Pr_str.X_invoke_ArityVariadic(seq(Pcalls.X_invoke_ArityVariadic(seq(Fn(func() interface{} { return 1.0 }), Fn(func() interface{} { return 2.0 }))))))
+	PanicsWith(t, "Boom", func() {
+		Doall.X_invoke_Arity1(Pmap.X_invoke_Arity2(Fn(func(x interface{}) interface{} { panic(&js.Error{"Boom"}) }), seq(1.0)))
+	})
+}
+
+type address struct {
+	Street string `json:"street"`
+	Zip    uint16 `json:"zip,omitempty"`
+}
+
+type named struct {
+	Name string
+}
+
+type person struct {
+	named
+	Age      int              `json:"age"`
+	Address  *address         `json:"address"`
+	Tags     []string         `json:"tags"`
+	Scores   map[string]int64 `json:"scores"`
+	Born     time.Time        `json:"born"`
+	Friends  []*person        `json:"friends,omitempty"`
+	Secret   string           `json:"-"`
+	internal bool
+	Extra    map[int][2]uint8
+}
+
+type Labeled struct {
+	Label string
+}
+
+type counter struct {
+	*Labeled
+	Count int
+}
+
+func Test_GoToClj(t *testing.T) {
+	seq := func(xs ...interface{}) interface{} {
+		return Array_seq.X_invoke_Arity1(xs)
+	}
+	str := func(x interface{}) interface{} {
+		return Pr_str.X_invoke_ArityVariadic(seq(x))
+	}
+	keyword := func(k string) interface{} {
+		return Keyword.X_invoke_Arity1(k)
+	}
+	born := time.Date(1970, 1, 1, 0, 0, 1, 0, time.UTC)
+	p := &person{named: named{"Alice"}, Age: 42, Address: &address{Street: "Main"}, Tags: []string{"a", "b"},
+		Scores: map[string]int64{"x": 1}, Born: born, Secret: "s", Extra: map[int][2]uint8{1: {2, 3}}}
+
+	m := Go__GT_clj.X_invoke_Arity1(p)
+	assert.Equal(t, "Alice", Get.X_invoke_Arity2(m, "Name"))
+	assert.Equal(t, 42.0, Get.X_invoke_Arity2(m, "age"))
+	assert.Equal(t, `{"street" "Main"}`, str(Get.X_invoke_Arity2(m, "address")))
+	assert.Equal(t, `["a" "b"]`, str(Get.X_invoke_Arity2(m, "tags")))
+	assert.Equal(t, `{"x" 1}`, str(Get.X_invoke_Arity2(m, "scores")))
+	assert.Equal(t, `{1 [2 3]}`, str(Get.X_invoke_Arity2(m, "Extra")))
+	assert.Equal(t, 1000.0, Get.X_invoke_Arity2(m, "born").(*js.Date).GetTime())
+	assert.Equal(t, 7.0, Count.X_invoke_Arity1(m))
+	assert.False(t, Contains_QMARK_.Arity2IIB(m, "Secret"))
+	assert.False(t, Contains_QMARK_.Arity2IIB(m, "friends"))
+
+	m = Go__GT_clj.X_invoke_ArityVariadic(p, seq(keyword("keywordize-keys"), true))
+	assert.Equal(t, "Alice", Get.X_invoke_Arity2(m, keyword("Name")))
+	assert.Equal(t, `{:street "Main"}`, str(Get.X_invoke_Arity2(m, keyword("address"))))
+	assert.Equal(t, `{:x 1}`, str(Get.X_invoke_Arity2(m, keyword("scores"))))
+
+	m = Go__GT_clj.X_invoke_ArityVariadic(p, seq(keyword("depth"), 1.0))
+	assert.Equal(t, p.Address, Get.X_invoke_Arity2(m, "address"))
+	assert.Equal(t, p.Tags, Get.X_invoke_Arity2(m, "tags"))
+	assert.Equal(t, 42.0, Get.X_invoke_Arity2(m, "age"))
+	assert.Equal(t, "Alice", Get.X_invoke_Arity2(m, "Name"))
+	assert.Equal(t, 1000.0, Get.X_invoke_Arity2(m, "born").(*js.Date).GetTime())
+	assert.Equal(t, `[1 "x" {"a" [2]}]`, str(Go__GT_clj.X_invoke_ArityVariadic([]interface{}{1, "x", map[string][]int{"a": {2}}}, seq(keyword("depth"), 3.0))))
+	assert.Equal(t, p, Go__GT_clj.X_invoke_ArityVariadic(p, seq(keyword("depth"), 0.0)))
+
+	v := Vector.X_invoke_ArityVariadic(seq(1.0))
+	assert.Equal(t, `[[1] :k nil 1.5 true]`, str(Go__GT_clj.X_invoke_Arity1([]interface{}{v, keyword("k"), nil, float32(1.5), true})))
+	assert.Nil(t, Go__GT_clj.X_invoke_Arity1((*person)(nil)))
+	assert.Nil(t, Go__GT_clj.X_invoke_Arity1([]int(nil)))
+	assert.Equal(t, `[]`, str(Go__GT_clj.X_invoke_Arity1([0]int{})))
+
+	friend := &person{Age: 1}
+	p = &person{Age: 2, Friends: []*person{friend, friend}}
+	p.Friends = append(p.Friends, p)
+	m = Go__GT_clj.X_invoke_Arity1(p)
+	friends := Get.X_invoke_Arity2(m, "friends")
+	assert.Equal(t, 1.0, Get.X_invoke_Arity2(Nth.X_invoke_Arity2(friends, 0.0), "age"))
+	assert.Equal(t, 1.0, Get.X_invoke_Arity2(Nth.X_invoke_Arity2(friends, 1.0), "age"))
+	assert.Equal(t, p, Nth.X_invoke_Arity2(friends, 2.0))
+	xs := []interface{}{1, nil}
+	xs[1] = xs
+	assert.Equal(t, 1.0, First.X_invoke_Arity1(Go__GT_clj.X_invoke_Arity1(xs)))
+}
+
+func Test_CljToGo(t *testing.T) {
+	seq := func(xs ...interface{}) interface{} {
+		return Array_seq.X_invoke_Arity1(xs)
+	}
+	keyword := func(k string) interface{} {
+		return Keyword.X_invoke_Arity1(k)
+	}
+	vector := func(xs ...interface{}) interface{} {
+		return Vector.X_invoke_ArityVariadic(seq(xs...))
+	}
+	hashMap := func(kvs ...interface{}) interface{} {
+		return Hash_map.X_invoke_ArityVariadic(seq(kvs...))
+	}
+	born := time.Date(1970, 1, 1, 0, 0, 1, 0, time.UTC)
+	p := &person{Secret: "s"}
+	assert.Equal(t, p, Clj__GT_go.X_invoke_Arity2(hashMap(keyword("Name"), "Alice", keyword("age"), 42.0, keyword("address"), hashMap(keyword("street"), "Main", "zip", 12345.0),
+		keyword("tags"), vector("a", keyword("b")), keyword("scores"), hashMap(keyword("x"), 1.0), keyword("born"), &js.Date{born},
+		keyword("secret"), "x", keyword("unknown"), 1.0, keyword("extra"), hashMap(1.0, vector(2.0, 3.0))), p))
+	assert.Equal(t, &person{named: named{"Alice"}, Age: 42, Address: &address{Street: "Main", Zip: 12345}, Tags: []string{"a", "b"},
+		Scores: map[string]int64{"x": 1}, Born: born, Secret: "s", Extra: map[int][2]uint8{1: {2, 3}}}, p)
+	assert.Equal(t, p, Go__GT_clj.X_invoke_ArityVariadic(p, seq(keyword("depth"), 0.0)))
+
+	var ss []string
+	Clj__GT_go.X_invoke_Arity2(List.X_invoke_ArityVariadic(seq("x", "y")), &ss)
+	assert.Equal(t, []string{"x", "y"}, ss)
+	m := map[string]int{"a": 1}
+	Clj__GT_go.X_invoke_Arity2(hashMap(keyword("b"), 2.0), &m)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, m)
+	var x interface{}
+	Clj__GT_go.X_invoke_Arity2(hashMap(keyword("a"), vector(1.0, keyword("b"))), &x)
+	assert.Equal(t, map[string]interface{}{"a": []interface{}{1.0, "b"}}, x)
+
+	c := &counter{}
+	Clj__GT_go.X_invoke_Arity2(hashMap(keyword("count"), 1.0, keyword("unknown"), 2.0), c)
+	assert.Equal(t, &counter{Count: 1}, c)
+	Clj__GT_go.X_invoke_Arity2(hashMap(keyword("label"), "x"), c)
+	assert.Equal(t, &counter{Labeled: &Labeled{"x"}, Count: 1}, c)
+
+	PanicsWith(t, "Can't convert 70000 to uint16 at [:address :zip]: out of range", func() {
+		Clj__GT_go.X_invoke_Arity2(hashMap(keyword("address"), hashMap(keyword("zip"), 70000.0)), &person{})
+	})
+	PanicsWith(t, "Can't convert 1.5 to int at [:scores :x]: not an integer", func() {
+		Clj__GT_go.X_invoke_Arity2(hashMap(keyword("scores"), hashMap(keyword("x"), 1.5)), &map[string]map[string]int{})
+	})
+	PanicsWith(t, "Can't convert 1 to string at [:tags 1]: not a string", func() {
+		Clj__GT_go.X_invoke_Arity2(hashMap(keyword("tags"), vector("a", 1.0)), &person{})
+	})
+	PanicsWith(t, "Can't convert -1 to uint8: out of range", func() {
+		Clj__GT_go.X_invoke_Arity2(-1.0, new(uint8))
+	})
+	PanicsWith(t, "Can't convert [1 2 3] to [2]uint8: too many elements", func() {
+		Clj__GT_go.X_invoke_Arity2(vector(1.0, 2.0, 3.0), new([2]uint8))
+	})
+	PanicsWith(t, "clj->go target must be a non-nil pointer, got core.person", func() {
+		Clj__GT_go.X_invoke_Arity2(hashMap(), person{})
+	})
+}
+
+func Test_GoErrors(t *testing.T) {
+	recovered := func(f func()) (x interface{}) {
+		defer func() { x = recover() }()
+		f()
+		return
+	}
+
+	e := recovered(func() { Native_invoke_func.X_invoke_Arity2(strconv.Atoi, []interface{}{"x"}) })
+	assert.IsType(t, &js.Error{}, e)
+	assert.True(t, Instance_(reflect.TypeOf((*strconv.NumError)(nil)).Elem(), e))
+	assert.True(t, Instance_(strconv.ErrSyntax, e))
+	assert.False(t, Instance_(strconv.ErrRange, e))
+	assert.False(t, Instance_(reflect.TypeOf((*os.PathError)(nil)).Elem(), e))
+	assert.True(t, Error_is_QMARK_.X_invoke_Arity2(e, strconv.ErrSyntax).(bool))
+	assert.Equal(t, "x", Error_as.X_invoke_Arity2(e, reflect.TypeOf((*strconv.NumError)(nil)).Elem()).(*strconv.NumError).Num)
+	assert.Nil(t, Error_as.X_invoke_Arity2("x", reflect.TypeOf((*strconv.NumError)(nil)).Elem()))
+	assert.Equal(t, 42, Native_invoke_func.X_invoke_Arity2(strconv.Atoi, []interface{}{"42"}))
+
+	Push_thread_bindings.X_invoke_Arity1(Hash_map.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{"cljs.core/*go-error-tuples*", true})))
+	tuple := Native_invoke_func.X_invoke_Arity2(strconv.Atoi, []interface{}{"x"})
+	Pop_thread_bindings.X_invoke_Arity0()
+	assert.Equal(t, 0, Nth.X_invoke_Arity2(tuple, 0.0))
+	assert.True(t, Instance_(strconv.ErrSyntax, Nth.X_invoke_Arity2(tuple, 1.0)))
+
+	e = recovered(func() { Native_invoke_func.X_invoke_Arity2(func() { panic("boom") }, []interface{}{}) })
+	assert.IsType(t, &js.Error{}, e)
+	assert.Equal(t, "boom", e.(*js.Error).Message.(*js.PanicError).Value)
+	assert.Contains(t, e.(*js.Error).Message.(*js.PanicError).Stack, "errors.go")
+	assert.True(t, Instance_(reflect.TypeOf((*js.PanicError)(nil)), e))
+
+	e = recovered(func() {
+		Native_invoke_func.X_invoke_Arity2(func(s []string) string { return s[1] }, []interface{}{[]string{}})
+	})
+	assert.Equal(t, "runtime error: index out of range [1] with length 0", e.(error).Error())
+	assert.True(t, Instance_(reflect.TypeOf((*runtime.Error)(nil)).Elem(), e))
+
+	thrown := &js.Error{"from cljs"}
+	e = recovered(func() {
+		Native_invoke_func.X_invoke_Arity2(func(f func()) { f() }, []interface{}{func() { panic(thrown) }})
+	})
+	assert.Equal(t, thrown, e)
+}
+
+func Test_ExceptionInfo(t *testing.T) {
+	exInfo := func(msg string, data interface{}, cause interface{}) error {
+		return Ex_info.X_invoke_Arity3(msg, data, cause).(error)
+	}
+	data := Hash_map.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{Keyword.X_invoke_Arity1("id"), 1.0}))
+	_, cause := strconv.Atoi("x")
+	inner := exInfo("inner", data, cause)
+	outer := fmt.Errorf("wrapped: %w", exInfo("outer", nil, inner))
+
+	assert.True(t, errors.Is(outer, strconv.ErrSyntax))
+	assert.True(t, errors.Is(outer, inner))
+	var e *CljsCoreExceptionInfo
+	assert.True(t, errors.As(outer, &e))
+	assert.Equal(t, "outer", e.Message)
+	assert.Equal(t, inner, Ex_cause.X_invoke_Arity1(e))
+	assert.Nil(t, exInfo("no cause", nil, "not an error").(*CljsCoreExceptionInfo).Unwrap())
+
+	assert.Nil(t, ExData_(e))
+	assert.Equal(t, data, ExData_(fmt.Errorf("wrapped: %w", inner)))
+	assert.Nil(t, ExData_(cause))
+	assert.Nil(t, ExData_(nil))
+
+	assert.Equal(t, "inner", fmt.Sprint(inner))
+	assert.Equal(t, "inner", fmt.Sprintf("%v", inner))
+	assert.Equal(t, `"inner"`, fmt.Sprintf("%q", inner))
+	assert.Equal(t, "outer\nCaused by: inner {:id 1}\nCaused by: strconv.Atoi: parsing \"x\": invalid syntax", fmt.Sprintf("%+v", e))
+}
+
+func Test_Demunge(t *testing.T) {
+	for munged, name := range map[string]string{
+		"X_invoke_Arity1":           "-invoke",
+		"X_invoke_ArityVariadic":    "-invoke",
+		"Empty_QMARK_":              "empty?",
+		"Reset_BANG_":               "reset!",
+		"Pr_str":                    "pr-str",
+		"Go__GT_clj":                "go->clj",
+		"X__GT_ExceptionInfo":       "->ExceptionInfo",
+		"X_STAR_print_length_STAR_": "*print-length*",
+		"Map_":                      "map",
+		"Nth":                       "nth",
+		"PersistentVector":          "PersistentVector",
+		"x___2":                     "x",
+		"X_":                        "-",
+		"X_EQ__EQ_":                 "==",
+	} {
+		assert.Equal(t, name, Demunge.X_invoke_Arity1(munged), munged)
+	}
+	assert.Equal(t, `goroutine 1 [running]:
+github.com/hraberg/cljs2go/cljs/core.(*AFn).-invoke(0xc000010000, {0x0, 0x0})
+	/go/src/github.com/hraberg/cljs2go/cljs/core/rt.go:120 +0x25
+github.com/hraberg/cljs2go/cljs/core.init.func12.1(...)
+	cljs/core.cljs:42 +0x1a
+github.com/hraberg/cljs2go/cljs/core.empty?(...)`, demungeStack(`goroutine 1 [running]:
+github.com/hraberg/cljs2go/cljs/core.(*AFn).X_invoke_Arity2(0xc000010000, {0x0, 0x0})
+	/go/src/github.com/hraberg/cljs2go/cljs/core/rt.go:120 +0x25
+github.com/hraberg/cljs2go/cljs/core.init.func12.1(...)
+	cljs/core.cljs:42 +0x1a
+github.com/hraberg/cljs2go/cljs/core.Empty_QMARK_(...)`))
+
+	e := func() (x interface{}) {
+		defer func() { x = recover() }()
+		Native_invoke_func.X_invoke_Arity2(Fn(func() interface{} { panic("boom") }).X_invoke_Arity0, []interface{}{})
+		return
+	}()
+	assert.Contains(t, e.(*js.Error).Message.(*js.PanicError).Stack, ".-invoke(")
+	assert.Contains(t, fmt.Sprintf("%+v", Ex_info.X_invoke_Arity3("outer", nil, e)), "boom\ngoroutine ")
+}
+
+func Test_Namespaces(t *testing.T) {
+	sym := func(ns, name string) interface{} {
+		if ns == "" {
+			return Symbol.X_invoke_Arity1(name)
+		}
+		return Symbol.X_invoke_Arity2(ns, name)
+	}
+	kw := func(name string) interface{} {
+		return Keyword.X_invoke_Arity1(name)
+	}
+	pr := func(x interface{}) string {
+		return Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{x})).(string)
+	}
+
+	core := Find_ns.X_invoke_Arity1(sym("", "cljs.core"))
+	assert.Equal(t, "#<Namespace cljs.core>", pr(core))
+	assert.Equal(t, sym("", "cljs.core"), Ns_name.X_invoke_Arity1(core))
+	assert.Nil(t, Find_ns.X_invoke_Arity1(sym("", "no.such.ns")))
+	assert.True(t, Truth_(Some.X_invoke_Arity2(Set.X_invoke_Arity1(Vector.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{core}))), All_ns.X_invoke_Arity0())))
+
+	v := Resolve.X_invoke_Arity1(sym("", "map")).(*CljsCoreVar)
+	assert.Equal(t, "#'cljs.core/map", pr(v))
+	assert.Equal(t, Map_, Var_get.X_invoke_Arity1(v))
+	assert.Equal(t, v, Find_var.X_invoke_Arity1(sym("cljs.core", "map")))
+	assert.Equal(t, v, Get.X_invoke_Arity2(Ns_publics.X_invoke_Arity1(core), sym("", "map")))
+	assert.Equal(t, "([f] [f coll] [f c1 c2] [f c1 c2 c3] [f c1 c2 c3 & colls])", pr(Get.X_invoke_Arity2(Meta.X_invoke_Arity1(v), kw("arglists"))))
+	assert.Contains(t, Get.X_invoke_Arity2(Meta.X_invoke_Arity1(v), kw("doc")).(string), "Returns a lazy sequence")
+	assert.Equal(t, core, Get.X_invoke_Arity2(Meta.X_invoke_Arity1(v), kw("ns")))
+	assert.Equal(t, true, Get.X_invoke_Arity2(Meta.X_invoke_Arity1(Resolve.X_invoke_Arity1(sym("", "*print-length*"))), kw("dynamic")))
+	assert.Equal(t, Swap_vals_BANG_, Var_get.X_invoke_Arity1(Resolve.X_invoke_Arity1(sym("", "swap-vals!"))))
+	assert.Nil(t, Resolve.X_invoke_Arity1(sym("", "no-such-var")))
+
+	test := Create_ns.X_invoke_Arity1(sym("", "cljs.ns-registry-test"))
+	assert.Equal(t, test, Create_ns.X_invoke_Arity1(sym("", "cljs.ns-registry-test")))
+	counter := Intern.X_invoke_Arity3(test, sym("", "counter"), 1.0)
+	assert.Equal(t, "#'cljs.ns-registry-test/counter", pr(counter))
+	assert.Equal(t, 3.0, Alter_var_root.X_invoke_ArityVariadic(counter, X_PLUS_, Array_seq.X_invoke_Arity1([]interface{}{2.0})))
+	assert.Equal(t, 3.0, Var_get.X_invoke_Arity1(counter))
+	assert.Equal(t, 3.0, Deref.X_invoke_Arity1(Ns_resolve.X_invoke_Arity2(test, sym("", "counter"))))
+	Intern.X_invoke_Arity2(test, With_meta.X_invoke_Arity2(sym("", "secret"), Hash_map.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{kw("private"), true}))))
+	assert.Equal(t, 2.0, Count.X_invoke_Arity1(Ns_interns.X_invoke_Arity1(test)))
+
+	var root *AFn = Identity
+	Intern_("cljs.ns-registry-test", "root", &root, map[string]interface{}{"private": true})
+	assert.Equal(t, 1.0, Count.X_invoke_Arity1(Ns_publics.X_invoke_Arity1(test)))
+	Alter_var_root.X_invoke_Arity2(Find_var.X_invoke_Arity1(sym("cljs.ns-registry-test", "root")), Constantly.X_invoke_Arity1(Inc))
+	assert.Equal(t, Inc, root)
+	PanicsWith(t, "Can't set cljs.ns-registry-test/root of type *core.AFn to float64", func() {
+		Intern.X_invoke_Arity3(test, sym("", "root"), 1.0)
+	})
+	registered := test.(*CljsCoreNamespace).lookup("root")
+	Intern_("cljs.ns-registry-test", "root", &root, map[string]interface{}{"doc": "The root."})
+	assert.Equal(t, "The root.", Get.X_invoke_Arity2(Meta.X_invoke_Arity1(Find_var.X_invoke_Arity1(sym("cljs.ns-registry-test", "root"))), kw("doc")))
+	assert.Equal(t, registered, test.(*CljsCoreNamespace).lookup("root"))
+
+	var reloaded *AFn = Identity
+	Intern_("cljs.ns-registry-test", "root", &reloaded, map[string]interface{}{"private": true})
+	Alter_var_root.X_invoke_Arity2(Find_var.X_invoke_Arity1(sym("cljs.ns-registry-test", "root")), Constantly.X_invoke_Arity1(Dec))
+	assert.Equal(t, Dec, reloaded)
+	assert.Equal(t, Inc, root)
+}
+
+func Test_VarIndirection(t *testing.T) {
+	v := Var_("cljs.var-indirection-test", "counter")
+	assert.Equal(t, v, Var_("cljs.var-indirection-test", "counter"))
+	Set_var_root_(v, 0.0)
+	Intern_("cljs.var-indirection-test", "counter", v, map[string]interface{}{"doc": "The counter."})
+	assert.Equal(t, v, Find_var.X_invoke_Arity1(Symbol.X_invoke_Arity2("cljs.var-indirection-test", "counter")))
+	assert.Equal(t, "The counter.", Get.X_invoke_Arity2(Meta.X_invoke_Arity1(v), Keyword.X_invoke_Arity1("doc")))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				Alter_var_root.X_invoke_Arity2(v, Inc)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = Var_root_(v).(float64) + Deref.X_invoke_Arity1(v).(float64)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1000.0, Var_root_(v))
+	assert.Equal(t, 1000.0, Deref.X_invoke_Arity1(v))
+	assert.Equal(t, 1000.0, Var_get.X_invoke_Arity1(v))
+}
+
 func PanicsWith(t *testing.T, message string, f assert.PanicTestFunc) {
 	assert.Equal(t, message, func() (message string) {
 		defer func() { message = fmt.Sprint(recover()) }()
@@ -0,0 +1,208 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"runtime/debug"
+	"strings"
+
+	"github.com/hraberg/cljs2go/js"
+)
+
+// Go errors and panics crossing into ClojureScript. Calls to native Go functions returning a
+// trailing error throw it wrapped in a js/Error, unless *go-error-tuples* is bound to true. Panics
+// in Go code are rethrown as a js/Error wrapping a js/PanicError, so catching js/Error catches them,
+// while exceptions thrown by ClojureScript pass through.
+// ExceptionInfo is a Go error in turn, wrapping its cause.
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// When true, native Go functions returning a trailing error return a vector of all their
+// results instead of throwing the error.
+var X_STAR_go_error_tuples_STAR_ interface{} = false
+
+func goPanic(x interface{}) interface{} {
+	if isOpaque(reflect.TypeOf(x)) {
+		return x
+	}
+	return &js.Error{&js.PanicError{Message: fmt.Sprint(x), Value: x, Stack: demungeStack(string(debug.Stack()))}}
+}
+
+// Returns the PanicError x wraps if it was rethrown by goPanic.
+func panicError(x interface{}) (*js.PanicError, bool) {
+	if e, ok := x.(*js.Error); ok {
+		p, ok := e.Message.(*js.PanicError)
+		return p, ok
+	}
+	return nil, false
+}
+
+func callGo(f reflect.Value, in []reflect.Value) []reflect.Value {
+	defer func() {
+		if x := recover(); x != nil {
+			panic(goPanic(x))
+		}
+	}()
+	return f.Call(in)
+}
+
+func goReturn(t reflect.Type, out []reflect.Value) interface{} {
+	if n := t.NumOut(); n > 0 && t.Out(n-1) == errorType {
+		if Truth_(Dynamic_("cljs.core/*go-error-tuples*", X_STAR_go_error_tuples_STAR_)) {
+			tuple := make([]interface{}, len(out))
+			for i, v := range out {
+				tuple[i] = v.Interface()
+			}
+			return Vec.X_invoke_Arity1(tuple)
+		}
+		if err := out[n-1]; !err.IsNil() {
+			panic(&js.Error{err.Interface()})
+		}
+		out = out[:n-1]
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out[0].Interface()
+}
+
+// Returns the value in the chain of err matching t, or nil. Struct types match pointers to them.
+func errorAs(err error, t reflect.Type) interface{} {
+	for _, t := range []reflect.Type{t, reflect.PtrTo(t)} {
+		if t.Kind() == reflect.Interface || t.Implements(errorType) {
+			if target := reflect.New(t); errors.As(err, target.Interface()) {
+				return target.Elem().Interface()
+			}
+		}
+		if t.Kind() != reflect.Struct {
+			break
+		}
+	}
+	return nil
+}
+
+// Returns true if x is an instance of the type t. If t is a Go error value, x matches if it wraps
+// it as by errors.Is, if t is a Go error type, x matches if it wraps an error of that type as by
+// errors.As. Used by instance? and catch when t isn't a statically known type.
+func Instance_(t interface{}, x interface{}) bool {
+	switch t := t.(type) {
+	case reflect.Type:
+		if Value_(x).Type().AssignableTo(t) {
+			return true
+		}
+		if err, ok := x.(error); ok {
+			return errorAs(err, t) != nil
+		}
+		return false
+	case error:
+		err, ok := x.(error)
+		return ok && errors.Is(err, t)
+	}
+	panic(&js.TypeError{"Not a type: " + fmt.Sprint(t)})
+}
+
+// Returns true if e is a Go error wrapping the error target, as by errors.Is.
+var Error_is_QMARK_ = Fn(func(e interface{}, target interface{}) bool {
+	err, ok := e.(error)
+	return ok && errors.Is(err, target.(error))
+})
+
+// Returns the first error in the chain of the Go error e of type t, as by errors.As, or nil. t is a
+// reflect.Type, see go-type.
+var Error_as = Fn(func(e interface{}, t interface{}) interface{} {
+	if err, ok := e.(error); ok {
+		return errorAs(err, t.(reflect.Type))
+	}
+	return nil
+})
+
+func (e *CljsCoreExceptionInfo) Error() string {
+	return fmt.Sprint(e.Message)
+}
+
+// Returns the cause of this ExceptionInfo if it is a Go error, so errors.Is and errors.As can
+// see through ex-info.
+func (e *CljsCoreExceptionInfo) Unwrap() error {
+	err, _ := e.Cause.(error)
+	return err
+}
+
+// Formats the message for %s, %v and %q. %+v also prints the ex-data and the chain of causes.
+func (e *CljsCoreExceptionInfo) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		io.WriteString(f, e.Error())
+		if e.Data != nil {
+			io.WriteString(f, " "+Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{e.Data})).(string))
+		}
+		if e.Cause != nil {
+			fmt.Fprintf(f, "\nCaused by: %+v", e.Cause)
+			if p, ok := panicError(e.Cause); ok {
+				io.WriteString(f, "\n"+p.Stack)
+			}
+		}
+	case verb == 'q':
+		fmt.Fprintf(f, "%q", e.Error())
+	default:
+		io.WriteString(f, e.Error())
+	}
+}
+
+// Returns the ex-data of the first ExceptionInfo in the chain of err, or nil.
+func ExData_(err error) interface{} {
+	var e *CljsCoreExceptionInfo
+	if errors.As(err, &e) {
+		return e.Data
+	}
+	return nil
+}
+
+var (
+	demungeArity  = regexp.MustCompile(`(_Arity(\d+|Variadic)|___\d+)$`)
+	demungeFrame  = regexp.MustCompile(`\.(\w+_\w*)`)
+	demungeTokens = strings.NewReplacer("_DOT__DOT_", "..", "_COLON_", ":", "_PLUS_", "+", "_GT_", ">", "_LT_", "<",
+		"_EQ_", "=", "_TILDE_", "~", "_BANG_", "!", "_CIRCA_", "@", "_SHARP_", "#", "_SINGLEQUOTE_", "'",
+		"_DOUBLEQUOTE_", "\"", "_PERCENT_", "%", "_CARET_", "^", "_AMPERSAND_", "&", "_STAR_", "*", "_BAR_", "|",
+		"_LBRACE_", "{", "_RBRACE_", "}", "_LBRACK_", "[", "_RBRACK_", "]", "_SLASH_", "/", "_BSLASH_", "\\",
+		"_QMARK_", "?", "_", "-")
+)
+
+// Reverses the munging of a ClojureScript name into a Go identifier, dropping the arity suffix.
+func demunge(s string) string {
+	s = demungeArity.ReplaceAllString(s, "")
+	public := !strings.HasPrefix(s, "X_")
+	if !public {
+		s = s[1:]
+	}
+	d := demungeTokens.Replace(s)
+	if len(d) > 1 {
+		d = strings.TrimSuffix(d, "-")
+	}
+	if public && len(d) > 1 && strings.ToLower(d[1:]) == d[1:] {
+		d = strings.ToLower(d[:1]) + d[1:]
+	}
+	return d
+}
+
+// Demunges the function names in a Go stack trace, like (*AFn).X_invoke_Arity1 to (*AFn).-invoke.
+func demungeStack(stack string) string {
+	lines := strings.Split(stack, "\n")
+	for i, l := range lines {
+		if l == "" || l[0] == '\t' {
+			continue
+		}
+		pkg := strings.LastIndex(l, "/") + 1
+		lines[i] = l[:pkg] + demungeFrame.ReplaceAllStringFunc(l[pkg:], func(id string) string {
+			return "." + demunge(id[1:])
+		})
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Returns the ClojureScript name of the munged Go identifier s.
+var Demunge = Fn(func(s interface{}) interface{} {
+	return demunge(s.(string))
+})
@@ -0,0 +1,225 @@
+package core
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hraberg/cljs2go/js"
+)
+
+// Futures and promises are backed by goroutines and a closed channel signalling completion.
+
+func derefWithTimeout(done chan struct{}, msec interface{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+	}
+	timer := time.NewTimer(time.Duration(msec.(float64) * float64(time.Millisecond)))
+	defer timer.Stop()
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+type CljsCoreFuture struct {
+	done      chan struct{}
+	val       interface{}
+	err       interface{}
+	failed    bool
+	cancelled int32
+}
+
+func (this *CljsCoreFuture) run(f interface{}) {
+	defer close(this.done)
+	defer func() {
+		if err := recover(); err != nil {
+			this.err, this.failed = err, true
+		}
+	}()
+	val := f.(CljsCoreIFn).X_invoke_Arity0()
+	if atomic.LoadInt32(&this.cancelled) == 0 {
+		this.val = val
+	}
+}
+
+func (this *CljsCoreFuture) get() interface{} {
+	if this.isCancelled() {
+		panic(&js.Error{"Future was cancelled"})
+	}
+	if this.failed {
+		panic(this.err)
+	}
+	return this.val
+}
+
+func (this *CljsCoreFuture) isDone() bool {
+	select {
+	case <-this.done:
+		return true
+	default:
+		return this.isCancelled()
+	}
+}
+
+func (this *CljsCoreFuture) isCancelled() bool {
+	return atomic.LoadInt32(&this.cancelled) == 1
+}
+
+func (this *CljsCoreFuture) cancel() bool {
+	select {
+	case <-this.done:
+		return false
+	default:
+		return atomic.CompareAndSwapInt32(&this.cancelled, 0, 1)
+	}
+}
+
+func (_ *CljsCoreFuture) CljsCoreIDeref__() {}
+func (this *CljsCoreFuture) X_deref_Arity1() interface{} {
+	if !this.isCancelled() {
+		<-this.done
+	}
+	return this.get()
+}
+
+func (_ *CljsCoreFuture) CljsCoreIDerefWithTimeout__() {}
+func (this *CljsCoreFuture) X_deref_with_timeout_Arity3(msec interface{}, timeout_val interface{}) interface{} {
+	if this.isCancelled() || derefWithTimeout(this.done, msec) {
+		return this.get()
+	}
+	return timeout_val
+}
+
+func (_ *CljsCoreFuture) CljsCoreIPending__() {}
+func (this *CljsCoreFuture) X_realized_QMARK__Arity1() bool {
+	return this.isDone()
+}
+
+func (_ *CljsCoreFuture) CljsCoreIPrintWithWriter__() {}
+func (this *CljsCoreFuture) X_pr_writer_Arity3(writer interface{}, opts interface{}) interface{} {
+	Decorate_(writer).(CljsCoreIWriter).X_write_Arity2("#<Future: ")
+	switch {
+	case this.isCancelled():
+		Decorate_(writer).(CljsCoreIWriter).X_write_Arity2(":cancelled")
+	case this.isDone() && !this.failed:
+		Pr_writer.X_invoke_Arity3(this.val, writer, opts)
+	case this.isDone():
+		Decorate_(writer).(CljsCoreIWriter).X_write_Arity2(":failed")
+	default:
+		Decorate_(writer).(CljsCoreIWriter).X_write_Arity2(":pending")
+	}
+	return Decorate_(writer).(CljsCoreIWriter).X_write_Arity2(">")
+}
+
+type CljsCorePromise struct {
+	done chan struct{}
+	val  interface{}
+	once sync.Once
+}
+
+func (this *CljsCorePromise) deliver(val interface{}) bool {
+	delivered := false
+	this.once.Do(func() {
+		this.val, delivered = val, true
+		close(this.done)
+	})
+	return delivered
+}
+
+func (this *CljsCorePromise) isRealized() bool {
+	select {
+	case <-this.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (_ *CljsCorePromise) CljsCoreIDeref__() {}
+func (this *CljsCorePromise) X_deref_Arity1() interface{} {
+	<-this.done
+	return this.val
+}
+
+func (_ *CljsCorePromise) CljsCoreIDerefWithTimeout__() {}
+func (this *CljsCorePromise) X_deref_with_timeout_Arity3(msec interface{}, timeout_val interface{}) interface{} {
+	if derefWithTimeout(this.done, msec) {
+		return this.val
+	}
+	return timeout_val
+}
+
+func (_ *CljsCorePromise) CljsCoreIPending__() {}
+func (this *CljsCorePromise) X_realized_QMARK__Arity1() bool {
+	return this.isRealized()
+}
+
+func (_ *CljsCorePromise) CljsCoreIPrintWithWriter__() {}
+func (this *CljsCorePromise) X_pr_writer_Arity3(writer interface{}, opts interface{}) interface{} {
+	Decorate_(writer).(CljsCoreIWriter).X_write_Arity2("#<Promise: ")
+	if this.isRealized() {
+		Pr_writer.X_invoke_Arity3(this.val, writer, opts)
+	} else {
+		Decorate_(writer).(CljsCoreIWriter).X_write_Arity2(":pending")
+	}
+	return Decorate_(writer).(CljsCoreIWriter).X_write_Arity2(">")
+}
+
+// Takes a function of no args and yields a future object that will
+// invoke the function in another goroutine, and will cache the result and
+// return it on all subsequent calls to deref/@. If the computation has
+// not yet finished, calls to deref/@ will block, unless the variant
+// of deref with timeout is used. Any error thrown by the function is
+// rethrown on deref. The bindings in effect are conveyed to the
+// goroutine. See also - realized?.
+var Future_call = Fn(func(f interface{}) interface{} {
+	future := &CljsCoreFuture{done: make(chan struct{})}
+	go future.run(Binding_conveyor_fn_(f))
+	return future
+})
+
+// Returns true if x is a future
+var Future_QMARK_ = Fn(func(x interface{}) bool {
+	_, ok := x.(*CljsCoreFuture)
+	return ok
+})
+
+// Returns true if future f is done
+var Future_done_QMARK_ = Fn(func(f interface{}) bool {
+	return f.(*CljsCoreFuture).isDone()
+})
+
+// Cancels the future, if possible. The goroutine running the future is
+// not interrupted, but its result will be discarded.
+var Future_cancel = Fn(func(f interface{}) bool {
+	return f.(*CljsCoreFuture).cancel()
+})
+
+// Returns true if future f is cancelled
+var Future_cancelled_QMARK_ = Fn(func(f interface{}) bool {
+	return f.(*CljsCoreFuture).isCancelled()
+})
+
+// Returns a promise object that can be read with deref/@, and set,
+// once only, with deliver. Calls to deref/@ prior to delivery will
+// block, unless the variant of deref with timeout is used. All
+// subsequent derefs will return the same delivered value without
+// blocking. See also - realized?.
+var Promise = Fn(func() interface{} {
+	return &CljsCorePromise{done: make(chan struct{})}
+})
+
+// Delivers the supplied value to the promise, releasing any pending
+// derefs. A subsequent call to deliver on a promise will have no effect.
+// Returns the promise if the value was delivered, else nil.
+var Deliver = Fn(func(promise interface{}, val interface{}) interface{} {
+	if promise := promise.(*CljsCorePromise); promise.deliver(val) {
+		return promise
+	}
+	return nil
+})
@@ -0,0 +1,603 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hraberg/cljs2go/js"
+)
+
+// Compiled namespaces register their vars from init() by calling Intern_ with a pointer to the Go
+// variable holding each var's root, so vars can be found by name at run time. Vars are reified
+// lazily as CljsCoreVar, whose Val is refreshed from the root whenever the registry hands one out.
+// Namespaces compiled in var indirection mode instead create their vars up front with Var_, and keep
+// the root in an atomic.Value read and written with Var_root_ and Set_var_root_, leaving Val unused.
+//
+// A namespace can be registered again by a new build of it loaded as a Go plugin. Its vars are then
+// replaced by ones for the Go variables of the new build, while code linked against the old build
+// keeps using the old ones. The vars of namespaces compiled in var indirection mode are instead
+// shared between builds, so all callers see the roots set by the latest one.
+
+type CljsCoreNamespace struct {
+	Name    interface{}
+	mutex   sync.RWMutex
+	vars    map[string]*nsVar
+	aliases map[string]*CljsCoreNamespace
+	refers  map[string]*nsVar
+}
+
+type nsVar struct {
+	mutex sync.Mutex
+	ns    *CljsCoreNamespace
+	name  string
+	root  reflect.Value
+	addr  uintptr
+	cell  *atomic.Value
+	meta  map[string]interface{}
+	v     *CljsCoreVar
+}
+
+var namespaces = struct {
+	sync.RWMutex
+	all map[string]*CljsCoreNamespace
+}{all: map[string]*CljsCoreNamespace{}}
+
+func createNs(name string) *CljsCoreNamespace {
+	namespaces.Lock()
+	defer namespaces.Unlock()
+	ns, ok := namespaces.all[name]
+	if !ok {
+		ns = &CljsCoreNamespace{Name: &CljsCoreSymbol{nil, name, name, nil, nil}, vars: map[string]*nsVar{},
+			aliases: map[string]*CljsCoreNamespace{}, refers: map[string]*nsVar{}}
+		namespaces.all[name] = ns
+	}
+	return ns
+}
+
+func findNs(name string) *CljsCoreNamespace {
+	namespaces.RLock()
+	defer namespaces.RUnlock()
+	return namespaces.all[name]
+}
+
+func (this *CljsCoreNamespace) name() string {
+	return this.Name.(*CljsCoreSymbol).Str.(string)
+}
+
+func (this *CljsCoreNamespace) lookup(name string) *nsVar {
+	this.mutex.RLock()
+	defer this.mutex.RUnlock()
+	return this.vars[name]
+}
+
+func (this *CljsCoreNamespace) lookupAlias(alias string) *CljsCoreNamespace {
+	this.mutex.RLock()
+	defer this.mutex.RUnlock()
+	return this.aliases[alias]
+}
+
+func (this *CljsCoreNamespace) lookupRefer(name string) *nsVar {
+	this.mutex.RLock()
+	defer this.mutex.RUnlock()
+	return this.refers[name]
+}
+
+func (this *CljsCoreNamespace) intern(name string, root reflect.Value, meta map[string]interface{}) *nsVar {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	v, ok := this.vars[name]
+	addr := root.Addr().Pointer()
+	switch {
+	case ok && v.cell == nil && v.addr == addr:
+		v.mutex.Lock()
+		v.meta = meta
+		if v.v != nil {
+			v.v.X_meta = v.metadata()
+		}
+		v.mutex.Unlock()
+	default:
+		v = &nsVar{ns: this, name: name, root: root, addr: addr, meta: meta}
+		this.vars[name] = v
+	}
+	return v
+}
+
+// Registers the metadata of a var created by Var_, putting it back if the name was taken since.
+func (this *CljsCoreNamespace) internIndirect(name string, cv *CljsCoreVar, meta map[string]interface{}) {
+	v := indirectVar(cv)
+	this.mutex.Lock()
+	this.vars[name] = v
+	this.mutex.Unlock()
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	v.meta = meta
+	cv.X_meta = v.metadata()
+}
+
+func (_ *CljsCoreNamespace) CljsCoreIPrintWithWriter__() {}
+func (this *CljsCoreNamespace) X_pr_writer_Arity3(writer interface{}, opts interface{}) interface{} {
+	return Decorate_(writer).(CljsCoreIWriter).X_write_Arity2("#<Namespace " + this.name() + ">")
+}
+
+func (this *nsVar) get() interface{} {
+	if this.cell != nil {
+		return this.cell.Load().(boxed).val
+	}
+	return this.root.Interface()
+}
+
+func (this *nsVar) set(val interface{}) {
+	if this.cell != nil {
+		this.cell.Store(boxed{val})
+		return
+	}
+	var v reflect.Value
+	switch {
+	case val == nil:
+		v = reflect.Zero(this.root.Type())
+	case reflect.TypeOf(val).AssignableTo(this.root.Type()):
+		v = reflect.ValueOf(val)
+	default:
+		panic(&js.TypeError{fmt.Sprintf("Can't set %s/%s of type %s to %T", this.ns.name(), this.name, this.root.Type(), val)})
+	}
+	this.root.Set(v)
+}
+
+func (this *nsVar) reify() *CljsCoreVar {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	if this.v == nil {
+		this.v = &CljsCoreVar{Sym: Symbol.X_invoke_Arity2(this.ns.name(), this.name), X_meta: this.metadata()}
+	}
+	if this.cell == nil {
+		this.v.Val = this.get()
+	}
+	return this.v
+}
+
+func (this *nsVar) metadata() interface{} {
+	m := Transient.X_invoke_Arity1(CljsCorePersistentArrayMap_EMPTY)
+	m = Assoc_BANG_.X_invoke_Arity3(m, Keyword.X_invoke_Arity1("ns"), this.ns)
+	m = Assoc_BANG_.X_invoke_Arity3(m, Keyword.X_invoke_Arity1("name"), Symbol.X_invoke_Arity1(this.name))
+	for k, v := range this.meta {
+		switch v := v.(type) {
+		case [][]string:
+			arglists := make([]interface{}, len(v))
+			for i, params := range v {
+				syms := make([]interface{}, len(params))
+				for j, p := range params {
+					syms[j] = Symbol.X_invoke_Arity1(p)
+				}
+				arglists[i] = Vec.X_invoke_Arity1(syms)
+			}
+			m = Assoc_BANG_.X_invoke_Arity3(m, Keyword.X_invoke_Arity1(k), List.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1(arglists)))
+		case int:
+			m = Assoc_BANG_.X_invoke_Arity3(m, Keyword.X_invoke_Arity1(k), float64(v))
+		default:
+			m = Assoc_BANG_.X_invoke_Arity3(m, Keyword.X_invoke_Arity1(k), v)
+		}
+	}
+	return Persistent_BANG_.X_invoke_Arity1(m)
+}
+
+func (this *nsVar) private() bool {
+	return Truth_(Get.X_invoke_Arity2(this.reify().X_meta, Keyword.X_invoke_Arity1("private")))
+}
+
+// Registers the Go variable pointed to by root as the var ns/name, with metadata like "doc",
+// "arglists" as [][]string, "dynamic" and "private". Called from the init() of compiled namespaces.
+// For namespaces compiled in var indirection mode root is the var returned by Var_.
+func Intern_(ns string, name string, root interface{}, meta map[string]interface{}) {
+	if cv, ok := root.(*CljsCoreVar); ok {
+		createNs(ns).internIndirect(name, cv, meta)
+		return
+	}
+	createNs(ns).intern(name, reflect.ValueOf(root).Elem(), meta)
+}
+
+// The vars created by Var_, by their CljsCoreVar.
+var indirectVars sync.Map
+
+// Returns the var ns/name for a namespace compiled in var indirection mode, where the Go variable of
+// each top-level def holds its CljsCoreVar, and callers read the root with Var_root_ on every use. This
+// way alter-var-root, with-redefs and loading a new build of the namespace all go through the var.
+// A new build gets the var of the old one. Called from the package variables of compiled namespaces.
+func Var_(ns string, name string) *CljsCoreVar {
+	n := createNs(ns)
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	if v, ok := n.vars[name]; ok && v.cell != nil {
+		return v.v
+	}
+	cv := &CljsCoreVar{Sym: Symbol.X_invoke_Arity2(ns, name)}
+	v := &nsVar{ns: n, name: name, cell: &atomic.Value{}, v: cv}
+	v.cell.Store(boxed{nil})
+	cv.X_meta = v.metadata()
+	n.vars[name] = v
+	indirectVars.Store(cv, v)
+	return cv
+}
+
+func indirectVar(v *CljsCoreVar) *nsVar {
+	if nv, ok := indirectVars.Load(v); ok {
+		return nv.(*nsVar)
+	}
+	panic(&js.Error{"Var is not interned: " + fmt.Sprint(Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{v})))})
+}
+
+// Returns the root of a var created by Var_.
+func Var_root_(v *CljsCoreVar) interface{} {
+	return indirectVar(v).get()
+}
+
+// Sets the root of a var created by Var_, like def and with-redefs do.
+func Set_var_root_(v *CljsCoreVar, val interface{}) {
+	nv := indirectVar(v)
+	nv.mutex.Lock()
+	defer nv.mutex.Unlock()
+	nv.set(val)
+}
+
+func theNs(x interface{}) *CljsCoreNamespace {
+	switch x := x.(type) {
+	case *CljsCoreNamespace:
+		return x
+	case *CljsCoreSymbol:
+		if ns := findNs(x.Str.(string)); ns != nil {
+			return ns
+		}
+	}
+	panic(&js.Error{"No namespace: " + fmt.Sprint(Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{x})))})
+}
+
+func registeredVar(v interface{}) *nsVar {
+	if v, ok := v.(*CljsCoreVar); ok {
+		if sym, ok := v.Sym.(*CljsCoreSymbol); ok && sym.Ns != nil {
+			if ns := findNs(sym.Ns.(string)); ns != nil {
+				return ns.lookup(sym.Name.(string))
+			}
+		}
+	}
+	return nil
+}
+
+func nsMap(ns interface{}, publicOnly bool) interface{} {
+	n := theNs(ns)
+	n.mutex.RLock()
+	vars := make([]*nsVar, 0, len(n.vars))
+	for _, v := range n.vars {
+		if !publicOnly || !v.private() {
+			vars = append(vars, v)
+		}
+	}
+	n.mutex.RUnlock()
+	m := Transient.X_invoke_Arity1(CljsCorePersistentHashMap_EMPTY)
+	for _, v := range vars {
+		m = Assoc_BANG_.X_invoke_Arity3(m, Symbol.X_invoke_Arity1(v.name), v.reify())
+	}
+	return Persistent_BANG_.X_invoke_Arity1(m)
+}
+
+// The current namespace, used by resolve and by tools evaluating code at run time.
+var X_STAR_ns_STAR_ interface{} = createNs("cljs.user")
+
+// Returns the namespace named by the symbol or nil if it doesn't exist.
+var Find_ns = Fn(func(sym interface{}) interface{} {
+	if ns := findNs(sym.(*CljsCoreSymbol).Str.(string)); ns != nil {
+		return ns
+	}
+	return nil
+})
+
+// Create a new namespace named by the symbol if one doesn't already
+// exist, returns it or the already-existing namespace of the same
+// name.
+var Create_ns = Fn(func(sym interface{}) interface{} {
+	return createNs(sym.(*CljsCoreSymbol).Str.(string))
+})
+
+// Returns a sequence of all namespaces.
+var All_ns = Fn(func() interface{} {
+	namespaces.RLock()
+	names := make([]string, 0, len(namespaces.all))
+	for name := range namespaces.all {
+		names = append(names, name)
+	}
+	namespaces.RUnlock()
+	sort.Strings(names)
+	all := make([]interface{}, len(names))
+	for i, name := range names {
+		all[i] = findNs(name)
+	}
+	return Array_seq.X_invoke_Arity1(all)
+})
+
+// Returns the name of the namespace, a symbol.
+var Ns_name = Fn(func(ns interface{}) interface{} {
+	return theNs(ns).Name
+})
+
+// Returns a map of the public intern mappings for the namespace.
+var Ns_publics = Fn(func(ns interface{}) interface{} {
+	return nsMap(ns, true)
+})
+
+// Returns a map of the intern mappings for the namespace.
+var Ns_interns = Fn(func(ns interface{}) interface{} {
+	return nsMap(ns, false)
+})
+
+// Returns the global var named by the namespace-qualified symbol, or
+// nil if no var with that name.
+var Find_var = Fn(func(sym interface{}) interface{} {
+	s := sym.(*CljsCoreSymbol)
+	if s.Ns == nil {
+		panic(&js.Error{"Symbol must be namespace-qualified"})
+	}
+	if ns := findNs(s.Ns.(string)); ns != nil {
+		if v := ns.lookup(s.Name.(string)); v != nil {
+			return v.reify()
+		}
+	}
+	return nil
+})
+
+// Returns the var to which a symbol will be resolved in the namespace,
+// else nil. The namespace of a qualified symbol can be an alias.
+// Unqualified symbols not interned in or referred into the namespace
+// are looked up in cljs.core.
+var Ns_resolve = Fn(func(ns interface{}, sym interface{}) interface{} {
+	n, s := theNs(ns), sym.(*CljsCoreSymbol)
+	if s.Ns != nil {
+		if target := n.lookupAlias(s.Ns.(string)); target != nil {
+			sym = Symbol.X_invoke_Arity2(target.name(), s.Name)
+		}
+		return Find_var.X_invoke_Arity1(sym)
+	}
+	name := s.Name.(string)
+	if strings.HasSuffix(name, ".") {
+		return nil
+	}
+	if v := n.lookup(name); v != nil {
+		return v.reify()
+	}
+	if v := n.lookupRefer(name); v != nil {
+		return v.reify()
+	}
+	if core := findNs("cljs.core"); core != nil {
+		if v := core.lookup(name); v != nil {
+			return v.reify()
+		}
+	}
+	return nil
+})
+
+// same as (ns-resolve *ns* symbol)
+var Resolve = Fn(func(sym interface{}) interface{} {
+	return Ns_resolve.X_invoke_Arity2(Dynamic_("cljs.core/*ns*", X_STAR_ns_STAR_), sym)
+})
+
+// Finds or creates a var named by the symbol name in the namespace
+// ns (which can be a symbol or a namespace), setting its root binding
+// to val if supplied. The namespace must exist.
+var Intern = Fn(func(ns interface{}, name interface{}) interface{} {
+	return internVar(ns, name).reify()
+}, func(ns interface{}, name interface{}, val interface{}) interface{} {
+	v := internVar(ns, name)
+	func() {
+		v.mutex.Lock()
+		defer v.mutex.Unlock()
+		v.set(val)
+	}()
+	return v.reify()
+})
+
+func internVar(ns interface{}, name interface{}) *nsVar {
+	n, s := theNs(ns), name.(*CljsCoreSymbol)
+	v := n.lookup(s.Name.(string))
+	if v == nil {
+		v = n.intern(s.Name.(string), reflect.ValueOf(new(interface{})).Elem(), nil)
+	}
+	if meta := s.X_meta; meta != nil {
+		cv := v.reify()
+		cv.X_meta = Merge.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{cv.X_meta, meta}))
+	}
+	return v
+}
+
+// Add an alias in the current namespace to another
+// namespace. Arguments are two symbols: the alias to be used, and
+// the symbolic name of the target namespace.
+var Alias = Fn(func(alias interface{}, namespace_sym interface{}) interface{} {
+	n, target := theNs(Dynamic_("cljs.core/*ns*", X_STAR_ns_STAR_)), theNs(namespace_sym)
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.aliases[alias.(*CljsCoreSymbol).Name.(string)] = target
+	return nil
+})
+
+// Returns a map of the aliases for the namespace.
+var Ns_aliases = Fn(func(ns interface{}) interface{} {
+	n := theNs(ns)
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	m := Transient.X_invoke_Arity1(CljsCorePersistentHashMap_EMPTY)
+	for alias, target := range n.aliases {
+		m = Assoc_BANG_.X_invoke_Arity3(m, Symbol.X_invoke_Arity1(alias), target)
+	}
+	return Persistent_BANG_.X_invoke_Arity1(m)
+})
+
+// refers to all public vars of ns, subject to filters.
+// filters can include at most one each of:
+//
+// :exclude list-of-symbols
+// :only list-of-symbols
+//
+// For each public interned var in the namespace named by the symbol,
+// adds a mapping from the name of the var to the var to the current
+// namespace.
+// @param {...*} var_args
+var Refer = Fn(1, func(ns_sym_filters__ ...interface{}) interface{} {
+	var ns_sym, filters = ns_sym_filters__[0], ns_sym_filters__[1]
+	n, source := theNs(Dynamic_("cljs.core/*ns*", X_STAR_ns_STAR_)), theNs(ns_sym)
+	opts := Apply.X_invoke_Arity2(Hash_map, filters)
+	only := Get.X_invoke_Arity2(opts, Keyword.X_invoke_Arity1("only"))
+	exclude := Set.X_invoke_Arity1(Get.X_invoke_Arity2(opts, Keyword.X_invoke_Arity1("exclude")))
+	publics := Ns_publics.X_invoke_Arity1(source)
+	if only == nil {
+		only = Keys.X_invoke_Arity1(publics)
+	}
+	for s := Seq.X_invoke_Arity1(only); s != nil; s = Next.X_invoke_Arity1(s) {
+		sym := First.X_invoke_Arity1(s)
+		if Truth_(Contains_QMARK_.X_invoke_Arity2(exclude, sym)) {
+			continue
+		}
+		v := registeredVar(Get.X_invoke_Arity2(publics, sym))
+		if v == nil {
+			panic(&js.Error{sym.(*CljsCoreSymbol).Str.(string) + " is not public"})
+		}
+		n.mutex.Lock()
+		n.refers[sym.(*CljsCoreSymbol).Name.(string)] = v
+		n.mutex.Unlock()
+	}
+	return nil
+})
+
+// Returns a map of the refer mappings for the namespace.
+var Ns_refers = Fn(func(ns interface{}) interface{} {
+	n := theNs(ns)
+	n.mutex.RLock()
+	vars := make([]*nsVar, 0, len(n.refers))
+	names := make([]string, 0, len(n.refers))
+	for name, v := range n.refers {
+		names, vars = append(names, name), append(vars, v)
+	}
+	n.mutex.RUnlock()
+	m := Transient.X_invoke_Arity1(CljsCorePersistentHashMap_EMPTY)
+	for i, v := range vars {
+		m = Assoc_BANG_.X_invoke_Arity3(m, Symbol.X_invoke_Arity1(names[i]), v.reify())
+	}
+	return Persistent_BANG_.X_invoke_Arity1(m)
+})
+
+// Gets the value in the var object
+var Var_get = Fn(func(x interface{}) interface{} {
+	if v := registeredVar(x); v != nil {
+		return v.get()
+	}
+	return x.(*CljsCoreVar).Val
+})
+
+// Atomically alters the root binding of var v by applying f to its
+// current value plus any args
+// @param {...*} var_args
+var Alter_var_root = Fn(2, func(v interface{}, f interface{}) interface{} {
+	return alterVarRoot(v, f, nil)
+}, func(v_f_args__ ...interface{}) interface{} {
+	var v, f, args = v_f_args__[0], v_f_args__[1], v_f_args__[2]
+	return alterVarRoot(v, f, args)
+})
+
+func alterVarRoot(v interface{}, f interface{}, args interface{}) interface{} {
+	nv := registeredVar(v)
+	if nv == nil {
+		panic(&js.Error{"Var is not interned: " + fmt.Sprint(Pr_str.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{v})))})
+	}
+	val := func() interface{} {
+		nv.mutex.Lock()
+		defer nv.mutex.Unlock()
+		val := Apply.X_invoke_Arity3(f, nv.get(), args)
+		nv.set(val)
+		return val
+	}()
+	nv.reify()
+	return val
+}
+
+func init() {
+	for name, v := range map[string]struct {
+		root     interface{}
+		arglists [][]string
+	}{
+		"atom":                          {&Atom, [][]string{{"x"}, {"x", "&", "p"}}},
+		"->Atom":                        {&X__GT_Atom, [][]string{{"state", "meta", "validator", "watches"}}},
+		"swap!":                         {&Swap_BANG_, [][]string{{"a", "f"}, {"a", "f", "x"}, {"a", "f", "x", "y"}, {"a", "f", "x", "y", "&", "more"}}},
+		"reset!":                        {&Reset_BANG_, [][]string{{"a", "new-value"}}},
+		"compare-and-set!":              {&Compare_and_set_BANG_, [][]string{{"a", "oldval", "newval"}}},
+		"get-validator":                 {&Get_validator, [][]string{{"iref"}}},
+		"set-validator!":                {&Set_validator_BANG_, [][]string{{"iref", "val"}}},
+		"swap-vals!":                    {&Swap_vals_BANG_, [][]string{{"a", "f"}, {"a", "f", "&", "args"}}},
+		"reset-vals!":                   {&Reset_vals_BANG_, [][]string{{"a", "new-value"}}},
+		"future-call":                   {&Future_call, [][]string{{"f"}}},
+		"future?":                       {&Future_QMARK_, [][]string{{"x"}}},
+		"future-done?":                  {&Future_done_QMARK_, [][]string{{"f"}}},
+		"future-cancel":                 {&Future_cancel, [][]string{{"f"}}},
+		"future-cancelled?":             {&Future_cancelled_QMARK_, [][]string{{"f"}}},
+		"promise":                       {&Promise, [][]string{{}}},
+		"deliver":                       {&Deliver, [][]string{{"promise", "val"}}},
+		"pmap":                          {&Pmap, [][]string{{"f", "coll"}, {"f", "coll", "&", "colls"}}},
+		"pcalls":                        {&Pcalls, [][]string{{"&", "fns"}}},
+		"push-thread-bindings":          {&Push_thread_bindings, [][]string{{"bindings"}}},
+		"pop-thread-bindings":           {&Pop_thread_bindings, [][]string{{}}},
+		"get-thread-bindings":           {&Get_thread_bindings, [][]string{{}}},
+		"with-bindings*":                {&With_bindings_STAR_, [][]string{{"binding-map", "f", "&", "args"}}},
+		"bound-fn*":                     {&Bound_fn_STAR_, [][]string{{"f"}}},
+		"thread-bound?":                 {&Thread_bound_QMARK_, [][]string{{"&", "vars"}}},
+		"agent":                         {&Agent, [][]string{{"state", "&", "options"}}},
+		"send":                          {&Send, [][]string{{"a", "f", "&", "args"}}},
+		"send-off":                      {&Send_off, [][]string{{"a", "f", "&", "args"}}},
+		"await":                         {&Await, [][]string{{"&", "agents"}}},
+		"await-for":                     {&Await_for, [][]string{{"timeout-ms", "&", "agents"}}},
+		"agent-error":                   {&Agent_error, [][]string{{"a"}}},
+		"restart-agent":                 {&Restart_agent, [][]string{{"a", "new-state", "&", "options"}}},
+		"set-error-handler!":            {&Set_error_handler_BANG_, [][]string{{"a", "handler-fn"}}},
+		"error-handler":                 {&Error_handler, [][]string{{"a"}}},
+		"set-error-mode!":               {&Set_error_mode_BANG_, [][]string{{"a", "mode-keyword"}}},
+		"error-mode":                    {&Error_mode, [][]string{{"a"}}},
+		"shutdown-agents":               {&Shutdown_agents, [][]string{{}}},
+		"ref":                           {&Ref, [][]string{{"x"}, {"x", "&", "options"}}},
+		"run-in-transaction":            {&Run_in_transaction, [][]string{{"f"}}},
+		"alter":                         {&Alter, [][]string{{"ref", "fun", "&", "args"}}},
+		"commute":                       {&Commute, [][]string{{"ref", "fun", "&", "args"}}},
+		"ref-set":                       {&Ref_set, [][]string{{"ref", "val"}}},
+		"ensure":                        {&Ensure, [][]string{{"ref"}}},
+		"ref-history-count":             {&Ref_history_count, [][]string{{"ref"}}},
+		"ref-min-history":               {&Ref_min_history, [][]string{{"ref"}, {"ref", "n"}}},
+		"ref-max-history":               {&Ref_max_history, [][]string{{"ref"}, {"ref", "n"}}},
+		"native-get-instance-field":     {&Native_get_instance_field, [][]string{{"target", "fieldName"}}},
+		"native-set-instance-field":     {&Native_set_instance_field, [][]string{{"target", "fieldName", "val"}}},
+		"native-invoke-func":            {&Native_invoke_func, [][]string{{"f", "args"}}},
+		"native-invoke-instance-method": {&Native_invoke_instance_method, [][]string{{"target", "methodName", "args"}}},
+		"go->clj":                       {&Go__GT_clj, [][]string{{"x", "&", "opts"}}},
+		"clj->go":                       {&Clj__GT_go, [][]string{{"x", "target"}}},
+		"error-is?":                     {&Error_is_QMARK_, [][]string{{"e", "target"}}},
+		"error-as":                      {&Error_as, [][]string{{"e", "t"}}},
+		"demunge":                       {&Demunge, [][]string{{"s"}}},
+		"find-ns":                       {&Find_ns, [][]string{{"sym"}}},
+		"create-ns":                     {&Create_ns, [][]string{{"sym"}}},
+		"all-ns":                        {&All_ns, [][]string{{}}},
+		"ns-name":                       {&Ns_name, [][]string{{"ns"}}},
+		"ns-publics":                    {&Ns_publics, [][]string{{"ns"}}},
+		"ns-interns":                    {&Ns_interns, [][]string{{"ns"}}},
+		"find-var":                      {&Find_var, [][]string{{"sym"}}},
+		"ns-resolve":                    {&Ns_resolve, [][]string{{"ns", "sym"}}},
+		"resolve":                       {&Resolve, [][]string{{"sym"}}},
+		"intern":                        {&Intern, [][]string{{"ns", "name"}, {"ns", "name", "val"}}},
+		"alias":                         {&Alias, [][]string{{"alias", "namespace-sym"}}},
+		"ns-aliases":                    {&Ns_aliases, [][]string{{"ns"}}},
+		"refer":                         {&Refer, [][]string{{"ns-sym", "&", "filters"}}},
+		"ns-refers":                     {&Ns_refers, [][]string{{"ns"}}},
+		"var-get":                       {&Var_get, [][]string{{"x"}}},
+		"alter-var-root":                {&Alter_var_root, [][]string{{"v", "f", "&", "args"}}},
+	} {
+		Intern_("cljs.core", name, v.root, map[string]interface{}{"arglists": v.arglists})
+	}
+	Intern_("cljs.core", "*agent*", &X_STAR_agent_STAR_, map[string]interface{}{"dynamic": true})
+	Intern_("cljs.core", "*go-error-tuples*", &X_STAR_go_error_tuples_STAR_, map[string]interface{}{"dynamic": true})
+	Intern_("cljs.core", "*ns*", &X_STAR_ns_STAR_, map[string]interface{}{"dynamic": true})
+}
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// Edited by hand since it was compiled, to follow the compiler and src/cljs/core/overrides.cljs changes. Regenerate it with lein compile-clojurescript.
 // cljs.core
 
 // Go overrides.
@@ -19,15 +20,17 @@ import (
 func init() {
 	X_STAR_clojurescript_version_STAR_ = "0.0-2411"
 
-	X_STAR_print_length_STAR_ = js.NaN
+	X_STAR_print_length_STAR_ = nil
 
-	X_STAR_print_level_STAR_ = js.NaN
+	X_STAR_print_level_STAR_ = nil
 
 	Set_print_fn_BANG_ = func(set_print_fn_BANG_ *AFn) *AFn {
 		return Fn(set_print_fn_BANG_, 1, func(f interface{}) interface{} {
 			return func() interface{} {
 				var return__8013 = f.(*AFn)
-				X_STAR_print_fn_STAR_ = return__8013
+				if !Set_dynamic_("cljs.core/*print-fn*", return__8013) {
+					X_STAR_print_fn_STAR_ = return__8013
+				}
 				return return__8013
 			}()
 		})
@@ -229,6 +232,18 @@ func init() {
 		})
 	}(&AFn{})
 
+	Deref = func(deref *AFn) *AFn {
+		return Fn(deref, 3, func(o interface{}) interface{} {
+			if Value_(o).Type().AssignableTo(reflect.TypeOf((**CljsCoreVar)(nil)).Elem()) {
+				return Var_get.X_invoke_Arity1(o)
+			} else {
+				return Decorate_(o).(CljsCoreIDeref).X_deref_Arity1()
+			}
+		}, func(o interface{}, msec interface{}, timeout_val interface{}) interface{} {
+			return Decorate_(o).(CljsCoreIDerefWithTimeout).X_deref_with_timeout_Arity3(msec, timeout_val)
+		})
+	}(&AFn{})
+
 	Quote_string = func(quote_string *AFn) *AFn {
 		return Fn(quote_string, 1, func(s interface{}) interface{} {
 			return strconv.Quote(s.(string))
@@ -306,18 +321,29 @@ func init() {
 														}
 													})
 												}(&AFn{})
-												_ = normalize
-												return Write_all.X_invoke_ArityVariadic(writer, Array_seq.X_invoke_Arity1([]interface{}{"#inst \"", strings.Join([]string{Str.X_invoke_Arity1(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCFullYear", []interface{}{})).(string)}, ``), "-", normalize.X_invoke_Arity2((Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCMonth", []interface{}{}).(float64) + float64(1)), float64(2)).(string), "-", normalize.X_invoke_Arity2(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCDate", []interface{}{}), float64(2)).(string), "T", normalize.X_invoke_Arity2(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCHours", []interface{}{}), float64(2)).(string), ":", normalize.X_invoke_Arity2(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCMinutes", []interface{}{}), float64(2)).(string), ":", normalize.X_invoke_Arity2(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCSeconds", []interface{}{}), float64(2)).(string), ".", normalize.X_invoke_Arity2(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCMilliseconds", []interface{}{}), float64(3)).(string), "-", "00:00\""}))
+												var nanos = Rem.X_invoke_Arity2(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetNanoseconds", []interface{}{}), float64(1000000))
+												_, _ = normalize, nanos
+												return Write_all.X_invoke_ArityVariadic(writer, Array_seq.X_invoke_Arity1([]interface{}{"#inst \"", strings.Join([]string{Str.X_invoke_Arity1(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCFullYear", []interface{}{})).(string)}, ``), "-", normalize.X_invoke_Arity2((Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCMonth", []interface{}{}).(float64) + float64(1)), float64(2)).(string), "-", normalize.X_invoke_Arity2(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCDate", []interface{}{}), float64(2)).(string), "T", normalize.X_invoke_Arity2(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCHours", []interface{}{}), float64(2)).(string), ":", normalize.X_invoke_Arity2(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCMinutes", []interface{}{}), float64(2)).(string), ":", normalize.X_invoke_Arity2(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCSeconds", []interface{}{}), float64(2)).(string), ".", normalize.X_invoke_Arity2(Native_invoke_instance_method.X_invoke_Arity3(obj, "GetUTCMilliseconds", []interface{}{}), float64(3)).(string), func() interface{} {
+													if nanos.(float64) == float64(0) {
+														return ""
+													} else {
+														return normalize.X_invoke_Arity2(nanos, float64(6))
+													}
+												}(), "-", "00:00\""}))
 											}
 										} else {
-											if Truth_(Regexp_QMARK_.X_invoke_Arity1(obj)) {
-												return Write_all.X_invoke_ArityVariadic(writer, Array_seq.X_invoke_Arity1([]interface{}{"#\"", Native_get_instance_field.X_invoke_Arity2(obj, "Pattern"), "\""}))
+											if Value_(obj).Type() == timeType {
+												return pr_writer.X_invoke_Arity3((&js.Date{obj}), writer, opts)
 											} else {
-												if DecoratedValue_(obj).Type().Implements(reflect.TypeOf((*CljsCoreIPrintWithWriter)(nil)).Elem()) {
-													return Decorate_(obj).(CljsCoreIPrintWithWriter).X_pr_writer_Arity3(writer, opts)
+												if Truth_(Regexp_QMARK_.X_invoke_Arity1(obj)) {
+													return Write_all.X_invoke_ArityVariadic(writer, Array_seq.X_invoke_Arity1([]interface{}{"#\"", Native_get_instance_field.X_invoke_Arity2(obj, "Pattern"), "\""}))
 												} else {
-													return Write_all.X_invoke_ArityVariadic(writer, Array_seq.X_invoke_Arity1([]interface{}{"#<", strings.Join([]string{Str.X_invoke_Arity1(obj).(string)}, ``), ">"}))
+													if DecoratedValue_(obj).Type().Implements(reflect.TypeOf((*CljsCoreIPrintWithWriter)(nil)).Elem()) {
+														return Decorate_(obj).(CljsCoreIPrintWithWriter).X_pr_writer_Arity3(writer, opts)
+													} else {
+														return Write_all.X_invoke_ArityVariadic(writer, Array_seq.X_invoke_Arity1([]interface{}{"#<", strings.Join([]string{Str.X_invoke_Arity1(obj).(string)}, ``), ">"}))
 
+													}
 												}
 											}
 										}
@@ -335,23 +361,20 @@ func init() {
 	Pr_sequential_writer = func(pr_sequential_writer *AFn) *AFn {
 		return Fn(pr_sequential_writer, 7, func(writer interface{}, print_one interface{}, begin interface{}, sep interface{}, end interface{}, opts interface{}, coll interface{}) interface{} {
 			{
-				var _STAR_print_level_STAR_8052 = X_STAR_print_level_STAR_
-				_ = _STAR_print_level_STAR_8052
+				Push_thread_bindings.X_invoke_Arity1((&CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.core/*print-level*", func() interface{} {
+					if Nil_(Dynamic_("cljs.core/*print-level*", X_STAR_print_level_STAR_)) {
+						return nil
+					} else {
+						return (Dynamic_("cljs.core/*print-level*", X_STAR_print_level_STAR_).(float64) - float64(1))
+					}
+				}()}, nil}))
 				return func() interface{} {
 					defer func() {
-						X_STAR_print_level_STAR_ = _STAR_print_level_STAR_8052
+						Pop_thread_bindings.X_invoke_Arity0()
 
 					}()
 					{
-						X_STAR_print_level_STAR_ = func() float64 {
-							if js.NaN == X_STAR_print_level_STAR_ {
-								return X_STAR_print_level_STAR_
-							} else {
-								return (X_STAR_print_level_STAR_ - float64(1))
-							}
-						}()
-
-						if X_STAR_print_level_STAR_ < float64(0) {
+						if (!(Nil_(Dynamic_("cljs.core/*print-level*", X_STAR_print_level_STAR_)))) && (Dynamic_("cljs.core/*print-level*", X_STAR_print_level_STAR_).(float64) < float64(0)) {
 							return Decorate_(writer).(CljsCoreIWriter).X_write_Arity2("#")
 						} else {
 							Decorate_(writer).(CljsCoreIWriter).X_write_Arity2(begin)
@@ -367,14 +390,20 @@ func init() {
 							}
 							{
 								var coll_8062___1 interface{} = Next.Arity1IQ(coll)
-								var n_8063 = ((&CljsCoreKeyword{Ns: nil, Name: "print-length", Fqn: "print-length", X_hash: float64(1931866356)}).X_invoke_Arity1(opts).(float64) - float64(1))
+								var n_8063 interface{} = func() interface{} {
+									if Nil_((&CljsCoreKeyword{Ns: nil, Name: "print-length", Fqn: "print-length", X_hash: float64(1931866356)}).X_invoke_Arity1(opts)) {
+										return nil
+									} else {
+										return ((&CljsCoreKeyword{Ns: nil, Name: "print-length", Fqn: "print-length", X_hash: float64(1931866356)}).X_invoke_Arity1(opts).(float64) - float64(1))
+									}
+								}()
 								_, _ = coll_8062___1, n_8063
 								for {
 									if Truth_(func() interface{} {
 										var and__159__auto__ = coll_8062___1
 										_ = and__159__auto__
 										if Truth_(and__159__auto__) {
-											return (Nil_(n_8063)) || (!(n_8063 == float64(0)))
+											return (Nil_(n_8063)) || (!(n_8063.(float64) == float64(0)))
 										} else {
 											return and__159__auto__
 										}
@@ -387,14 +416,20 @@ func init() {
 											_, _, _ = G__8056_8064, G__8057_8065, G__8058_8066
 											print_one.(CljsCoreIFn).X_invoke_Arity3(G__8056_8064, G__8057_8065, G__8058_8066)
 										}
-										coll_8062___1, n_8063 = Next.Arity1IQ(coll_8062___1), (n_8063 - float64(1))
+										coll_8062___1, n_8063 = Next.Arity1IQ(coll_8062___1), func() interface{} {
+											if Nil_(n_8063) {
+												return nil
+											} else {
+												return (n_8063.(float64) - float64(1))
+											}
+										}()
 										continue
 									} else {
 										if Truth_(func() interface{} {
 											var and__159__auto__ = Seq.Arity1IQ(coll_8062___1)
 											_ = and__159__auto__
 											if Truth_(and__159__auto__) {
-												return (n_8063 == float64(0))
+												return (!(Nil_(n_8063))) && (n_8063.(float64) == float64(0))
 											} else {
 												return and__159__auto__
 											}
@@ -510,7 +545,9 @@ func init() {
 
 	Enable_console_print_BANG_ = func(enable_console_print_BANG_ *AFn) *AFn {
 		return Fn(enable_console_print_BANG_, 0, func() interface{} {
-			X_STAR_print_newline_STAR_ = false
+			if !Set_dynamic_("cljs.core/*print-newline*", false) {
+				X_STAR_print_newline_STAR_ = false
+			}
 
 			return func() interface{} {
 				var return__8073 = func(fmt_println *AFn) *AFn {
@@ -519,7 +556,9 @@ func init() {
 						return nil
 					})
 				}(&AFn{})
-				X_STAR_print_fn_STAR_ = return__8073
+				if !Set_dynamic_("cljs.core/*print-fn*", return__8073) {
+					X_STAR_print_fn_STAR_ = return__8073
+				}
 				return return__8073
 			}()
 		})
@@ -617,13 +656,42 @@ func init() {
 		})
 	}(&AFn{})
 
+	Intern_("cljs.core", "*clojurescript-version*", &X_STAR_clojurescript_version_STAR_, map[string]interface{}{"dynamic": true})
+	Intern_("cljs.core", "*print-length*", &X_STAR_print_length_STAR_, map[string]interface{}{"dynamic": true})
+	Intern_("cljs.core", "*print-level*", &X_STAR_print_level_STAR_, map[string]interface{}{"dynamic": true})
+	Intern_("cljs.core", "=", &X_EQ_, map[string]interface{}{"doc": "Equality. Returns true if x equals y, false if not. Compares\nnumbers and collections in a type-independent manner.  Clojure's immutable data\nstructures define -equiv (and thus =) as a value, not an identity,\ncomparison.", "arglists": [][]string{{"x"}, {"x", "y"}, {"x", "y", "&", "more"}}})
+	Intern_("cljs.core", "add-to-string-hash-cache", &Add_to_string_hash_cache, map[string]interface{}{"arglists": [][]string{{"k"}}})
+	Intern_("cljs.core", "apply", &Apply, map[string]interface{}{"doc": "Applies fn f to the argument list formed by prepending intervening arguments to args.\nFirst cut.  Not lazy.  Needs to use emitted toApply.", "arglists": [][]string{{"f", "args"}, {"f", "x", "args"}, {"f", "x", "y", "args"}, {"f", "x", "y", "z", "args"}, {"f", "a", "b", "c", "d", "&", "args"}}})
+	Intern_("cljs.core", "array", &Array, map[string]interface{}{"doc": "Creates a new javascript array.", "arglists": [][]string{{"&", "items"}}})
+	Intern_("cljs.core", "char", &Char, map[string]interface{}{"doc": "Coerce to char", "arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "complement", &Complement, map[string]interface{}{"doc": "Takes a fn f and returns a fn that takes the same arguments as f,\nhas the same effects, if any, and returns the opposite truth value.", "arglists": [][]string{{"f"}}})
+	Intern_("cljs.core", "deref", &Deref, map[string]interface{}{"doc": "Also reader macro: @ref/@atom/@delay/@future/@promise. Within a\ntransaction, returns the in-transaction-value of ref, else returns the\nmost-recently-committed value of ref. When applied to an atom, returns\nits current state. When applied to a delay, forces it if not already\nforced. When applied to a future, will block if computation not\ncomplete. When applied to a promise, will block until a value is\ndelivered. The variant taking a timeout can be used for blocking\nreferences (futures and promises), and will return timeout-val if the\ntimeout (in milliseconds) is reached before a value is available.", "arglists": [][]string{{"o"}, {"o", "msec", "timeout-val"}}})
+	Intern_("cljs.core", "enable-console-print!", &Enable_console_print_BANG_, map[string]interface{}{"doc": "Set *print-fn* to console.log", "arglists": [][]string{{}}})
+	Intern_("cljs.core", "get", &Get, map[string]interface{}{"doc": "Returns the value mapped to key, not-found or nil if key not present.", "arglists": [][]string{{"o", "k"}, {"o", "k", "not-found"}}})
+	Intern_("cljs.core", "hash-string", &Hash_string, map[string]interface{}{"arglists": [][]string{{"k"}}})
+	Intern_("cljs.core", "identity", &Identity, map[string]interface{}{"arglists": [][]string{{"x"}, {"x", "&", "_"}}})
+	Intern_("cljs.core", "integer?", &Integer_QMARK_, map[string]interface{}{"doc": "Returns true if n is an integer.", "arglists": [][]string{{"n"}}})
+	Intern_("cljs.core", "make-array", &Make_array, map[string]interface{}{"arglists": [][]string{{"size"}, {"type", "size"}}})
+	Intern_("cljs.core", "native-satisfies?", &Native_satisfies_QMARK_, map[string]interface{}{"doc": "Internal - do not use!", "arglists": [][]string{{"p", "x"}}})
+	Intern_("cljs.core", "pr-sequential-writer", &Pr_sequential_writer, map[string]interface{}{"arglists": [][]string{{"writer", "print-one", "begin", "sep", "end", "opts", "coll"}}})
+	Intern_("cljs.core", "pr-writer", &Pr_writer, map[string]interface{}{"doc": "Prefer this to pr-seq, because it makes the printing function\nconfigurable, allowing efficient implementations such as appending\nto a StringBuffer.", "arglists": [][]string{{"obj", "writer", "opts"}}, "private": true})
+	Intern_("cljs.core", "quote-string", &Quote_string, map[string]interface{}{"arglists": [][]string{{"s"}}, "private": true})
+	Intern_("cljs.core", "rand", &Rand, map[string]interface{}{"doc": "Returns a random floating point number between 0 (inclusive) and\nn (default 1) (exclusive).", "arglists": [][]string{{}, {"n"}}})
+	Intern_("cljs.core", "remove", &Remove, map[string]interface{}{"doc": "Returns a lazy sequence of the items in coll for which\n(pred item) returns false. pred must be free of side-effects.\nReturns a transducer when no collection is provided.", "arglists": [][]string{{"pred"}, {"pred", "coll"}}})
+	Intern_("cljs.core", "set-print-fn!", &Set_print_fn_BANG_, map[string]interface{}{"doc": "Set *print-fn* to f.", "arglists": [][]string{{"f"}}})
+	Intern_("cljs.core", "sort", &Sort, map[string]interface{}{"doc": "Returns a sorted sequence of the items in coll. Comp can be\nboolean-valued comparison funcion, or a -/0/+ valued comparator.\nComp defaults to compare.", "arglists": [][]string{{"coll"}, {"comp", "coll"}}})
+	Intern_("cljs.core", "string-hash-cache", &String_hash_cache, map[string]interface{}{})
+	Intern_("cljs.core", "symbol?", &Symbol_QMARK_, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "test", &Test, map[string]interface{}{"doc": "test [v] finds fn at key :test in var metadata and calls it,\npresuming failure will throw exception", "arglists": [][]string{{"v"}}})
+	Intern_("cljs.core", "type", &Type_, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	Intern_("cljs.core", "type->str", &Type__GT_str, map[string]interface{}{"arglists": [][]string{{"ty"}}})
 }
 
 var X_STAR_clojurescript_version_STAR_ string
 
-var X_STAR_print_length_STAR_ float64
+var X_STAR_print_length_STAR_ interface{}
 
-var X_STAR_print_level_STAR_ float64
+var X_STAR_print_level_STAR_ interface{}
 
 // Set *print-fn* to f.
 var Set_print_fn_BANG_ *AFn
@@ -661,6 +729,17 @@ var Sort *AFn
 // Returns the value mapped to key, not-found or nil if key not present.
 var Get *AFn
 
+// Also reader macro: @ref/@atom/@delay/@future/@promise. Within a
+// transaction, returns the in-transaction-value of ref, else returns the
+// most-recently-committed value of ref. When applied to an atom, returns
+// its current state. When applied to a delay, forces it if not already
+// forced. When applied to a future, will block if computation not
+// complete. When applied to a promise, will block until a value is
+// delivered. The variant taking a timeout can be used for blocking
+// references (futures and promises), and will return timeout-val if the
+// timeout (in milliseconds) is reached before a value is available.
+var Deref *AFn
+
 var Quote_string *AFn
 
 // Prefer this to pr-seq, because it makes the printing function
@@ -0,0 +1,61 @@
+package core
+
+import "runtime"
+
+// Parallel versions of map and friends, backed by futures. At most a fixed number of futures
+// are realized ahead of the consumer, bounding the parallelism to the number of CPUs.
+
+func pmapLookahead() float64 {
+	return float64(runtime.NumCPU() + 2)
+}
+
+func pmapStep(vs interface{}, fs interface{}) *CljsCoreLazySeq {
+	return &CljsCoreLazySeq{nil, Fn(func() interface{} {
+		if s := Seq.X_invoke_Arity1(fs); s != nil {
+			return Cons.X_invoke_Arity2(Deref.X_invoke_Arity1(First.X_invoke_Arity1(vs)), pmapStep(Rest.X_invoke_Arity1(vs), Rest.X_invoke_Arity1(s)))
+		}
+		return Map_.X_invoke_Arity2(Deref, vs)
+	}), nil, nil}
+}
+
+// Returns the elements of coll as a lazy seq that isn't chunked, so mapping over it realizes them one
+// at a time.
+func unchunk(coll interface{}) *CljsCoreLazySeq {
+	return &CljsCoreLazySeq{nil, Fn(func() interface{} {
+		if s := Seq.X_invoke_Arity1(coll); s != nil {
+			return Cons.X_invoke_Arity2(First.X_invoke_Arity1(s), unchunk(Rest.X_invoke_Arity1(s)))
+		}
+		return nil
+	}), nil, nil}
+}
+
+// Like map, except f is applied in parallel. Semi-lazy in that the
+// parallel computation stays ahead of the consumption, but doesn't
+// realize the entire result unless required. Only useful for
+// computationally intensive functions where the time of f dominates
+// the coordination overhead.
+// @param {...*} var_args
+var Pmap = func(pmap *AFn) *AFn {
+	return Fn(pmap, 2, func(f interface{}, coll interface{}) interface{} {
+		rets := Map_.X_invoke_Arity2(Fn(func(x interface{}) interface{} {
+			return Future_call.X_invoke_Arity1(Fn(func() interface{} {
+				return f.(CljsCoreIFn).X_invoke_Arity1(x)
+			}))
+		}), unchunk(coll))
+		return pmapStep(rets, Drop.X_invoke_Arity2(pmapLookahead(), rets))
+	}, func(f_coll_colls__ ...interface{}) interface{} {
+		var f, coll, colls = f_coll_colls__[0], f_coll_colls__[1], f_coll_colls__[2]
+		return pmap.X_invoke_Arity2(Fn(func(args interface{}) interface{} {
+			return Apply.X_invoke_Arity2(f, args)
+		}), Apply.X_invoke_Arity4(Map_, Vector, coll, colls))
+	})
+}(&AFn{})
+
+// Executes the no-arg fns in parallel, returning a lazy sequence of
+// their values
+// @param {...*} var_args
+var Pcalls = Fn(0, func(fns__ ...interface{}) interface{} {
+	return Pmap.X_invoke_Arity2(Fn(func(f interface{}) interface{} {
+		return f.(CljsCoreIFn).X_invoke_Arity0()
+	}), fns__[0])
+})
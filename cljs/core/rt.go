@@ -5,6 +5,7 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"runtime/debug"
 
 	"github.com/hraberg/cljs2go/js"
 )
@@ -55,12 +56,16 @@ var Native_get_instance_field = Fn(func(target, fieldName interface{}) interface
 })
 
 var Native_set_instance_field = Fn(func(target, fieldName, val interface{}) interface{} {
-	element(Decorate_(target)).FieldByName(fieldName.(string)).Set(Value_(val))
+	field := element(Decorate_(target)).FieldByName(fieldName.(string))
+	if v := Value_(val); v.Type().AssignableTo(field.Type()) {
+		field.Set(v)
+	} else {
+		(&cljToGo{[]interface{}{fieldName}}).convert(val, field)
+	}
 	return val
 })
 
-var Native_invoke_func = Fn(func(f, args interface{}) interface{} {
-	fv := Value_(f)
+func invokeNative(fv reflect.Value, args interface{}, recoverPanics bool) interface{} {
 	argsArray := args.([]interface{}) // this should really take a seq
 	argc := fv.Type().NumIn()
 	if len(argsArray) > argc {
@@ -73,11 +78,19 @@ var Native_invoke_func = Fn(func(f, args interface{}) interface{} {
 	for i := len(argsArray); i < len(in); i++ {
 		in[i] = Value_(nil)
 	}
-	return fv.Call(in)[0].Interface()
+	if recoverPanics {
+		return goReturn(fv.Type(), callGo(fv, in))
+	}
+	return goReturn(fv.Type(), fv.Call(in))
+}
+
+var Native_invoke_func = Fn(func(f, args interface{}) interface{} {
+	return invokeNative(Value_(f), args, true)
 })
 
 var Native_invoke_instance_method = Fn(func(target, methodName, args interface{}) interface{} {
-	return Native_invoke_func.X_invoke_Arity2(reflect.ValueOf(Decorate_(target)).MethodByName(methodName.(string)), args)
+	target = Decorate_(target)
+	return invokeNative(reflect.ValueOf(target).MethodByName(methodName.(string)), args, !isOpaque(reflect.TypeOf(target)))
 })
 
 type ArityVariadic func(...interface{}) interface{}
@@ -734,10 +747,6 @@ func (this *CljsCoreSubvec) X_contains_key_QMARK__Arity2(k interface{}) bool {
 	panic("Not implemented.")
 }
 
-func (e *CljsCoreExceptionInfo) Error() string {
-	return fmt.Sprint(e.Message)
-}
-
 // var resolves to their symbols, hack for setMacro.
 // This will register them so cljs.analyzer/get-expander can find them for cljs.analyzer/macroexpand-1.
 func (e *CljsCoreSymbol) SetMacro() {
@@ -761,6 +770,16 @@ func init() {
 }
 
 func Main_() {
+	defer func() {
+		if x := recover(); x != nil {
+			stack := demungeStack(string(debug.Stack()))
+			if p, ok := panicError(x); ok {
+				stack = p.Stack
+			}
+			fmt.Fprintf(os.Stderr, "panic: %+v\n\n%s", x, stack)
+			os.Exit(2)
+		}
+	}()
 	Enable_console_print_BANG_.X_invoke_Arity0()
 	args := make([]interface{}, len(os.Args[1:]))
 	for i, a := range os.Args[1:] {
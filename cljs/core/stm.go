@@ -0,0 +1,495 @@
+package core
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hraberg/cljs2go/goog"
+	"github.com/hraberg/cljs2go/js"
+)
+
+// Refs are coordinated by a multi-version concurrency control STM, loosely following Clojure's LockingTransaction.
+// Each transaction reads a snapshot as of its read point and takes ownership of the refs it writes or ensures,
+// a conflicting write makes the transaction retry. Commits are serialized and stamp new versions with the commit point.
+
+const retryLimit = 10000
+
+var stmClock int64
+
+var commitMutex sync.Mutex
+
+var transactions = struct {
+	sync.RWMutex
+	running map[int64]*transaction
+}{running: map[int64]*transaction{}}
+
+var runningTransactions int64
+
+type retryError struct{}
+
+const (
+	txRunning int32 = iota
+	txCommitting
+	txRetry
+	txKilled
+	txCommitted
+)
+
+type commute struct {
+	f    interface{}
+	args interface{}
+}
+
+type transaction struct {
+	readPoint int64
+	status    int32
+	vals      map[*CljsCoreRef]interface{}
+	sets      map[*CljsCoreRef]bool
+	ensures   map[*CljsCoreRef]bool
+	commutes  map[*CljsCoreRef][]commute
+	actions   []*agentAction
+}
+
+func currentTransaction() *transaction {
+	if atomic.LoadInt64(&runningTransactions) == 0 {
+		return nil
+	}
+	transactions.RLock()
+	defer transactions.RUnlock()
+	if tx := transactions.running[goroutineId()]; tx != nil && tx.isRunning() {
+		return tx
+	}
+	return nil
+}
+
+func (this *transaction) isRunning() bool {
+	status := atomic.LoadInt32(&this.status)
+	return status == txRunning || status == txCommitting
+}
+
+func (this *transaction) retry() {
+	atomic.StoreInt32(&this.status, txRetry)
+	panic(retryError{})
+}
+
+func (this *transaction) release() {
+	for ref := range this.sets {
+		ref.release(this)
+	}
+	for ref := range this.ensures {
+		ref.release(this)
+	}
+	for ref := range this.commutes {
+		ref.release(this)
+	}
+}
+
+func (this *transaction) read(ref *CljsCoreRef) interface{} {
+	if val, ok := this.vals[ref]; ok {
+		return val
+	}
+	if val, ok := ref.valAt(this.readPoint); ok {
+		return val
+	}
+	atomic.AddInt32(&ref.faults, 1)
+	this.retry()
+	return nil
+}
+
+func (this *transaction) claim(ref *CljsCoreRef) {
+	if !ref.claim(this, true) {
+		this.retry()
+	}
+}
+
+func (this *transaction) set(ref *CljsCoreRef, val interface{}) interface{} {
+	if _, ok := this.commutes[ref]; ok {
+		panic(&js.Error{"Can't set after commute"})
+	}
+	if !this.sets[ref] {
+		this.claim(ref)
+		this.sets[ref] = true
+	}
+	this.vals[ref] = val
+	return val
+}
+
+func (this *transaction) ensure(ref *CljsCoreRef) interface{} {
+	if !this.sets[ref] && !this.ensures[ref] {
+		this.claim(ref)
+		this.ensures[ref] = true
+	}
+	return this.read(ref)
+}
+
+func (this *transaction) commute(ref *CljsCoreRef, f interface{}, args interface{}) interface{} {
+	if _, ok := this.vals[ref]; !ok {
+		this.vals[ref] = this.read(ref)
+	}
+	this.commutes[ref] = append(this.commutes[ref], commute{f, args})
+	val := Apply.X_invoke_Arity3(f, this.vals[ref], args)
+	this.vals[ref] = val
+	return val
+}
+
+type notify struct {
+	ref            *CljsCoreRef
+	oldval, newval interface{}
+}
+
+func (this *transaction) commit() {
+	for _, n := range this.stamp() {
+		n.ref.notifyWatches(n.ref, n.oldval, n.newval)
+	}
+	for _, action := range this.actions {
+		action.agent.dispatch(action)
+	}
+}
+
+func (this *transaction) stamp() (notifies []notify) {
+	commitMutex.Lock()
+	defer commitMutex.Unlock()
+	atomic.StoreInt32(&this.status, txCommitting)
+	for ref, commutes := range this.commutes {
+		if this.sets[ref] {
+			continue
+		}
+		if !this.ensures[ref] && !ref.claim(this, false) {
+			this.retry()
+		}
+		val := ref.latest()
+		for _, c := range commutes {
+			val = Apply.X_invoke_Arity3(c.f, val, c.args)
+		}
+		this.vals[ref] = val
+	}
+	changed := this.changed()
+	for ref := range changed {
+		ref.validate(ref.validator(), this.vals[ref])
+	}
+	commitPoint := atomic.AddInt64(&stmClock, 1)
+	for ref := range changed {
+		notifies = append(notifies, notify{ref, ref.latest(), this.vals[ref]})
+		ref.commit(this.vals[ref], commitPoint)
+	}
+	atomic.StoreInt32(&this.status, txCommitted)
+	this.release()
+	return notifies
+}
+
+func (this *transaction) changed() map[*CljsCoreRef]bool {
+	changed := map[*CljsCoreRef]bool{}
+	for ref := range this.sets {
+		changed[ref] = true
+	}
+	for ref := range this.commutes {
+		changed[ref] = true
+	}
+	return changed
+}
+
+func (this *transaction) run(f interface{}) (val interface{}, done bool) {
+	defer func() {
+		if err := recover(); err != nil {
+			if _, ok := err.(retryError); !ok {
+				atomic.StoreInt32(&this.status, txKilled)
+				this.release()
+				panic(err)
+			}
+			this.release()
+		}
+	}()
+	val = f.(CljsCoreIFn).X_invoke_Arity0()
+	this.commit()
+	return val, true
+}
+
+func runInTransaction(f interface{}) interface{} {
+	if tx := currentTransaction(); tx != nil {
+		return f.(CljsCoreIFn).X_invoke_Arity0()
+	}
+	id := goroutineId()
+	atomic.AddInt64(&runningTransactions, 1)
+	defer func() {
+		transactions.Lock()
+		delete(transactions.running, id)
+		transactions.Unlock()
+		atomic.AddInt64(&runningTransactions, -1)
+	}()
+	for i := 0; i < retryLimit; i++ {
+		tx := &transaction{readPoint: atomic.LoadInt64(&stmClock), vals: map[*CljsCoreRef]interface{}{},
+			sets: map[*CljsCoreRef]bool{}, ensures: map[*CljsCoreRef]bool{}, commutes: map[*CljsCoreRef][]commute{}}
+		transactions.Lock()
+		transactions.running[id] = tx
+		transactions.Unlock()
+		if val, done := tx.run(f); done {
+			return val
+		}
+		time.Sleep(time.Duration(rand.Intn(100*(i+1))) * time.Microsecond)
+	}
+	panic(&js.Error{"Transaction failed after reaching retry limit"})
+}
+
+func runningTransaction() *transaction {
+	tx := currentTransaction()
+	if tx == nil {
+		panic(&js.Error{"No transaction running"})
+	}
+	return tx
+}
+
+type refVal struct {
+	val   interface{}
+	point int64
+	prior *refVal
+}
+
+type CljsCoreRef struct {
+	tvals      *refVal
+	owner      *transaction
+	faults     int32
+	MinHistory float64
+	MaxHistory float64
+	mutex      sync.RWMutex
+	iref
+}
+
+func (this *CljsCoreRef) valAt(point int64) (interface{}, bool) {
+	this.mutex.RLock()
+	defer this.mutex.RUnlock()
+	for v := this.tvals; v != nil; v = v.prior {
+		if v.point <= point {
+			return v.val, true
+		}
+	}
+	return nil, false
+}
+
+func (this *CljsCoreRef) claim(tx *transaction, current bool) bool {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	if current && this.tvals.point > tx.readPoint {
+		return false
+	}
+	if this.owner != nil && this.owner != tx && this.owner.isRunning() {
+		return false
+	}
+	this.owner = tx
+	return true
+}
+
+func (this *CljsCoreRef) release(tx *transaction) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	if this.owner == tx {
+		this.owner = nil
+	}
+}
+
+func (this *CljsCoreRef) latest() interface{} {
+	this.mutex.RLock()
+	defer this.mutex.RUnlock()
+	return this.tvals.val
+}
+
+func (this *CljsCoreRef) historyCount() int {
+	count := 0
+	for v := this.tvals; v != nil; v = v.prior {
+		count++
+	}
+	return count - 1
+}
+
+func (this *CljsCoreRef) commit(val interface{}, point int64) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	count := this.historyCount()
+	this.tvals = &refVal{val, point, this.tvals}
+	if (atomic.LoadInt32(&this.faults) > 0 && float64(count) < this.MaxHistory) || float64(count) < this.MinHistory {
+		atomic.StoreInt32(&this.faults, 0)
+		return
+	}
+	keep := this.tvals
+	for i := 0; i < count; i++ {
+		keep = keep.prior
+	}
+	keep.prior = nil
+}
+
+func (this *CljsCoreRef) setHistory(min, max interface{}) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	if min != nil {
+		this.MinHistory = min.(float64)
+	}
+	if max != nil {
+		this.MaxHistory = max.(float64)
+	}
+}
+
+func (_ *CljsCoreRef) CljsCoreIHash__() {}
+func (this *CljsCoreRef) X_hash_Arity1() interface{} {
+	return Native_invoke_func.X_invoke_Arity2(goog.GetUid, []interface{}{this})
+}
+
+func (_ *CljsCoreRef) CljsCoreIWatchable__() {}
+func (this *CljsCoreRef) X_notify_watches_Arity3(oldval interface{}, newval interface{}) interface{} {
+	this.notifyWatches(this, oldval, newval)
+	return nil
+}
+
+func (this *CljsCoreRef) X_add_watch_Arity3(key interface{}, f interface{}) interface{} {
+	this.addWatch(key, f)
+	return this
+}
+
+func (this *CljsCoreRef) X_remove_watch_Arity2(key interface{}) interface{} {
+	return this.removeWatch(key)
+}
+
+func (_ *CljsCoreRef) CljsCoreIMeta__() {}
+func (this *CljsCoreRef) X_meta_Arity1() interface{} {
+	return this.Meta
+}
+
+func (_ *CljsCoreRef) CljsCoreIDeref__() {}
+func (this *CljsCoreRef) X_deref_Arity1() interface{} {
+	if tx := currentTransaction(); tx != nil {
+		return tx.read(this)
+	}
+	return this.latest()
+}
+
+func (_ *CljsCoreRef) CljsCoreIPrintWithWriter__() {}
+func (this *CljsCoreRef) X_pr_writer_Arity3(writer interface{}, opts interface{}) interface{} {
+	Decorate_(writer).(CljsCoreIWriter).X_write_Arity2("#<Ref: ")
+	Pr_writer.X_invoke_Arity3(this.X_deref_Arity1(), writer, opts)
+	return Decorate_(writer).(CljsCoreIWriter).X_write_Arity2(">")
+}
+
+var minHistoryKeyword = &CljsCoreKeyword{Ns: nil, Name: "min-history", Fqn: "min-history", X_hash: float64(-347495913)}
+var maxHistoryKeyword = &CljsCoreKeyword{Ns: nil, Name: "max-history", Fqn: "max-history", X_hash: float64(1298651731)}
+
+// Creates and returns a Ref with an initial value of x and zero or
+// more options (in any order):
+//
+// :meta metadata-map
+//
+// :validator validate-fn
+//
+// :min-history (default 0)
+// :max-history (default 10)
+//
+// If metadata-map is supplied, it will become the metadata on the
+// ref. validate-fn must be nil or a side-effect-free fn of one
+// argument, which will be passed the intended new state on any state
+// change. If the new state is unacceptable, the validate-fn should
+// return false or throw an Error. validate-fn will be called on
+// transaction commit, when all refs have their final values.
+//
+// Normally refs accumulate history dynamically as needed to deal with
+// read demands. If you know in advance you will need history you can
+// set :min-history to ensure it will be available when first needed
+// (instead of after a read fault). History is limited, and the limit
+// can be set with :max-history.
+// @param {...*} var_args
+var Ref = Fn(1, func(x interface{}) interface{} {
+	// The first version is stamped at point 0, before any read point, so a transaction can alter a
+	// ref it created whatever has been committed since it started.
+	return &CljsCoreRef{tvals: &refVal{x, 0, nil}, MaxHistory: 10}
+}, func(x_options__ ...interface{}) interface{} {
+	var x = x_options__[0]
+	var opts = Apply.X_invoke_Arity2(Hash_map, x_options__[1])
+	var r = &CljsCoreRef{tvals: &refVal{x, 0, nil},
+		MinHistory: Get.X_invoke_Arity3(opts, minHistoryKeyword, float64(0)).(float64),
+		MaxHistory: Get.X_invoke_Arity3(opts, maxHistoryKeyword, float64(10)).(float64),
+		iref:       iref{Meta: Get.X_invoke_Arity2(opts, metaKeyword), Validator: Get.X_invoke_Arity2(opts, validatorKeyword)}}
+	r.validate(r.Validator, x)
+	return r
+})
+
+// Runs f in a transaction, retrying it on conflicts. If a transaction is
+// already running on this goroutine, f runs as part of it. This is the
+// runtime for the dosync and sync macros.
+var Run_in_transaction = Fn(func(f interface{}) interface{} {
+	return runInTransaction(f)
+})
+
+// Must be called in a transaction. Sets the in-transaction-value of
+// ref to:
+//
+// (apply fun in-transaction-value-of-ref args)
+//
+// and returns the in-transaction-value of ref.
+// @param {...*} var_args
+var Alter = Fn(2, func(ref interface{}, fun interface{}) interface{} {
+	tx := runningTransaction()
+	return tx.set(ref.(*CljsCoreRef), fun.(CljsCoreIFn).X_invoke_Arity1(tx.read(ref.(*CljsCoreRef))))
+}, func(ref_fun_args__ ...interface{}) interface{} {
+	var ref, fun, args = ref_fun_args__[0].(*CljsCoreRef), ref_fun_args__[1], ref_fun_args__[2]
+	tx := runningTransaction()
+	return tx.set(ref, Apply.X_invoke_Arity3(fun, tx.read(ref), args))
+})
+
+// Must be called in a transaction. Sets the in-transaction-value of
+// ref to:
+//
+// (apply fun in-transaction-value-of-ref args)
+//
+// and returns the in-transaction-value of ref.
+//
+// At the commit point of the transaction, sets the value of ref to be:
+//
+// (apply fun most-recently-committed-value-of-ref args)
+//
+// Thus fun should be commutative, or, failing that, you must accept
+// last-one-in-wins behavior.  commute allows for more concurrency than
+// ref-set.
+// @param {...*} var_args
+var Commute = Fn(2, func(ref interface{}, fun interface{}) interface{} {
+	return runningTransaction().commute(ref.(*CljsCoreRef), fun, nil)
+}, func(ref_fun_args__ ...interface{}) interface{} {
+	return runningTransaction().commute(ref_fun_args__[0].(*CljsCoreRef), ref_fun_args__[1], ref_fun_args__[2])
+})
+
+// Must be called in a transaction. Sets the value of ref.
+// Returns val.
+var Ref_set = Fn(func(ref interface{}, val interface{}) interface{} {
+	return runningTransaction().set(ref.(*CljsCoreRef), val)
+})
+
+// Must be called in a transaction. Protects the ref from modification
+// by other transactions.  Returns the in-transaction-value of
+// ref. Allows for more concurrency than (ref-set ref @ref)
+var Ensure = Fn(func(ref interface{}) interface{} {
+	return runningTransaction().ensure(ref.(*CljsCoreRef))
+})
+
+// Returns the history count of a ref
+var Ref_history_count = Fn(func(ref interface{}) interface{} {
+	ref.(*CljsCoreRef).mutex.RLock()
+	defer ref.(*CljsCoreRef).mutex.RUnlock()
+	return float64(ref.(*CljsCoreRef).historyCount())
+})
+
+// Gets the min-history of a ref, or sets it and returns the ref
+var Ref_min_history = Fn(func(ref interface{}) interface{} {
+	ref.(*CljsCoreRef).mutex.RLock()
+	defer ref.(*CljsCoreRef).mutex.RUnlock()
+	return ref.(*CljsCoreRef).MinHistory
+}, func(ref interface{}, n interface{}) interface{} {
+	ref.(*CljsCoreRef).setHistory(n, nil)
+	return ref
+})
+
+// Gets the max-history of a ref, or sets it and returns the ref
+var Ref_max_history = Fn(func(ref interface{}) interface{} {
+	ref.(*CljsCoreRef).mutex.RLock()
+	defer ref.(*CljsCoreRef).mutex.RUnlock()
+	return ref.(*CljsCoreRef).MaxHistory
+}, func(ref interface{}, n interface{}) interface{} {
+	ref.(*CljsCoreRef).setHistory(nil, n)
+	return ref
+})
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// Edited by hand since it was compiled, to follow the compiler changes for bindings. Regenerate it with lein compile-clojurescript-tests.
 // cljs.core-test
 
 package core_test
@@ -1055,20 +1056,17 @@ func init() {
 				var sb__1140__auto__ = (&goog_string.StringBuffer{})
 				_ = sb__1140__auto__
 				{
-					var _STAR_print_fn_STAR_537_964 = cljs_core.X_STAR_print_fn_STAR_
-					_ = _STAR_print_fn_STAR_537_964
+					cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.core/*print-fn*", func(G__965 *cljs_core.AFn, sb__1140__auto__ *goog_string.StringBuffer) *cljs_core.AFn {
+						return cljs_core.Fn(G__965, 1, func(x__1141__auto__ interface{}) interface{} {
+							return sb__1140__auto__.Append(x__1141__auto__)
+						})
+					}(&cljs_core.AFn{}, sb__1140__auto__)}, nil}))
 					func() {
 						defer func() {
-							cljs_core.X_STAR_print_fn_STAR_ = _STAR_print_fn_STAR_537_964
+							cljs_core.Pop_thread_bindings.X_invoke_Arity0()
 
 						}()
 						{
-							cljs_core.X_STAR_print_fn_STAR_ = func(G__965 *cljs_core.AFn, _STAR_print_fn_STAR_537_964 interface{}, sb__1140__auto__ *goog_string.StringBuffer) *cljs_core.AFn {
-								return cljs_core.Fn(G__965, 1, func(x__1141__auto__ interface{}) interface{} {
-									return sb__1140__auto__.Append(x__1141__auto__)
-								})
-							}(&cljs_core.AFn{}, _STAR_print_fn_STAR_537_964, sb__1140__auto__)
-
 							cljs_core.Print.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{float64(1)}))
 							cljs_core.Print.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{float64(2)}))
 						}
@@ -1083,22 +1081,19 @@ func init() {
 				var sb__1140__auto__ = (&goog_string.StringBuffer{})
 				_ = sb__1140__auto__
 				{
-					var _STAR_print_fn_STAR_538_966 = cljs_core.X_STAR_print_fn_STAR_
-					_ = _STAR_print_fn_STAR_538_966
+					cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.core/*print-fn*", func(G__967 *cljs_core.AFn, sb__1140__auto__ *goog_string.StringBuffer) *cljs_core.AFn {
+						return cljs_core.Fn(G__967, 1, func(x__1141__auto__ interface{}) interface{} {
+							return sb__1140__auto__.Append(x__1141__auto__)
+						})
+					}(&cljs_core.AFn{}, sb__1140__auto__)}, nil}))
 					func() {
 						defer func() {
-							cljs_core.X_STAR_print_fn_STAR_ = _STAR_print_fn_STAR_538_966
+							cljs_core.Pop_thread_bindings.X_invoke_Arity0()
 
 						}()
 						{
-							cljs_core.X_STAR_print_fn_STAR_ = func(G__967 *cljs_core.AFn, _STAR_print_fn_STAR_538_966 interface{}, sb__1140__auto__ *goog_string.StringBuffer) *cljs_core.AFn {
-								return cljs_core.Fn(G__967, 1, func(x__1141__auto__ interface{}) interface{} {
-									return sb__1140__auto__.Append(x__1141__auto__)
-								})
-							}(&cljs_core.AFn{}, _STAR_print_fn_STAR_538_966, sb__1140__auto__)
-
-							cljs_core.X_STAR_print_fn_STAR_.X_invoke_Arity1(float64(1))
-							cljs_core.X_STAR_print_fn_STAR_.X_invoke_Arity1(float64(2))
+							cljs_core.Dynamic_or_zero_("cljs.core/*print-fn*", cljs_core.X_STAR_print_fn_STAR_).(*cljs_core.AFn).X_invoke_Arity1(float64(1))
+							cljs_core.Dynamic_or_zero_("cljs.core/*print-fn*", cljs_core.X_STAR_print_fn_STAR_).(*cljs_core.AFn).X_invoke_Arity1(float64(2))
 						}
 					}()
 				}
@@ -7779,20 +7774,17 @@ func init() {
 					var sb__1140__auto__ = (&goog_string.StringBuffer{})
 					_ = sb__1140__auto__
 					{
-						var _STAR_print_fn_STAR_849_1396 = cljs_core.X_STAR_print_fn_STAR_
-						_ = _STAR_print_fn_STAR_849_1396
+						cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.core/*print-fn*", func(G__1397 *cljs_core.AFn, sb__1140__auto__ *goog_string.StringBuffer) *cljs_core.AFn {
+							return cljs_core.Fn(G__1397, 1, func(x__1141__auto__ interface{}) interface{} {
+								return sb__1140__auto__.Append(x__1141__auto__)
+							})
+						}(&cljs_core.AFn{}, sb__1140__auto__)}, nil}))
 						func() {
 							defer func() {
-								cljs_core.X_STAR_print_fn_STAR_ = _STAR_print_fn_STAR_849_1396
+								cljs_core.Pop_thread_bindings.X_invoke_Arity0()
 
 							}()
 							{
-								cljs_core.X_STAR_print_fn_STAR_ = func(G__1397 *cljs_core.AFn, _STAR_print_fn_STAR_849_1396 interface{}, sb__1140__auto__ *goog_string.StringBuffer) *cljs_core.AFn {
-									return cljs_core.Fn(G__1397, 1, func(x__1141__auto__ interface{}) interface{} {
-										return sb__1140__auto__.Append(x__1141__auto__)
-									})
-								}(&cljs_core.AFn{}, _STAR_print_fn_STAR_849_1396, sb__1140__auto__)
-
 								cljs_core.Value_(f_BANG_.X_invoke_Arity1((&cljs_core.CljsCoreSymbol{Ns: nil, Name: "foo", Str: "foo", X_hash: float64(-1385541733), X_meta: nil}))).Type().AssignableTo(reflect.TypeOf((**cljs_core.CljsCoreSymbol)(nil)).Elem())
 								func(x, y float64) float64 {
 									if x > y {
@@ -8360,20 +8352,17 @@ func init() {
 				var sb__1140__auto__ = (&goog_string.StringBuffer{})
 				_ = sb__1140__auto__
 				{
-					var _STAR_print_fn_STAR_880_1449 = cljs_core.X_STAR_print_fn_STAR_
-					_ = _STAR_print_fn_STAR_880_1449
+					cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.core/*print-fn*", func(G__1450 *cljs_core.AFn, sb__1140__auto__ *goog_string.StringBuffer) *cljs_core.AFn {
+						return cljs_core.Fn(G__1450, 1, func(x__1141__auto__ interface{}) interface{} {
+							return sb__1140__auto__.Append(x__1141__auto__)
+						})
+					}(&cljs_core.AFn{}, sb__1140__auto__)}, nil}))
 					func() {
 						defer func() {
-							cljs_core.X_STAR_print_fn_STAR_ = _STAR_print_fn_STAR_880_1449
+							cljs_core.Pop_thread_bindings.X_invoke_Arity0()
 
 						}()
 						{
-							cljs_core.X_STAR_print_fn_STAR_ = func(G__1450 *cljs_core.AFn, _STAR_print_fn_STAR_880_1449 interface{}, sb__1140__auto__ *goog_string.StringBuffer) *cljs_core.AFn {
-								return cljs_core.Fn(G__1450, 1, func(x__1141__auto__ interface{}) interface{} {
-									return sb__1140__auto__.Append(x__1141__auto__)
-								})
-							}(&cljs_core.AFn{}, _STAR_print_fn_STAR_880_1449, sb__1140__auto__)
-
 							{
 								var seq__881_1451 interface{} = cljs_core.Seq.Arity1IQ(Cljs_739.X_invoke_Arity2(cljs_core.CljsCorePersistentVector_EMPTY, (&cljs_core.CljsCorePersistentVector{nil, float64(4), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{(&cljs_core.CljsCoreKeyword{Ns: nil, Name: "a", Fqn: "a", X_hash: float64(-2123407586)}), (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "b", Fqn: "b", X_hash: float64(1482224470)}), (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "c", Fqn: "c", X_hash: float64(-1763192079)}), (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "d", Fqn: "d", X_hash: float64(1972142424)})}, nil})))
 								var chunk__882_1452 interface{} = nil
@@ -9130,16 +9119,13 @@ func init() {
 				}
 			}
 			if cljs_core.X_EQ_.Arity2IIB(func() interface{} {
-				var _STAR_print_length_STAR_941 = cljs_core.X_STAR_print_length_STAR_
-				_ = _STAR_print_length_STAR_941
+				cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.core/*print-length*", float64(1)}, nil}))
 				return func() string {
 					defer func() {
-						cljs_core.X_STAR_print_length_STAR_ = _STAR_print_length_STAR_941
+						cljs_core.Pop_thread_bindings.X_invoke_Arity0()
 
 					}()
 					{
-						cljs_core.X_STAR_print_length_STAR_ = float64(1)
-
 						return strings.Join([]string{cljs_core.Str.X_invoke_Arity1((&cljs_core.CljsCorePersistentVector{nil, float64(10), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{float64(1), float64(2), float64(3), float64(4), float64(5), float64(6), float64(7), float64(8), float64(9), float64(0)}, nil})).(string)}, ``)
 					}
 				}()
@@ -9148,16 +9134,13 @@ func init() {
 				panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= (binding [*print-length* 1] (str [1 2 3 4 5 6 7 8 9 0])) \"[1 ...]\")").(string)}, ``)}))
 			}
 			if cljs_core.X_EQ_.Arity2IIB(func() interface{} {
-				var _STAR_print_length_STAR_942 = cljs_core.X_STAR_print_length_STAR_
-				_ = _STAR_print_length_STAR_942
+				cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.core/*print-length*", float64(2)}, nil}))
 				return func() string {
 					defer func() {
-						cljs_core.X_STAR_print_length_STAR_ = _STAR_print_length_STAR_942
+						cljs_core.Pop_thread_bindings.X_invoke_Arity0()
 
 					}()
 					{
-						cljs_core.X_STAR_print_length_STAR_ = float64(2)
-
 						return strings.Join([]string{cljs_core.Str.X_invoke_Arity1((&cljs_core.CljsCorePersistentVector{nil, float64(10), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{float64(1), float64(2), float64(3), float64(4), float64(5), float64(6), float64(7), float64(8), float64(9), float64(0)}, nil})).(string)}, ``)
 					}
 				}()
@@ -9166,16 +9149,13 @@ func init() {
 				panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= (binding [*print-length* 2] (str [1 2 3 4 5 6 7 8 9 0])) \"[1 2 ...]\")").(string)}, ``)}))
 			}
 			if cljs_core.X_EQ_.Arity2IIB(func() interface{} {
-				var _STAR_print_length_STAR_943 = cljs_core.X_STAR_print_length_STAR_
-				_ = _STAR_print_length_STAR_943
+				cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.core/*print-length*", float64(10)}, nil}))
 				return func() string {
 					defer func() {
-						cljs_core.X_STAR_print_length_STAR_ = _STAR_print_length_STAR_943
+						cljs_core.Pop_thread_bindings.X_invoke_Arity0()
 
 					}()
 					{
-						cljs_core.X_STAR_print_length_STAR_ = float64(10)
-
 						return strings.Join([]string{cljs_core.Str.X_invoke_Arity1((&cljs_core.CljsCorePersistentVector{nil, float64(10), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{float64(1), float64(2), float64(3), float64(4), float64(5), float64(6), float64(7), float64(8), float64(9), float64(0)}, nil})).(string)}, ``)
 					}
 				}()
@@ -9184,16 +9164,13 @@ func init() {
 				panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= (binding [*print-length* 10] (str [1 2 3 4 5 6 7 8 9 0])) \"[1 2 3 4 5 6 7 8 9 0]\")").(string)}, ``)}))
 			}
 			if cljs_core.X_EQ_.Arity2IIB(func() interface{} {
-				var _STAR_print_length_STAR_944 = cljs_core.X_STAR_print_length_STAR_
-				_ = _STAR_print_length_STAR_944
+				cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.core/*print-length*", float64(10)}, nil}))
 				return func() string {
 					defer func() {
-						cljs_core.X_STAR_print_length_STAR_ = _STAR_print_length_STAR_944
+						cljs_core.Pop_thread_bindings.X_invoke_Arity0()
 
 					}()
 					{
-						cljs_core.X_STAR_print_length_STAR_ = float64(10)
-
 						return strings.Join([]string{cljs_core.Str.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{(&cljs_core.CljsCoreKeyword{Ns: nil, Name: "foo", Fqn: "foo", X_hash: float64(1268894036)}), "bar"}, nil})).(string)}, ``)
 					}
 				}()
@@ -9202,16 +9179,13 @@ func init() {
 				panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= (binding [*print-length* 10] (str {:foo \"bar\"})) \"{:foo \\\"bar\\\"}\")").(string)}, ``)}))
 			}
 			if cljs_core.X_EQ_.Arity2IIB(func() interface{} {
-				var _STAR_print_length_STAR_945 = cljs_core.X_STAR_print_length_STAR_
-				_ = _STAR_print_length_STAR_945
+				cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.core/*print-length*", float64(1)}, nil}))
 				return func() string {
 					defer func() {
-						cljs_core.X_STAR_print_length_STAR_ = _STAR_print_length_STAR_945
+						cljs_core.Pop_thread_bindings.X_invoke_Arity0()
 
 					}()
 					{
-						cljs_core.X_STAR_print_length_STAR_ = float64(1)
-
 						return strings.Join([]string{cljs_core.Str.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(2), []interface{}{(&cljs_core.CljsCoreKeyword{Ns: nil, Name: "foo", Fqn: "foo", X_hash: float64(1268894036)}), "bar", (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "baz", Fqn: "baz", X_hash: float64(-1134894686)}), "woz"}, nil})).(string)}, ``)
 					}
 				}()
@@ -9220,16 +9194,13 @@ func init() {
 				panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= (binding [*print-length* 1] (str {:foo \"bar\", :baz \"woz\"})) \"{:foo \\\"bar\\\", ...}\")").(string)}, ``)}))
 			}
 			if cljs_core.X_EQ_.Arity2IIB(func() interface{} {
-				var _STAR_print_length_STAR_946 = cljs_core.X_STAR_print_length_STAR_
-				_ = _STAR_print_length_STAR_946
+				cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.core/*print-length*", float64(10)}, nil}))
 				return func() string {
 					defer func() {
-						cljs_core.X_STAR_print_length_STAR_ = _STAR_print_length_STAR_946
+						cljs_core.Pop_thread_bindings.X_invoke_Arity0()
 
 					}()
 					{
-						cljs_core.X_STAR_print_length_STAR_ = float64(10)
-
 						return strings.Join([]string{cljs_core.Str.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(2), []interface{}{(&cljs_core.CljsCoreKeyword{Ns: nil, Name: "foo", Fqn: "foo", X_hash: float64(1268894036)}), "bar", (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "baz", Fqn: "baz", X_hash: float64(-1134894686)}), "woz"}, nil})).(string)}, ``)
 					}
 				}()
@@ -9750,6 +9721,76 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("cljs.core-test", "->A", &X__GT_A, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->A2", &X__GT_A2, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->B", &X__GT_B, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->C", &X__GT_C, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->DestructuringWithLocals", &X__GT_DestructuringWithLocals, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->First", &X__GT_First, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->FixedHash", &X__GT_FixedHash, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->FnLike", &X__GT_FnLike, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->FnLikeB", &X__GT_FnLikeB, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->KeywordTest", &X__GT_KeywordTest, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->Mutate", &X__GT_Mutate, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->Person", &X__GT_Person, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->PositionalFactoryTest", &X__GT_PositionalFactoryTest, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->PrintMe", &X__GT_PrintMe, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->t756", &X__GT_t756, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->t837", &X__GT_t837, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->t851", &X__GT_t851, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->t854", &X__GT_t854, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->t924", &X__GT_t924, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "->t936", &X__GT_t936, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "-bar", &X_bar, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "-find-first", &X_find_first, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "-get-first", &X_get_first, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "-woz", &X_woz, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "apply-multi-test", &Apply_multi_test, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "area", &Area, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "array-map-conversion-threshold", &Array_map_conversion_threshold, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "bar", &Bar, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "baz", &Baz, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "c", &C, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "case-recur", &Case_recur, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "circle", &Circle, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "cljs-739", &Cljs_739, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "cljs-780", &Cljs_780, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "data", &Data, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "fixed-hash-bar", &Fixed_hash_bar, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "fixed-hash-foo", &Fixed_hash_foo, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "foo", &Foo, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "foo1", &Foo1, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "foo2", &Foo2, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "foo580", &Foo580, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "fred", &Fred, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "fred-too", &Fred_too, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "letters", &Letters, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "map->A", &Map__GT_A, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "map->A2", &Map__GT_A2, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "map->B", &Map__GT_B, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "map->C", &Map__GT_C, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "map->Person", &Map__GT_Person, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "map->PrintMe", &Map__GT_PrintMe, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "more-letters", &More_letters, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "mutate", &Mutate, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "my-map-hierarchy", &My_map_hierarchy, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "my-map?", &My_map_QMARK_, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "nested-dispatch", &Nested_dispatch, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "nested-dispatch2", &Nested_dispatch2, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "no-dispatch-value", &No_dispatch_value, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "noz", &Noz, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "original-closure-stmt", &Original_closure_stmt, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "r", &R, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "rect", &Rect, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "some-x", &Some_x, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "some-y", &Some_y, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "test-map", &Test_map, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "test-stuff", &Test_stuff, map[string]interface{}{"arglists": [][]string{{}}})
+	cljs_core.Intern_("cljs.core-test", "var-test", &Var_test, map[string]interface{}{"doc": "A docstring"})
+	cljs_core.Intern_("cljs.core-test", "var-test-self-call", &Var_test_self_call, map[string]interface{}{"doc": "A docstring"})
+	cljs_core.Intern_("cljs.core-test", "var-test-test", &Var_test_test, map[string]interface{}{"doc": "A docstring"})
+	cljs_core.Intern_("cljs.core-test", "xf", &Xf, map[string]interface{}{})
+	cljs_core.Intern_("cljs.core-test", "xform", &Xform, map[string]interface{}{})
 }
 
 var Test_stuff *cljs_core.AFn
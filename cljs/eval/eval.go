@@ -0,0 +1,803 @@
+// cljs.eval
+
+// A tree-walking interpreter for forms read by cljs.reader. Symbols resolve to the vars of the
+// compiled namespaces registered with this package, so interpreted code can call compiled code,
+// and interpreted fns are plain *AFn values compiled code can call in turn.
+package eval
+
+//go:generate go run gen_types.go
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	"github.com/hraberg/cljs2go/cljs/reader"
+	"github.com/hraberg/cljs2go/js"
+)
+
+var (
+	typesMutex sync.RWMutex
+	types      = map[string]map[string]reflect.Type{}
+)
+
+// Registers the Go types of a namespace, so they can be used with new, instance? and catch. Vars
+// are looked up in the namespace registry of cljs.core, see ns-resolve.
+func registerTypes(ns string, ts map[string]reflect.Type) {
+	typesMutex.Lock()
+	defer typesMutex.Unlock()
+	if types[ns] == nil {
+		types[ns] = map[string]reflect.Type{}
+	}
+	for name, t := range ts {
+		types[ns][name] = t
+	}
+}
+
+func lookupType(ns, name string) (reflect.Type, bool) {
+	typesMutex.RLock()
+	defer typesMutex.RUnlock()
+	t, ok := types[ns][name]
+	return t, ok
+}
+
+// The current namespace, *ns*. def interns vars into it, and unqualified symbols are resolved in it
+// before cljs.core.
+func currentNs() interface{} {
+	return cljs_core.Dynamic_("cljs.core/*ns*", cljs_core.X_STAR_ns_STAR_)
+}
+
+// Evaluates the form data structure (not text!) and returns the result.
+var Eval = cljs_core.Fn(func(form interface{}) interface{} {
+	return eval(form, nil)
+})
+
+// Sequentially reads and evaluates the forms in the string s, returning the value of the last one.
+var Load_string = cljs_core.Fn(func(s interface{}) interface{} {
+	r := reader.Push_back_reader.X_invoke_Arity1(s)
+	eof := new(interface{})
+	var result interface{}
+	for {
+		form := reader.Read.X_invoke_Arity4(r, false, eof, false)
+		if form == eof {
+			return result
+		}
+		result = eval(form, nil)
+	}
+})
+
+var Instance_QMARK_ = cljs_core.Fn(func(t, x interface{}) interface{} {
+	return cljs_core.Instance_(t, x)
+})
+
+func init() {
+	cljs_core.Intern_("cljs.eval", "eval", &Eval, map[string]interface{}{"doc": "Evaluates the form data structure (not text!) and returns the result.", "arglists": [][]string{{"form"}}})
+	cljs_core.Intern_("cljs.eval", "load-string", &Load_string, map[string]interface{}{"doc": "Sequentially reads and evaluates the forms in the string s, returning the value of the last one.", "arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("cljs.core", "instance?", &Instance_QMARK_, map[string]interface{}{"arglists": [][]string{{"t", "x"}}})
+	registerTypes("js", map[string]reflect.Type{
+		"Error":      reflect.TypeOf((*js.Error)(nil)),
+		"TypeError":  reflect.TypeOf((*js.TypeError)(nil)),
+		"PanicError": reflect.TypeOf((*js.PanicError)(nil)),
+		"Date":       reflect.TypeOf((*js.Date)(nil)),
+		"RegExp":     reflect.TypeOf((*js.RegExp)(nil)),
+	})
+}
+
+type locals struct {
+	name string
+	val  interface{}
+	next *locals
+}
+
+func (l *locals) bind(name string, val interface{}) *locals {
+	return &locals{name, val, l}
+}
+
+func (l *locals) lookup(name string) (interface{}, bool) {
+	for ; l != nil; l = l.next {
+		if l.name == name {
+			return l.val, true
+		}
+	}
+	return nil, false
+}
+
+// The value of a recur form, carrying the new bindings back to the enclosing loop* or fn*.
+type recur []interface{}
+
+func evalError(format string, args ...interface{}) *js.Error {
+	return &js.Error{fmt.Sprintf(format, args...)}
+}
+
+func prStr(x interface{}) string {
+	return cljs_core.Pr_str.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{x})).(string)
+}
+
+func items(coll interface{}) []interface{} {
+	var xs []interface{}
+	for s := cljs_core.Seq.X_invoke_Arity1(coll); s != nil; s = cljs_core.Next.X_invoke_Arity1(s) {
+		xs = append(xs, cljs_core.First.X_invoke_Arity1(s))
+	}
+	return xs
+}
+
+func list(xs ...interface{}) interface{} {
+	return cljs_core.List.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1(xs))
+}
+
+func symbol(name string) interface{} {
+	return cljs_core.Symbol.X_invoke_Arity1(name)
+}
+
+func symbolName(x interface{}) (ns string, name string, ok bool) {
+	if s, ok := x.(*cljs_core.CljsCoreSymbol); ok {
+		if s.Ns != nil {
+			ns = s.Ns.(string)
+		}
+		return ns, s.Name.(string), true
+	}
+	return "", "", false
+}
+
+func localName(x interface{}) string {
+	if ns, name, ok := symbolName(x); ok && ns == "" {
+		return name
+	}
+	panic(evalError("Bad binding form, expected local symbol, got: %s", prStr(x)))
+}
+
+func isVector(x interface{}) bool {
+	_, ok := x.(cljs_core.CljsCoreIVector)
+	return ok
+}
+
+var gensymCounter int64
+
+func gensym(prefix string) interface{} {
+	return symbol(fmt.Sprint(prefix, atomic.AddInt64(&gensymCounter, 1)))
+}
+
+func eval(form interface{}, env *locals) interface{} {
+	switch f := form.(type) {
+	case *cljs_core.CljsCoreSymbol:
+		return resolve(f, env)
+	case cljs_core.CljsCoreIVector:
+		return cljs_core.Vec.X_invoke_Arity1(evalAll(items(f), env))
+	case cljs_core.CljsCoreIMap:
+		entries := items(f)
+		for i, e := range entries {
+			entries[i] = cljs_core.Vec.X_invoke_Arity1(evalAll(items(e), env))
+		}
+		return cljs_core.Into.X_invoke_Arity2(cljs_core.CljsCorePersistentArrayMap_EMPTY, entries)
+	case cljs_core.CljsCoreISet:
+		return cljs_core.Into.X_invoke_Arity2(cljs_core.CljsCorePersistentHashSet_EMPTY, evalAll(items(f), env))
+	case cljs_core.CljsCoreISeq:
+		if cljs_core.Seq.X_invoke_Arity1(f) != nil {
+			return evalSeq(items(f), env)
+		}
+	}
+	return form
+}
+
+func evalAll(forms []interface{}, env *locals) []interface{} {
+	xs := make([]interface{}, len(forms))
+	for i, f := range forms {
+		xs[i] = eval(f, env)
+	}
+	return xs
+}
+
+func evalBody(forms []interface{}, env *locals) interface{} {
+	var result interface{}
+	for _, f := range forms {
+		result = eval(f, env)
+	}
+	return result
+}
+
+func evalSeq(form []interface{}, env *locals) interface{} {
+	if ns, name, ok := symbolName(form[0]); ok && ns == "" {
+		if _, local := env.lookup(name); !local {
+			if special, ok := specials[name]; ok {
+				return special(form[1:], env)
+			}
+		}
+	}
+	if expansion, ok := macroexpand1(form, env); ok {
+		return eval(expansion, env)
+	}
+	return invoke(eval(form[0], env), evalAll(form[1:], env))
+}
+
+func invoke(f interface{}, args []interface{}) interface{} {
+	if _, ok := f.(cljs_core.CljsCoreIFn); ok {
+		return cljs_core.Apply.X_invoke_Arity2(f, cljs_core.Array_seq.X_invoke_Arity1(args))
+	}
+	if f != nil && reflect.TypeOf(f).Kind() == reflect.Func {
+		return cljs_core.Native_invoke_func.X_invoke_Arity2(f, args)
+	}
+	panic(&js.TypeError{prStr(f) + " is not a function"})
+}
+
+func resolveVar(ns, name string) (*cljs_core.CljsCoreVar, bool) {
+	var sym interface{}
+	if ns == "" {
+		sym = cljs_core.Symbol.X_invoke_Arity1(name)
+	} else {
+		sym = cljs_core.Symbol.X_invoke_Arity2(ns, name)
+	}
+	v, ok := cljs_core.Ns_resolve.X_invoke_Arity2(currentNs(), sym).(*cljs_core.CljsCoreVar)
+	return v, ok
+}
+
+// Returns the goroutine binding of v if it has one, like *1 in a REPL, otherwise its root value.
+func varValue(v *cljs_core.CljsCoreVar) interface{} {
+	return cljs_core.Dynamic_(v.Sym.(*cljs_core.CljsCoreSymbol).Str.(string), cljs_core.Var_get.X_invoke_Arity1(v))
+}
+
+func resolve(sym *cljs_core.CljsCoreSymbol, env *locals) interface{} {
+	ns, name, _ := symbolName(sym)
+	if ns == "" {
+		if v, ok := env.lookup(name); ok {
+			return v
+		}
+	}
+	if v, ok := resolveVar(ns, name); ok {
+		return varValue(v)
+	}
+	if ns == "" {
+		ns = "cljs.core"
+	}
+	if t, ok := lookupType(ns, name); ok {
+		return t
+	}
+	panic(evalError("Unable to resolve symbol: %s in this context", prStr(sym)))
+}
+
+func setVar(v reflect.Value, val interface{}) {
+	switch {
+	case val == nil:
+		v.Set(reflect.Zero(v.Type()))
+	case reflect.TypeOf(val).AssignableTo(v.Type()):
+		v.Set(reflect.ValueOf(val))
+	default:
+		panic(&js.TypeError{fmt.Sprintf("Can't set var of type %s to %s", v.Type(), prStr(val))})
+	}
+}
+
+var goNameTokens = strings.NewReplacer("-", "_", ":", "_COLON_", "+", "_PLUS_", ">", "_GT_", "<", "_LT_",
+	"=", "_EQ_", "~", "_TILDE_", "!", "_BANG_", "@", "_CIRCA_", "#", "_SHARP_", "'", "_SINGLEQUOTE_",
+	"%", "_PERCENT_", "^", "_CARET_", "&", "_AMPERSAND_", "*", "_STAR_", "|", "_BAR_", "/", "_SLASH_",
+	"?", "_QMARK_")
+
+// The exported Go name of a field or method, like getLength to GetLength.
+func goName(name string) string {
+	name = goNameTokens.Replace(name)
+	if strings.HasPrefix(name, "_") {
+		return "X" + name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+type specialForm func(args []interface{}, env *locals) interface{}
+
+var specials map[string]specialForm
+
+func init() {
+	specials = map[string]specialForm{
+		"def":    evalDef,
+		"fn*":    evalFn,
+		"let*":   evalLet,
+		"loop*":  evalLoop,
+		"recur":  evalRecur,
+		"if":     evalIf,
+		"do":     evalBody,
+		"quote":  evalQuote,
+		"throw":  evalThrow,
+		"try":    evalTry,
+		"set!":   evalSet,
+		"new":    evalNew,
+		".":      evalDot,
+		"var":    evalVar,
+		"letfn*": evalLetfn,
+	}
+}
+
+func checkArgs(op string, args []interface{}, min, max int) {
+	if len(args) < min {
+		panic(evalError("Too few arguments to %s", op))
+	}
+	if max >= 0 && len(args) > max {
+		panic(evalError("Too many arguments to %s", op))
+	}
+}
+
+func evalDef(args []interface{}, env *locals) interface{} {
+	checkArgs("def", args, 1, 3)
+	sym := args[0]
+	localName(sym)
+	if len(args) == 3 {
+		meta := cljs_core.Assoc.X_invoke_Arity3(cljs_core.Meta.X_invoke_Arity1(sym), cljs_core.Keyword.X_invoke_Arity1("doc"), args[1])
+		sym = cljs_core.With_meta.X_invoke_Arity2(sym, meta)
+	}
+	if len(args) > 1 {
+		return cljs_core.Intern.X_invoke_Arity3(currentNs(), sym, eval(args[len(args)-1], env))
+	}
+	return cljs_core.Intern.X_invoke_Arity2(currentNs(), sym)
+}
+
+func evalVar(args []interface{}, env *locals) interface{} {
+	checkArgs("var", args, 1, 1)
+	ns, name, _ := symbolName(args[0])
+	if v, ok := resolveVar(ns, name); ok {
+		return v
+	}
+	panic(evalError("Unable to resolve var: %s in this context", prStr(args[0])))
+}
+
+type method struct {
+	params []string
+	rest   string
+	body   []interface{}
+}
+
+func newMethod(params interface{}, body []interface{}) *method {
+	if !isVector(params) {
+		panic(evalError("Parameter declaration %s should be a vector", prStr(params)))
+	}
+	m := &method{body: body}
+	ps := items(params)
+	for i, p := range ps {
+		if name := localName(p); name != "&" {
+			m.params = append(m.params, name)
+		} else if i == len(ps)-2 {
+			m.rest = localName(ps[i+1])
+			break
+		} else {
+			panic(evalError("Invalid parameter declaration %s", prStr(params)))
+		}
+	}
+	return m
+}
+
+func (m *method) variadic() bool {
+	return m.rest != ""
+}
+
+func (m *method) invoke(args []interface{}, rest interface{}, env *locals) interface{} {
+	for {
+		e := env
+		for i, p := range m.params {
+			e = e.bind(p, args[i])
+		}
+		if m.variadic() {
+			e = e.bind(m.rest, rest)
+		}
+		result := evalBody(m.body, e)
+		r, ok := result.(recur)
+		if !ok {
+			return result
+		}
+		if expected := len(m.params) + boolInt(m.variadic()); len(r) != expected {
+			panic(evalError("recur arg count mismatch, expected %d, got %d", expected, len(r)))
+		}
+		args = r[:len(m.params)]
+		if m.variadic() {
+			rest = r[len(m.params)]
+		}
+	}
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func dispatch(methods []*method, argSeq interface{}) (*method, []interface{}, interface{}) {
+	max := 0
+	var variadic *method
+	for _, m := range methods {
+		if len(m.params) > max {
+			max = len(m.params)
+		}
+		if m.variadic() {
+			variadic = m
+		}
+	}
+	var args []interface{}
+	s := cljs_core.Seq.X_invoke_Arity1(argSeq)
+	for ; s != nil && len(args) <= max; s = cljs_core.Next.X_invoke_Arity1(s) {
+		args = append(args, cljs_core.First.X_invoke_Arity1(s))
+	}
+	if s == nil {
+		for _, m := range methods {
+			if !m.variadic() && len(m.params) == len(args) {
+				return m, args, nil
+			}
+		}
+	}
+	if variadic == nil || len(args) < len(variadic.params) {
+		panic(evalError("Invalid arity: %d", len(args)+int(cljs_core.Count.X_invoke_Arity1(s).(float64))))
+	}
+	for i := len(args) - 1; i >= len(variadic.params); i-- {
+		s = cljs_core.Cons.X_invoke_Arity2(args[i], s)
+	}
+	return variadic, args[:len(variadic.params)], s
+}
+
+func evalFn(args []interface{}, env *locals) interface{} {
+	var name string
+	if len(args) > 0 {
+		if _, n, ok := symbolName(args[0]); ok {
+			name, args = n, args[1:]
+		}
+	}
+	checkArgs("fn*", args, 1, -1)
+	var methods []*method
+	if isVector(args[0]) {
+		methods = append(methods, newMethod(args[0], args[1:]))
+	} else {
+		for _, m := range args {
+			m := items(m)
+			if len(m) == 0 {
+				panic(evalError("Invalid fn* method"))
+			}
+			methods = append(methods, newMethod(m[0], m[1:]))
+		}
+	}
+	f := &cljs_core.AFn{}
+	if name != "" {
+		env = env.bind(name, f)
+	}
+	return cljs_core.Fn(f, 0, func(args ...interface{}) interface{} {
+		m, fixed, rest := dispatch(methods, args[0])
+		return m.invoke(fixed, rest, env)
+	})
+}
+
+func bindings(op string, x interface{}) []interface{} {
+	if !isVector(x) {
+		panic(evalError("%s requires a vector for its binding", op))
+	}
+	bs := items(x)
+	if len(bs)%2 != 0 {
+		panic(evalError("%s requires an even number of forms in binding vector", op))
+	}
+	return bs
+}
+
+func evalLet(args []interface{}, env *locals) interface{} {
+	checkArgs("let*", args, 1, -1)
+	bs := bindings("let*", args[0])
+	for i := 0; i < len(bs); i += 2 {
+		env = env.bind(localName(bs[i]), eval(bs[i+1], env))
+	}
+	return evalBody(args[1:], env)
+}
+
+func evalLoop(args []interface{}, env *locals) interface{} {
+	checkArgs("loop*", args, 1, -1)
+	bs := bindings("loop*", args[0])
+	names := make([]string, len(bs)/2)
+	vals := make([]interface{}, len(bs)/2)
+	e := env
+	for i := 0; i < len(bs); i += 2 {
+		names[i/2], vals[i/2] = localName(bs[i]), eval(bs[i+1], e)
+		e = e.bind(names[i/2], vals[i/2])
+	}
+	for {
+		e := env
+		for i, name := range names {
+			e = e.bind(name, vals[i])
+		}
+		result := evalBody(args[1:], e)
+		r, ok := result.(recur)
+		if !ok {
+			return result
+		}
+		if len(r) != len(names) {
+			panic(evalError("recur arg count mismatch, expected %d, got %d", len(names), len(r)))
+		}
+		vals = r
+	}
+}
+
+func evalLetfn(args []interface{}, env *locals) interface{} {
+	checkArgs("letfn*", args, 1, -1)
+	bs := bindings("letfn*", args[0])
+	fns := make([]*cljs_core.AFn, len(bs)/2)
+	for i := 0; i < len(bs); i += 2 {
+		fns[i/2] = &cljs_core.AFn{}
+		env = env.bind(localName(bs[i]), fns[i/2])
+	}
+	for i := 0; i < len(bs); i += 2 {
+		*fns[i/2] = *eval(bs[i+1], env).(*cljs_core.AFn)
+	}
+	return evalBody(args[1:], env)
+}
+
+func evalRecur(args []interface{}, env *locals) interface{} {
+	return recur(evalAll(args, env))
+}
+
+func evalIf(args []interface{}, env *locals) interface{} {
+	checkArgs("if", args, 2, 3)
+	if cljs_core.Truth_(eval(args[0], env)) {
+		return eval(args[1], env)
+	}
+	if len(args) == 3 {
+		return eval(args[2], env)
+	}
+	return nil
+}
+
+func evalQuote(args []interface{}, env *locals) interface{} {
+	checkArgs("quote", args, 1, 1)
+	return args[0]
+}
+
+func evalThrow(args []interface{}, env *locals) interface{} {
+	checkArgs("throw", args, 1, 1)
+	panic(eval(args[0], env))
+}
+
+func head(form interface{}) string {
+	if _, ok := form.(cljs_core.CljsCoreISeq); ok {
+		if ns, name, ok := symbolName(cljs_core.First.X_invoke_Arity1(form)); ok && ns == "" {
+			return name
+		}
+	}
+	return ""
+}
+
+func evalTry(args []interface{}, env *locals) (result interface{}) {
+	var body, finally []interface{}
+	var catches [][]interface{}
+	for _, form := range args {
+		switch head(form) {
+		case "catch":
+			c := items(form)[1:]
+			if len(c) < 2 {
+				panic(evalError("Invalid catch clause %s", prStr(form)))
+			}
+			catches = append(catches, c)
+		case "finally":
+			finally = items(form)[1:]
+		default:
+			body = append(body, form)
+		}
+	}
+	if finally != nil {
+		defer evalBody(finally, env)
+	}
+	if catches != nil {
+		defer func() {
+			if x := recover(); x != nil {
+				for _, c := range catches {
+					if k, ok := c[0].(*cljs_core.CljsCoreKeyword); (ok && k.Fqn == "default") || cljs_core.Instance_(eval(c[0], env), x) {
+						result = evalBody(c[2:], env.bind(localName(c[1]), x))
+						return
+					}
+				}
+				panic(x)
+			}
+		}()
+	}
+	return evalBody(body, env)
+}
+
+func evalSet(args []interface{}, env *locals) interface{} {
+	checkArgs("set!", args, 2, 2)
+	target := args[0]
+	if head(target) != "" {
+		if expansion, ok := macroexpand1(items(target), env); ok {
+			target = expansion
+		}
+	}
+	val := eval(args[1], env)
+	if head(target) == "." {
+		dot := items(target)
+		if _, field, ok := symbolName(dot[2]); ok && len(dot) == 3 && strings.HasPrefix(field, "-") {
+			return cljs_core.Native_set_instance_field.X_invoke_Arity3(eval(dot[1], env), goName(field[1:]), val)
+		}
+		panic(evalError("Invalid assignment target %s", prStr(args[0])))
+	}
+	ns, name, ok := symbolName(target)
+	if !ok {
+		panic(evalError("Invalid assignment target %s", prStr(args[0])))
+	}
+	v, ok := resolveVar(ns, name)
+	if !ok {
+		panic(evalError("Unable to resolve var: %s in this context", prStr(target)))
+	}
+	if !cljs_core.Set_dynamic_(v.Sym.(*cljs_core.CljsCoreSymbol).Str.(string), val) {
+		cljs_core.Alter_var_root.X_invoke_Arity2(v, cljs_core.Constantly.X_invoke_Arity1(val))
+	}
+	return val
+}
+
+func evalNew(args []interface{}, env *locals) interface{} {
+	checkArgs("new", args, 1, -1)
+	t, ok := eval(args[0], env).(reflect.Type)
+	if !ok || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		panic(&js.TypeError{prStr(args[0]) + " is not a constructor"})
+	}
+	v := reflect.New(t.Elem())
+	if len(args)-1 > v.Elem().NumField() {
+		panic(evalError("Too many arguments to new %s", prStr(args[0])))
+	}
+	for i, a := range evalAll(args[1:], env) {
+		setVar(v.Elem().Field(i), a)
+	}
+	return v.Interface()
+}
+
+func evalDot(args []interface{}, env *locals) interface{} {
+	checkArgs(".", args, 2, -1)
+	target := eval(args[0], env)
+	member := args[1:]
+	if head(member[0]) != "" {
+		member = items(member[0])
+	}
+	_, name, ok := symbolName(member[0])
+	if !ok {
+		panic(evalError("Invalid member %s", prStr(member[0])))
+	}
+	if strings.HasPrefix(name, "-") {
+		if len(member) > 1 {
+			panic(evalError("Field access takes no arguments: %s", name))
+		}
+		return cljs_core.Native_get_instance_field.X_invoke_Arity2(target, goName(name[1:]))
+	}
+	return cljs_core.Native_invoke_instance_method.X_invoke_Arity3(target, goName(name), evalAll(member[1:], env))
+}
+
+type macro func(args []interface{}) interface{}
+
+var macros map[string]macro
+
+func init() {
+	macros = map[string]macro{
+		"fn": func(args []interface{}) interface{} {
+			return list(append([]interface{}{symbol("fn*")}, args...)...)
+		},
+		"let": func(args []interface{}) interface{} {
+			return list(append([]interface{}{symbol("let*")}, args...)...)
+		},
+		"loop": func(args []interface{}) interface{} {
+			return list(append([]interface{}{symbol("loop*")}, args...)...)
+		},
+		"letfn": func(args []interface{}) interface{} {
+			checkArgs("letfn", args, 1, -1)
+			var bs []interface{}
+			for _, f := range items(args[0]) {
+				f := items(f)
+				bs = append(bs, f[0], list(append([]interface{}{symbol("fn*")}, f...)...))
+			}
+			return list(append([]interface{}{symbol("letfn*"), cljs_core.Vec.X_invoke_Arity1(bs)}, args[1:]...)...)
+		},
+		"defn": func(args []interface{}) interface{} {
+			checkArgs("defn", args, 2, -1)
+			name := args[0]
+			def := []interface{}{symbol("def"), name}
+			args = args[1:]
+			if doc, ok := args[0].(string); ok && len(args) > 1 {
+				def, args = append(def, doc), args[1:]
+			}
+			if _, ok := args[0].(cljs_core.CljsCoreIMap); ok && len(args) > 1 {
+				args = args[1:]
+			}
+			return list(append(def, list(append([]interface{}{symbol("fn*"), name}, args...)...))...)
+		},
+		"declare": func(args []interface{}) interface{} {
+			forms := []interface{}{symbol("do")}
+			for _, name := range args {
+				forms = append(forms, list(symbol("def"), name))
+			}
+			return list(forms...)
+		},
+		"when": func(args []interface{}) interface{} {
+			checkArgs("when", args, 1, -1)
+			return list(symbol("if"), args[0], list(append([]interface{}{symbol("do")}, args[1:]...)...))
+		},
+		"when-not": func(args []interface{}) interface{} {
+			checkArgs("when-not", args, 1, -1)
+			return list(symbol("if"), args[0], nil, list(append([]interface{}{symbol("do")}, args[1:]...)...))
+		},
+		"if-not": func(args []interface{}) interface{} {
+			checkArgs("if-not", args, 2, 3)
+			return list(append([]interface{}{symbol("if"), list(symbol("not"), args[0])}, args[1:]...)...)
+		},
+		"cond": func(args []interface{}) interface{} {
+			if len(args) == 0 {
+				return nil
+			}
+			if len(args) == 1 {
+				panic(evalError("cond requires an even number of forms"))
+			}
+			return list(symbol("if"), args[0], args[1], list(append([]interface{}{symbol("cond")}, args[2:]...)...))
+		},
+		"and": func(args []interface{}) interface{} {
+			switch len(args) {
+			case 0:
+				return true
+			case 1:
+				return args[0]
+			}
+			and := gensym("and__")
+			return list(symbol("let*"), cljs_core.Vec.X_invoke_Arity1([]interface{}{and, args[0]}),
+				list(symbol("if"), and, list(append([]interface{}{symbol("and")}, args[1:]...)...), and))
+		},
+		"or": func(args []interface{}) interface{} {
+			switch len(args) {
+			case 0:
+				return nil
+			case 1:
+				return args[0]
+			}
+			or := gensym("or__")
+			return list(symbol("let*"), cljs_core.Vec.X_invoke_Arity1([]interface{}{or, args[0]}),
+				list(symbol("if"), or, or, list(append([]interface{}{symbol("or")}, args[1:]...)...)))
+		},
+		"->": func(args []interface{}) interface{} {
+			return thread(args, false)
+		},
+		"->>": func(args []interface{}) interface{} {
+			return thread(args, true)
+		},
+		"comment": func(args []interface{}) interface{} {
+			return nil
+		},
+	}
+}
+
+func thread(args []interface{}, last bool) interface{} {
+	checkArgs("->", args, 1, -1)
+	x := args[0]
+	for _, form := range args[1:] {
+		f := []interface{}{form}
+		if _, ok := form.(cljs_core.CljsCoreISeq); ok {
+			f = items(form)
+		}
+		if last {
+			f = append(f, x)
+		} else {
+			f = append([]interface{}{f[0], x}, f[1:]...)
+		}
+		x = list(f...)
+	}
+	return x
+}
+
+// Expands the interop sugar (.method obj), (.-field obj) and (Type. args), and the macros above.
+func macroexpand1(form []interface{}, env *locals) (interface{}, bool) {
+	ns, name, ok := symbolName(form[0])
+	if !ok {
+		return nil, false
+	}
+	if _, local := env.lookup(name); local && ns == "" {
+		return nil, false
+	}
+	switch {
+	case strings.HasPrefix(name, ".-") && len(name) > 2 && ns == "":
+		checkArgs(name, form[1:], 1, 1)
+		return list(symbol("."), form[1], symbol(name[1:])), true
+	case strings.HasPrefix(name, ".") && name != "." && name != ".." && ns == "":
+		checkArgs(name, form[1:], 1, -1)
+		return list(append([]interface{}{symbol("."), form[1], symbol(name[1:])}, form[2:]...)...), true
+	case strings.HasSuffix(name, ".") && len(name) > 1:
+		t := cljs_core.Symbol.X_invoke_Arity2(form[0].(*cljs_core.CljsCoreSymbol).Ns, name[:len(name)-1])
+		return list(append([]interface{}{symbol("new"), t}, form[1:]...)...), true
+	}
+	if ns == "" || ns == "cljs.core" {
+		if m, ok := macros[name]; ok {
+			return m(form[1:]), true
+		}
+	}
+	return nil, false
+}
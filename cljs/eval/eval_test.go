@@ -0,0 +1,100 @@
+package eval
+
+import (
+	"testing"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	"github.com/hraberg/cljs2go/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func evalString(s string) interface{} {
+	return Load_string.X_invoke_Arity1(s)
+}
+
+func prEval(s string) string {
+	return prStr(evalString(s))
+}
+
+func panicsWith(t *testing.T, message string, s string) {
+	assert.Equal(t, message, func() (message string) {
+		defer func() { message = recover().(error).Error() }()
+		evalString(s)
+		assert.Fail(t, "should panic")
+		return
+	}())
+}
+
+func Test_SpecialForms(t *testing.T) {
+	assert.Equal(t, 3.0, evalString("(+ 1 2)"))
+	assert.Equal(t, "[1 2 {:a 3} #{4}]", prEval("[1 (inc 1) {:a (+ 1 2)} #{(* 2 2)}]"))
+	assert.Equal(t, "(+ 1 2)", prEval("'(+ 1 2)"))
+	assert.Equal(t, "yes", evalString(`(if (pos? 1) "yes" "no")`))
+	assert.Nil(t, evalString(`(if nil "yes")`))
+	assert.Equal(t, 3.0, evalString("(do 1 2 3)"))
+	assert.Equal(t, 3.0, evalString("(let* [x 1 y (+ x 1)] (+ x y))"))
+	assert.Equal(t, 55.0, evalString("(loop* [i 0 acc 0] (if (> i 10) acc (recur (inc i) (+ acc i))))"))
+	assert.Equal(t, 120.0, evalString("((fn* fact [n] (if (zero? n) 1 (* n (fact (dec n))))) 5)"))
+	assert.Equal(t, "[0 1 (2 3)]", prEval("[((fn* ([] 0) ([x] x) ([x & xs] xs))) ((fn* ([] 0) ([x] x)) 1) (apply (fn* [x & xs] xs) 1 [2 3])]"))
+	assert.Equal(t, 10.0, evalString("((fn* [n acc] (if (zero? n) acc (recur (dec n) (+ acc n)))) 4 0)"))
+	assert.Equal(t, true, evalString("(letfn* [even? (fn* [n] (if (zero? n) true (odd? (dec n)))) odd? (fn* [n] (if (zero? n) false (even? (dec n))))] (even? 10))"))
+	assert.Equal(t, "[3 [(1 2 3)] #'cljs.core/inc]", prEval("[(#(+ % %2) 1 2) (#(vector %&) 1 2 3) #'inc]"))
+
+	panicsWith(t, "Invalid arity: 2", "((fn* [x] x) 1 2)")
+	panicsWith(t, "Unable to resolve symbol: foo in this context", "(foo)")
+	panicsWith(t, "recur arg count mismatch, expected 1, got 2", "(loop* [i 0] (recur 1 2))")
+}
+
+func Test_DefAndNamespaces(t *testing.T) {
+	v := evalString(`(def answer "The answer." 42)`).(*cljs_core.CljsCoreVar)
+	assert.Equal(t, "#'cljs.user/answer", prStr(v))
+	assert.Equal(t, "The answer.", cljs_core.Get.X_invoke_Arity2(v.X_meta, cljs_core.Keyword.X_invoke_Arity1("doc")))
+	assert.Equal(t, 42.0, evalString("answer"))
+	assert.Equal(t, 43.0, evalString("(set! answer 43) cljs.user/answer"))
+
+	assert.Equal(t, "(2 3 4)", prEval("(defn add [x y] (+ x y)) (map (partial add 1) [1 2 3])"))
+	assert.Equal(t, 5.0, evalString("(defn fib [n] (if (< n 2) n (+ (fib (dec n)) (fib (- n 2))))) (fib 5)"))
+	assert.Equal(t, "[1 (2 3)]", prEval("(defn rest-args \"Variadic.\" [x & xs] [x xs]) (rest-args 1 2 3)"))
+	assert.Equal(t, "[1 3 6]", prEval("(defn sum ([x] x) ([x y] (+ x y)) ([x y & more] (apply sum (sum x y) more))) [(sum 1) (sum 1 2) (sum 1 2 3)]"))
+	add := evalString("add").(*cljs_core.AFn)
+	assert.Equal(t, 3.0, add.X_invoke_Arity2(1.0, 2.0))
+
+	assert.Equal(t, "[1 nil :b 3]", prEval("[(when true 1) (when-not true 2) (cond false :a :else :b) (-> 1 inc (+ 1))]"))
+	assert.Equal(t, "[nil 2 false 1]", prEval("[(and 1 nil) (and 1 2) (or nil false) (or nil 1 2)]"))
+
+	assert.Equal(t, "(1 2 3)", prEval(`(def xs [1 2 3]) (seq (deref (var xs)))`))
+	assert.Equal(t, "cljs.user", prEval(`(cljs.eval/eval '(ns-name *ns*))`))
+	assert.Equal(t, "[2 (1 2 3)]", prEval("(defn template [x] `(let [y# (inc ~x)] (when (pos? y#) (-> y# (vector (list ~@[1 2 3])))))) (cljs.eval/eval (template 1))"))
+}
+
+func Test_TryAndInterop(t *testing.T) {
+	assert.Equal(t, "caught: boom {:a 1}", evalString(`
+		(try
+		  (throw (ex-info "boom" {:a 1}))
+		  (catch js/Error e :error)
+		  (catch ExceptionInfo e (str "caught: " (.-message e) " " (pr-str (ex-data e)))))`))
+	assert.Equal(t, "default", evalString(`(try (throw "x") (catch :default _ "default"))`))
+	assert.Equal(t, "[1 :finally]", prEval(`(def log (atom [])) (try 1 (finally (swap! log conj :finally))) [1 (first @log)]`))
+	_, ok := func() (x interface{}, ok bool) {
+		defer func() { x = recover(); _, ok = x.(*js.TypeError) }()
+		evalString(`(try (throw (js/TypeError. "type")) (catch js/Error e :caught))`)
+		return
+	}()
+	assert.True(t, ok)
+
+	nth := func(i float64) string { return []string{"x"}[int(i)] }
+	cljs_core.Intern_("cljs.user", "go-nth", &nth, nil)
+	assert.Equal(t, `[true "runtime error: index out of range [1] with length 1"]`, prEval(`
+		(try
+		  (go-nth 1)
+		  (catch js/Error e [(instance? js/PanicError (.-message e)) (.Error e)]))`))
+
+	assert.Equal(t, "message", evalString(`(.-message (js/Error. "message"))`))
+	assert.Equal(t, "message", evalString(`(. (new js/Error "message") -message)`))
+	assert.Equal(t, "Error", evalString(`(.error (js/Error. "Error"))`))
+	assert.Equal(t, 2014.0, evalString(`(.getUTCFullYear (js/Date. 1407962432671))`))
+	assert.Equal(t, "changed", evalString(`(let* [e (js/Error. "message")] (set! (.-message e) "changed") (.-message e))`))
+	assert.Equal(t, true, evalString(`(instance? js/Error (js/Error. "message"))`))
+	assert.Equal(t, true, evalString(`(instance? cljs.reader/StringPushbackReader (cljs.reader/push-back-reader "x"))`))
+	assert.Equal(t, true, evalString(`(instance? cljs.core/Namespace (find-ns 'cljs.core))`))
+}
@@ -0,0 +1,83 @@
+//go:build ignore
+// +build ignore
+
+// Generates cljs_core.go and cljs_reader.go, registering the exported struct types of those
+// namespaces with cljs.eval. Run via go generate in this directory after adding deftypes.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var namespaces = []struct{ ns, dir, pkg, prefix string }{
+	{"cljs.core", "../core", "cljs_core", "CljsCore"},
+	{"cljs.reader", "../reader", "reader", "CljsReader"},
+}
+
+func main() {
+	for _, n := range namespaces {
+		names := structTypes(n.dir, n.prefix)
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "// Code generated by gen_types.go from the exported types of %s. DO NOT EDIT.\n\n", n.ns)
+		fmt.Fprintf(&buf, "package eval\n\nimport (\n\t\"reflect\"\n\n")
+		if path := "github.com/hraberg/cljs2go/cljs/" + n.dir[len("../"):]; strings.HasSuffix(path, "/"+n.pkg) {
+			fmt.Fprintf(&buf, "\t%q\n)\n\n", path)
+		} else {
+			fmt.Fprintf(&buf, "\t%s %q\n)\n\n", n.pkg, path)
+		}
+		fmt.Fprintf(&buf, "func init() {\n\tregisterTypes(%q, map[string]reflect.Type{\n", n.ns)
+		for _, name := range names {
+			fmt.Fprintf(&buf, "\t\t%q: reflect.TypeOf((*%s.%s)(nil)),\n", strings.TrimPrefix(name, n.prefix), n.pkg, name)
+		}
+		fmt.Fprintf(&buf, "\t})\n}\n")
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			log.Fatal(err)
+		}
+		file := strings.Replace(n.ns, ".", "_", -1) + ".go"
+		if err := ioutil.WriteFile(file, src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// The anonymous types of reify, like CljsCoreT4787, aren't meant to be named.
+var reified = regexp.MustCompile(`T\d+$`)
+
+func structTypes(dir, prefix string) []string {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var names []string
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.TYPE {
+					for _, spec := range decl.Specs {
+						spec := spec.(*ast.TypeSpec)
+						if _, ok := spec.Type.(*ast.StructType); ok && strings.HasPrefix(spec.Name.Name, prefix) && !reified.MatchString(spec.Name.Name) {
+							names = append(names, spec.Name.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // cljs.keyword-other
 
 package keyword_other
@@ -12,6 +13,7 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("cljs.keyword-other", "foo", &Foo, map[string]interface{}{"arglists": [][]string{{"a", "b"}}})
 }
 
 var Foo *cljs_core.AFn
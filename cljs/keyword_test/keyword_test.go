@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // cljs.keyword-test
 
 package keyword_test
@@ -31,6 +32,7 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("cljs.keyword-test", "test-keyword", &Test_keyword, map[string]interface{}{"arglists": [][]string{{}}})
 }
 
 var Test_keyword *cljs_core.AFn
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // cljs.letfn-test
 
 package letfn_test
@@ -73,6 +74,7 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("cljs.letfn-test", "test-letfn", &Test_letfn, map[string]interface{}{"arglists": [][]string{{}}})
 }
 
 var Test_letfn *cljs_core.AFn
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // cljs.macro-test
 
 package macro_test
@@ -23,6 +24,7 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("cljs.macro-test", "test-macros", &Test_macros, map[string]interface{}{"arglists": [][]string{{}}})
 }
 
 var Test_macros *cljs_core.AFn
@@ -0,0 +1,190 @@
+package nrepl
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// Bencode, the wire format of nREPL. Messages are dictionaries with string keys, decoded to
+// map[string]interface{}. Byte strings decode to string, integers to int64 and lists to
+// []interface{}.
+
+// The longest byte string decode accepts, as its length is read from the wire.
+const maxStringLength = 64 << 20
+
+// The most digits of an integer or string length, and the deepest nesting of lists and dictionaries,
+// decode accepts, so a peer can't make it buffer or recurse without bound.
+const maxDigits = 20
+const maxDepth = 100
+
+func encode(w io.Writer, x interface{}) error {
+	var buf bytes.Buffer
+	if err := encodeTo(&buf, x); err != nil {
+		return err
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func encodeTo(buf *bytes.Buffer, x interface{}) error {
+	switch x := x.(type) {
+	case string:
+		fmt.Fprintf(buf, "%d:%s", len(x), x)
+	case []byte:
+		fmt.Fprintf(buf, "%d:%s", len(x), x)
+	case int:
+		fmt.Fprintf(buf, "i%de", x)
+	case int64:
+		fmt.Fprintf(buf, "i%de", x)
+	case float64:
+		fmt.Fprintf(buf, "i%de", int64(x))
+	case bool:
+		if x {
+			buf.WriteString("i1e")
+		} else {
+			buf.WriteString("i0e")
+		}
+	case []string:
+		buf.WriteByte('l')
+		for _, s := range x {
+			fmt.Fprintf(buf, "%d:%s", len(s), s)
+		}
+		buf.WriteByte('e')
+	case []interface{}:
+		buf.WriteByte('l')
+		for _, v := range x {
+			if err := encodeTo(buf, v); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('e')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		buf.WriteByte('d')
+		for _, k := range keys {
+			fmt.Fprintf(buf, "%d:%s", len(k), k)
+			if err := encodeTo(buf, x[k]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('e')
+	default:
+		return fmt.Errorf("bencode: can't encode %T", x)
+	}
+	return nil
+}
+
+func decode(r *bufio.Reader) (interface{}, error) {
+	return decodeAt(r, 0)
+}
+
+func decodeAt(r *bufio.Reader, depth int) (interface{}, error) {
+	c, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if (c == 'l' || c == 'd') && depth == maxDepth {
+		return nil, fmt.Errorf("bencode: nesting deeper than %d", maxDepth)
+	}
+	switch {
+	case c == 'i':
+		s, err := readDigits(r, "", 'e')
+		if err != nil {
+			return nil, err
+		}
+		return strconv.ParseInt(s, 10, 64)
+	case c == 'l':
+		xs := []interface{}{}
+		for {
+			if end, err := atEnd(r); err != nil || end {
+				return xs, err
+			}
+			x, err := decodeAt(r, depth+1)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			xs = append(xs, x)
+		}
+	case c == 'd':
+		m := map[string]interface{}{}
+		for {
+			if end, err := atEnd(r); err != nil || end {
+				return m, err
+			}
+			k, err := decodeAt(r, depth+1)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			key, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("bencode: dictionary key must be a string, got %T", k)
+			}
+			if m[key], err = decodeAt(r, depth+1); err != nil {
+				return nil, unexpectedEOF(err)
+			}
+		}
+	case c >= '0' && c <= '9':
+		s, err := readDigits(r, string(c), ':')
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+		if n > maxStringLength {
+			return nil, fmt.Errorf("bencode: string length %d exceeds %d", n, maxStringLength)
+		}
+		// Copied in chunks, so the buffer only grows as the bytes actually arrive.
+		var buf bytes.Buffer
+		if _, err := io.CopyN(&buf, r, int64(n)); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return buf.String(), nil
+	}
+	return nil, fmt.Errorf("bencode: unexpected %q", c)
+}
+
+// Reads the rest of a number starting with prefix up to delim, which is consumed but not returned.
+func readDigits(r *bufio.Reader, prefix string, delim byte) (string, error) {
+	buf := []byte(prefix)
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return "", unexpectedEOF(err)
+		}
+		if c == delim {
+			return string(buf), nil
+		}
+		if len(buf) == maxDigits {
+			return "", fmt.Errorf("bencode: number longer than %d digits", maxDigits)
+		}
+		buf = append(buf, c)
+	}
+}
+
+func atEnd(r *bufio.Reader) (bool, error) {
+	c, err := r.ReadByte()
+	if err != nil {
+		return false, unexpectedEOF(err)
+	}
+	if c == 'e' {
+		return true, nil
+	}
+	return false, r.UnreadByte()
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
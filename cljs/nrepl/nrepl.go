@@ -0,0 +1,488 @@
+// cljs.nrepl
+
+// An nREPL server speaking bencode over TCP, so editors can attach to a running program compiled
+// with cljs2go. It evaluates like cljs.repl, and supports the eval, load-file, describe, clone,
+// close, interrupt and completions ops. Printing via *print-fn* is sent to the client as out
+// messages of the request being evaluated, exceptions as err messages. Nothing else is sent as
+// err, as cljs.core has no separate printer for errors; writes to os.Stderr stay in the process.
+//
+// To embed it, start it from main, or call (cljs.nrepl/start-server 7888):
+//
+//	server, err := nrepl.Start_server_("127.0.0.1:7888")
+package nrepl
+
+import (
+	"bufio"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	"github.com/hraberg/cljs2go/cljs/repl"
+)
+
+type Server struct {
+	listener net.Listener
+	mutex    sync.Mutex
+	sessions map[string]*session
+	conns    map[net.Conn]bool
+}
+
+// Starts an nREPL server listening on addr, like "127.0.0.1:7888". Use port 0 to pick a free port
+// and Addr to find it.
+func Start_server_(addr string) (*Server, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{listener: l, sessions: map[string]*session{}, conns: map[net.Conn]bool{}}
+	go s.accept()
+	return s, nil
+}
+
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Stops accepting connections and closes the open ones. Evaluations in progress run to completion.
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	for id, session := range s.sessions {
+		session.close()
+		delete(s.sessions, id)
+	}
+	return err
+}
+
+func (s *Server) accept() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mutex.Lock()
+		s.conns[conn] = true
+		s.mutex.Unlock()
+		go s.serve(conn)
+	}
+}
+
+func (s *Server) serve(conn net.Conn) {
+	defer func() {
+		s.mutex.Lock()
+		delete(s.conns, conn)
+		s.mutex.Unlock()
+		conn.Close()
+	}()
+	r, t := bufio.NewReader(conn), &transport{conn: conn}
+	for {
+		x, err := decode(r)
+		if err != nil {
+			return
+		}
+		if msg, ok := x.(map[string]interface{}); ok {
+			s.handle(t, msg)
+		}
+	}
+}
+
+type transport struct {
+	mutex sync.Mutex
+	conn  net.Conn
+}
+
+func (t *transport) send(msg map[string]interface{}) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	encode(t.conn, msg)
+}
+
+// Sends a response to the request msg, with its id and session.
+func (t *transport) reply(msg map[string]interface{}, response map[string]interface{}) {
+	for _, k := range []string{"id", "session"} {
+		if v, ok := msg[k]; ok {
+			if _, ok := response[k]; !ok {
+				response[k] = v
+			}
+		}
+	}
+	t.send(response)
+}
+
+var ops = []string{"clone", "close", "completions", "describe", "eval", "interrupt", "load-file"}
+
+func (s *Server) handle(t *transport, msg map[string]interface{}) {
+	op, _ := msg["op"].(string)
+	switch op {
+	case "describe":
+		opsMap := map[string]interface{}{}
+		for _, op := range ops {
+			opsMap[op] = map[string]interface{}{}
+		}
+		t.reply(msg, map[string]interface{}{
+			"ops": opsMap,
+			"versions": map[string]interface{}{
+				"cljs2go": map[string]interface{}{"version-string": "0.0-2411"},
+				"go":      map[string]interface{}{"version-string": runtime.Version()},
+			},
+			"aux":    map[string]interface{}{"current-ns": nsName(s.session(msg).ns())},
+			"status": []string{"done"}})
+	case "clone":
+		clone := newSession(s.session(msg).snapshot())
+		s.mutex.Lock()
+		s.sessions[clone.id] = clone
+		s.mutex.Unlock()
+		t.reply(msg, map[string]interface{}{"new-session": clone.id, "status": []string{"done"}})
+	case "close":
+		id, _ := msg["session"].(string)
+		s.mutex.Lock()
+		session, ok := s.sessions[id]
+		delete(s.sessions, id)
+		s.mutex.Unlock()
+		if !ok {
+			t.reply(msg, map[string]interface{}{"status": []string{"error", "unknown-session", "done"}})
+			return
+		}
+		session.close()
+		t.reply(msg, map[string]interface{}{"status": []string{"session-closed", "done"}})
+	case "eval", "load-file":
+		if session := s.session(msg); !session.enqueue(&task{msg: msg, t: t}) {
+			t.reply(msg, map[string]interface{}{"status": []string{"error", "unknown-session", "done"}})
+		}
+	case "interrupt":
+		id, _ := msg["interrupt-id"].(string)
+		if s.session(msg).interrupt(id) {
+			t.reply(msg, map[string]interface{}{"status": []string{"done"}})
+		} else {
+			t.reply(msg, map[string]interface{}{"status": []string{"session-idle", "done"}})
+		}
+	case "completions":
+		prefix, _ := msg["prefix"].(string)
+		if prefix == "" {
+			prefix, _ = msg["symbol"].(string)
+		}
+		ns := s.session(msg).ns()
+		if name, ok := msg["ns"].(string); ok {
+			if found := cljs_core.Find_ns.X_invoke_Arity1(cljs_core.Symbol.X_invoke_Arity1(name)); found != nil {
+				ns = found
+			}
+		}
+		t.reply(msg, map[string]interface{}{"completions": completions(ns, prefix), "status": []string{"done"}})
+	default:
+		t.reply(msg, map[string]interface{}{"status": []string{"error", "unknown-op", "done"}})
+	}
+}
+
+// Returns the session of msg. Requests without a session, or with one that's been closed, get an
+// ephemeral session that's discarded after use, like in nREPL.
+func (s *Server) session(msg map[string]interface{}) *session {
+	id, _ := msg["session"].(string)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if session, ok := s.sessions[id]; ok {
+		return session
+	}
+	session := newSession(nil)
+	session.close()
+	session.ephemeral = true
+	return session
+}
+
+// The state of a REPL session is its bindings, like *ns* and *1, which are installed in the
+// goroutine evaluating each request and read back when it's done. Requests are evaluated one at a
+// time, in order. The queue is unbounded, so enqueueing never blocks the connection reading them.
+
+type session struct {
+	id        string
+	mutex     sync.Mutex
+	pending   *sync.Cond
+	bindings  interface{}
+	queue     []*task
+	running   *task
+	closed    bool
+	ephemeral bool
+}
+
+type task struct {
+	mutex       sync.Mutex
+	msg         map[string]interface{}
+	t           *transport
+	done        chan struct{}
+	finished    bool
+	interrupted bool
+}
+
+func newSession(bindings interface{}) *session {
+	if bindings == nil {
+		bindings = repl.Bindings_(ioutil.Discard)
+	}
+	s := &session{id: uuid(), bindings: bindings}
+	s.pending = sync.NewCond(&s.mutex)
+	go s.run()
+	return s
+}
+
+func uuid() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6], b[8] = b[6]&0x0f|0x40, b[8]&0x3f|0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func (s *session) snapshot() interface{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.bindings
+}
+
+func (s *session) ns() interface{} {
+	return cljs_core.Get.X_invoke_Arity2(s.snapshot(), cljs_core.Symbol.X_invoke_Arity1("cljs.core/*ns*"))
+}
+
+func (s *session) close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.closed = true
+	s.pending.Broadcast()
+}
+
+func (s *session) enqueue(task *task) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.ephemeral {
+		go s.eval(task)
+		return true
+	}
+	if s.closed {
+		return false
+	}
+	s.queue = append(s.queue, task)
+	s.pending.Signal()
+	return true
+}
+
+// Evaluates the queued tasks until the session is closed and the tasks queued before that are done.
+func (s *session) run() {
+	for {
+		s.mutex.Lock()
+		for len(s.queue) == 0 && !s.closed {
+			s.pending.Wait()
+		}
+		if len(s.queue) == 0 {
+			s.mutex.Unlock()
+			return
+		}
+		task := s.queue[0]
+		s.queue[0] = nil
+		s.queue = s.queue[1:]
+		task.done = make(chan struct{})
+		s.running = task
+		s.mutex.Unlock()
+		go s.eval(task)
+		<-task.done
+		s.mutex.Lock()
+		s.running = nil
+		s.mutex.Unlock()
+	}
+}
+
+// Goroutines can't be stopped from the outside, so an interrupted evaluation is abandoned: it
+// keeps running, but its output is dropped and the session moves on to its next request without
+// the bindings it changed.
+func (s *session) interrupt(id string) bool {
+	s.mutex.Lock()
+	task := s.running
+	s.mutex.Unlock()
+	if task == nil || (id != "" && task.msg["id"] != id) {
+		return false
+	}
+	task.mutex.Lock()
+	defer task.mutex.Unlock()
+	if task.finished || task.interrupted {
+		return false
+	}
+	task.interrupted = true
+	task.t.reply(task.msg, map[string]interface{}{"status": []string{"interrupted", "done"}})
+	close(task.done)
+	return true
+}
+
+func (task *task) reply(response map[string]interface{}) {
+	task.mutex.Lock()
+	defer task.mutex.Unlock()
+	if !task.interrupted {
+		task.t.reply(task.msg, response)
+	}
+}
+
+// Marks the task as done and saves the bindings unless it has been interrupted.
+func (task *task) finish(s *session, bindings interface{}) {
+	task.mutex.Lock()
+	defer task.mutex.Unlock()
+	if task.interrupted {
+		return
+	}
+	task.finished = true
+	s.mutex.Lock()
+	s.bindings = bindings
+	s.mutex.Unlock()
+	task.t.reply(task.msg, map[string]interface{}{"status": []string{"done"}})
+	if task.done != nil {
+		close(task.done)
+	}
+}
+
+func (s *session) eval(task *task) {
+	var code string
+	if task.msg["op"] == "load-file" {
+		code, _ = task.msg["file"].(string)
+	} else {
+		code, _ = task.msg["code"].(string)
+	}
+	bindings := cljs_core.Assoc.X_invoke_Arity3(s.snapshot(), cljs_core.Symbol.X_invoke_Arity1("cljs.core/*print-fn*"), cljs_core.Fn(func(x interface{}) interface{} {
+		task.reply(map[string]interface{}{"out": fmt.Sprintln(x)})
+		return nil
+	}))
+	if name, ok := task.msg["ns"].(string); ok {
+		if ns := cljs_core.Find_ns.X_invoke_Arity1(cljs_core.Symbol.X_invoke_Arity1(name)); ns != nil {
+			bindings = cljs_core.Assoc.X_invoke_Arity3(bindings, cljs_core.Symbol.X_invoke_Arity1("cljs.core/*ns*"), ns)
+		}
+	}
+	cljs_core.Push_thread_bindings.X_invoke_Arity1(bindings)
+	defer cljs_core.Pop_thread_bindings.X_invoke_Arity0()
+
+	forms, err := read(code)
+	if err != nil {
+		task.evalError(err)
+	}
+	for i, form := range forms {
+		value, err := evalForm(form)
+		if err != nil {
+			task.evalError(err)
+			break
+		}
+		if task.msg["op"] == "eval" || i == len(forms)-1 {
+			task.reply(map[string]interface{}{"value": value, "ns": repl.Ns_()})
+		}
+	}
+	task.finish(s, cljs_core.Get_thread_bindings.X_invoke_Arity0())
+}
+
+func (task *task) evalError(err interface{}) {
+	task.reply(map[string]interface{}{"err": repl.Error_string_(err) + "\n"})
+	task.reply(map[string]interface{}{"ex": fmt.Sprintf("%T", err), "status": []string{"eval-error"}})
+}
+
+func read(code string) (forms []interface{}, err interface{}) {
+	defer func() {
+		if x := recover(); x != nil {
+			cljs_core.Set_dynamic_("cljs.core/*e", x)
+			err = x
+		}
+	}()
+	forms, complete := repl.Read_forms_(code)
+	if !complete {
+		return nil, fmt.Errorf("EOF while reading")
+	}
+	return forms, nil
+}
+
+func evalForm(form interface{}) (value string, err interface{}) {
+	defer func() {
+		if x := recover(); x != nil {
+			err = x
+		}
+	}()
+	x := repl.Eval_(form)
+	return cljs_core.Pr_str.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{x})).(string), nil
+}
+
+func nsName(ns interface{}) string {
+	return cljs_core.Ns_name.X_invoke_Arity1(ns).(*cljs_core.CljsCoreSymbol).Str.(string)
+}
+
+func entries(m interface{}, f func(k interface{}, v interface{})) {
+	for s := cljs_core.Seq.X_invoke_Arity1(m); s != nil; s = cljs_core.Next.X_invoke_Arity1(s) {
+		e := cljs_core.First.X_invoke_Arity1(s)
+		f(cljs_core.Key.X_invoke_Arity1(e), cljs_core.Val.X_invoke_Arity1(e))
+	}
+}
+
+// Returns the candidates starting with prefix among the vars visible in ns, the vars of its
+// aliased namespaces, and the names of all namespaces.
+func completions(ns interface{}, prefix string) []interface{} {
+	candidates := map[string]map[string]interface{}{}
+	addVars := func(vars interface{}, qualifier string) {
+		entries(vars, func(sym interface{}, v interface{}) {
+			name := qualifier + sym.(*cljs_core.CljsCoreSymbol).Name.(string)
+			if !strings.HasPrefix(name, prefix) {
+				return
+			}
+			typ := "var"
+			if _, ok := cljs_core.Var_get.X_invoke_Arity1(v).(*cljs_core.AFn); ok {
+				typ = "function"
+			}
+			candidates[name] = map[string]interface{}{"candidate": name, "ns": v.(*cljs_core.CljsCoreVar).Sym.(*cljs_core.CljsCoreSymbol).Ns, "type": typ}
+		})
+	}
+	addVars(cljs_core.Ns_publics.X_invoke_Arity1(cljs_core.Symbol.X_invoke_Arity1("cljs.core")), "")
+	addVars(cljs_core.Ns_refers.X_invoke_Arity1(ns), "")
+	addVars(cljs_core.Ns_interns.X_invoke_Arity1(ns), "")
+	entries(cljs_core.Ns_aliases.X_invoke_Arity1(ns), func(alias interface{}, target interface{}) {
+		addVars(cljs_core.Ns_publics.X_invoke_Arity1(target), alias.(*cljs_core.CljsCoreSymbol).Name.(string)+"/")
+	})
+	for s := cljs_core.Seq.X_invoke_Arity1(cljs_core.All_ns.X_invoke_Arity0()); s != nil; s = cljs_core.Next.X_invoke_Arity1(s) {
+		name := nsName(cljs_core.First.X_invoke_Arity1(s))
+		if strings.HasPrefix(name, prefix) {
+			candidates[name] = map[string]interface{}{"candidate": name, "type": "namespace"}
+		} else if strings.HasPrefix(prefix, name+"/") {
+			addVars(cljs_core.Ns_publics.X_invoke_Arity1(cljs_core.First.X_invoke_Arity1(s)), name+"/")
+		}
+	}
+	names := make([]string, 0, len(candidates))
+	for name := range candidates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result := make([]interface{}, len(names))
+	for i, name := range names {
+		result[i] = candidates[name]
+	}
+	return result
+}
+
+// Starts an nREPL server on localhost, on port or on a free port, and returns it.
+var Start_server = cljs_core.Fn(func() interface{} {
+	return startServer(0.0)
+}, func(port interface{}) interface{} {
+	return startServer(port)
+})
+
+func startServer(port interface{}) *Server {
+	server, err := Start_server_(fmt.Sprintf("127.0.0.1:%d", int(port.(float64))))
+	if err != nil {
+		panic(err)
+	}
+	return server
+}
+
+// Stops the nREPL server.
+var Stop_server = cljs_core.Fn(func(server interface{}) interface{} {
+	server.(*Server).Close()
+	return nil
+})
+
+func init() {
+	cljs_core.Intern_("cljs.nrepl", "start-server", &Start_server, map[string]interface{}{"doc": "Starts an nREPL server on localhost, on port or on a free port, and returns it.", "arglists": [][]string{{}, {"port"}}})
+	cljs_core.Intern_("cljs.nrepl", "stop-server", &Stop_server, map[string]interface{}{"doc": "Stops the nREPL server.", "arglists": [][]string{{"server"}}})
+}
@@ -0,0 +1,191 @@
+package nrepl
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Bencode(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, encode(&buf, map[string]interface{}{"op": "eval", "id": 1, "status": []string{"done"}, "nested": map[string]interface{}{"xs": []interface{}{"λ", -2.0}}}))
+	assert.Equal(t, "d2:idi1e6:nestedd2:xsl2:λi-2eee2:op4:eval6:statusl4:doneee", buf.String())
+
+	x, err := decode(bufio.NewReader(&buf))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"op": "eval", "id": int64(1), "status": []interface{}{"done"}, "nested": map[string]interface{}{"xs": []interface{}{"λ", int64(-2)}}}, x)
+
+	_, err = decode(bufio.NewReader(strings.NewReader("d2:op4:ev")))
+	assert.EqualError(t, err, "unexpected EOF")
+	_, err = decode(bufio.NewReader(strings.NewReader("di1e1:xe")))
+	assert.EqualError(t, err, "bencode: dictionary key must be a string, got int64")
+	_, err = decode(bufio.NewReader(strings.NewReader("99999999999:x")))
+	assert.EqualError(t, err, "bencode: string length 99999999999 exceeds 67108864")
+	_, err = decode(bufio.NewReader(strings.NewReader("i" + strings.Repeat("1", 1000) + "e")))
+	assert.EqualError(t, err, "bencode: number longer than 20 digits")
+	_, err = decode(bufio.NewReader(strings.NewReader(strings.Repeat("1", 1000) + ":x")))
+	assert.EqualError(t, err, "bencode: number longer than 20 digits")
+	_, err = decode(bufio.NewReader(strings.NewReader(strings.Repeat("l", 1000) + strings.Repeat("e", 1000))))
+	assert.EqualError(t, err, "bencode: nesting deeper than 100")
+	x, err = decode(bufio.NewReader(strings.NewReader(strings.Repeat("l", 100) + strings.Repeat("e", 100))))
+	assert.Nil(t, err)
+}
+
+type client struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func connect(t *testing.T, server *Server) *client {
+	conn, err := net.Dial("tcp", server.Addr().String())
+	assert.Nil(t, err)
+	return &client{conn, bufio.NewReader(conn)}
+}
+
+// Sends msg and returns the responses up to and including the one with status done.
+func (c *client) request(msg map[string]interface{}) []map[string]interface{} {
+	encode(c.conn, msg)
+	var responses []map[string]interface{}
+	for {
+		x, err := decode(c.r)
+		if err != nil {
+			panic(err)
+		}
+		response := x.(map[string]interface{})
+		responses = append(responses, response)
+		if status, ok := response["status"].([]interface{}); ok && status[len(status)-1] == "done" {
+			return responses
+		}
+	}
+}
+
+func (c *client) merged(msg map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for _, response := range c.request(msg) {
+		for k, v := range response {
+			if k == "value" || k == "out" || k == "err" {
+				if s, ok := merged[k].(string); ok {
+					v = s + " " + v.(string)
+				}
+			}
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func Test_Server(t *testing.T) {
+	server, err := Start_server_("127.0.0.1:0")
+	assert.Nil(t, err)
+	defer server.Close()
+	c := connect(t, server)
+
+	describe := c.merged(map[string]interface{}{"op": "describe", "id": "1"})
+	assert.Equal(t, "1", describe["id"])
+	_, ok := describe["ops"].(map[string]interface{})["completions"]
+	assert.True(t, ok)
+	assert.Equal(t, "cljs.user", describe["aux"].(map[string]interface{})["current-ns"])
+
+	session := c.merged(map[string]interface{}{"op": "clone", "id": "2"})["new-session"].(string)
+	eval := func(code string) map[string]interface{} {
+		return c.merged(map[string]interface{}{"op": "eval", "id": "3", "session": session, "code": code})
+	}
+
+	response := eval(`(println "hello") (+ 1 2)`)
+	assert.Equal(t, "nil 3", response["value"])
+	assert.Equal(t, "hello\n", response["out"])
+	assert.Equal(t, session, response["session"])
+	assert.Equal(t, "cljs.user", response["ns"])
+	assert.Equal(t, "[3 nil]", eval("[*1 *2]")["value"])
+
+	response = eval(`(throw (ex-info "boom" {:a 1}))`)
+	assert.Equal(t, "boom {:a 1}\n", response["err"])
+	assert.Equal(t, "*core.CljsCoreExceptionInfo", response["ex"])
+	assert.Equal(t, "{:a 1}", eval("(ex-data *e)")["value"])
+	assert.Equal(t, "EOF while reading\n", eval("(+ 1")["err"])
+
+	assert.Equal(t, "#<Namespace foo>", eval("(in-ns 'foo)")["value"])
+	assert.Equal(t, "foo", eval("(def x 42)")["ns"])
+	response = c.merged(map[string]interface{}{"op": "load-file", "session": session, "file": "(ns bar (:require [foo :as f])) (def y (inc f/x)) y", "file-path": "bar.cljs"})
+	assert.Equal(t, "43", response["value"])
+	assert.Equal(t, "bar", response["ns"])
+
+	completions := c.merged(map[string]interface{}{"op": "completions", "session": session, "prefix": "f/"})["completions"]
+	assert.Equal(t, []interface{}{map[string]interface{}{"candidate": "f/x", "ns": "foo", "type": "var"}}, completions)
+	completions = c.merged(map[string]interface{}{"op": "completions", "session": session, "prefix": "mapc"})["completions"]
+	assert.Equal(t, []interface{}{map[string]interface{}{"candidate": "mapcat", "ns": "cljs.core", "type": "function"}}, completions)
+
+	other := c.merged(map[string]interface{}{"op": "clone", "session": session})["new-session"].(string)
+	assert.Equal(t, "bar", c.merged(map[string]interface{}{"op": "eval", "session": other, "code": "(ns-name *ns*)"})["value"])
+	assert.Equal(t, []interface{}{"session-closed", "done"}, c.merged(map[string]interface{}{"op": "close", "session": other})["status"])
+	assert.Equal(t, []interface{}{"error", "unknown-session", "done"}, c.merged(map[string]interface{}{"op": "close", "session": other})["status"])
+	assert.Equal(t, []interface{}{"error", "unknown-op", "done"}, c.merged(map[string]interface{}{"op": "foo"})["status"])
+}
+
+func Test_Interrupt(t *testing.T) {
+	server, err := Start_server_("127.0.0.1:0")
+	assert.Nil(t, err)
+	defer server.Close()
+	c := connect(t, server)
+
+	session := c.merged(map[string]interface{}{"op": "clone"})["new-session"].(string)
+	assert.Equal(t, []interface{}{"session-idle", "done"}, c.merged(map[string]interface{}{"op": "interrupt", "session": session})["status"])
+
+	encode(c.conn, map[string]interface{}{"op": "eval", "id": "blocked", "session": session, "code": "(def p (promise)) (println \"waiting\") @p"})
+	x, _ := decode(c.r)
+	assert.Equal(t, "#'cljs.user/p", x.(map[string]interface{})["value"])
+	x, _ = decode(c.r)
+	assert.Equal(t, "waiting\n", x.(map[string]interface{})["out"])
+	x, _ = decode(c.r)
+	assert.Equal(t, "nil", x.(map[string]interface{})["value"])
+
+	responses := c.request(map[string]interface{}{"op": "interrupt", "id": "interrupt", "session": session, "interrupt-id": "blocked"})
+	assert.Equal(t, map[string]interface{}{"id": "blocked", "session": session, "status": []interface{}{"interrupted", "done"}}, responses[0])
+	x, _ = decode(c.r)
+	assert.Equal(t, map[string]interface{}{"id": "interrupt", "session": session, "status": []interface{}{"done"}}, x)
+	assert.Equal(t, "3", c.merged(map[string]interface{}{"op": "eval", "session": session, "code": "(+ 1 2)"})["value"])
+}
+
+func Test_QueueManyRequests(t *testing.T) {
+	server, err := Start_server_("127.0.0.1:0")
+	assert.Nil(t, err)
+	defer server.Close()
+	c := connect(t, server)
+	c.conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	session := c.merged(map[string]interface{}{"op": "clone"})["new-session"].(string)
+	encode(c.conn, map[string]interface{}{"op": "eval", "id": "blocked", "session": session, "code": "(def p (promise)) @p"})
+	x, _ := decode(c.r)
+	assert.Equal(t, "#'cljs.user/p", x.(map[string]interface{})["value"])
+
+	const n = 32
+	for i := 0; i < n; i++ {
+		encode(c.conn, map[string]interface{}{"op": "eval", "id": i, "session": session, "code": fmt.Sprintf("(+ %d 1)", i)})
+	}
+	encode(c.conn, map[string]interface{}{"op": "interrupt", "id": "interrupt", "session": session, "interrupt-id": "blocked"})
+
+	values := map[int64]string{}
+	for done := 0; done < n+2; {
+		x, err := decode(c.r)
+		assert.Nil(t, err)
+		if err != nil {
+			return
+		}
+		response := x.(map[string]interface{})
+		if id, ok := response["id"].(int64); ok && response["value"] != nil {
+			values[id] = response["value"].(string)
+		}
+		if status, ok := response["status"].([]interface{}); ok && status[len(status)-1] == "done" {
+			done++
+		}
+	}
+	for i := 0; i < n; i++ {
+		assert.Equal(t, fmt.Sprint(i+1), values[int64(i)])
+	}
+}
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // cljs.ns-test.bar
 
 package bar
@@ -12,6 +13,7 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("cljs.ns-test.bar", "quux", &Quux, map[string]interface{}{"arglists": [][]string{{}}})
 }
 
 var Quux *cljs_core.AFn
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // cljs.ns-test.foo
 
 package foo
@@ -29,6 +30,9 @@ func init() {
 	} else {
 		panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= (str qkw) \":cljs.ns-test.foo/foo\")").(string)}, ``)}))
 	}
+	cljs_core.Intern_("cljs.ns-test.foo", "baz", &Baz, map[string]interface{}{"arglists": [][]string{{}}})
+	cljs_core.Intern_("cljs.ns-test.foo", "kw", &Kw, map[string]interface{}{})
+	cljs_core.Intern_("cljs.ns-test.foo", "qkw", &Qkw, map[string]interface{}{})
 }
 
 var Baz *cljs_core.AFn
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // cljs.ns-test
 
 package ns_test
@@ -128,6 +129,8 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("cljs.ns-test", "+", &X_PLUS_, map[string]interface{}{})
+	cljs_core.Intern_("cljs.ns-test", "test-ns", &Test_ns, map[string]interface{}{"arglists": [][]string{{}}})
 }
 
 var X_PLUS_ interface{}
@@ -0,0 +1,55 @@
+// cljs.plugin
+
+// Loads compiled namespaces into a running program from Go plugins, see
+// https://golang.org/pkg/plugin/. A plugin is a main package importing the packages of the
+// namespaces for their side effects. When the plugin is opened, its namespaces register their vars
+// as they're initialized.
+//
+// Namespaces meant to be reloaded are compiled in var indirection mode, with ^:go-var-indirection on
+// the ns, see Var_ in cljs.core. A new build of such a namespace gets the vars of the old one and
+// sets their roots, which all callers read through the vars, also code linked against the old build.
+// Loading a new build of any other namespace only replaces its vars, so find-var and resolve see the
+// new build, but code linked against the old build keeps calling the old one.
+//
+// Go loads a package only once per process, so each build of a namespace to be reloaded needs its
+// own import path and plugin file. To reload greeter.cljs, compile it into a new, existing directory
+// on the GOPATH, add a main package importing it, and build and load the plugin:
+//
+//	(cljs.go/compile-file (str (System/getenv "GOPATH") "/src/app/plugins/v2") "src/greeter.cljs")
+//
+//	// $GOPATH/src/app/plugins/v2/main.go
+//	package main
+//
+//	import _ "app/plugins/v2/greeter"
+//
+//	go build -buildmode=plugin -o greeter_v2.so ./plugins/v2
+//
+//	(cljs.plugin/load "greeter_v2.so")
+//
+// The plugin must be built against the same versions of the packages it shares with the program.
+package plugin
+
+import (
+	"plugin"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+)
+
+// Opens the Go plugin at path. Fails if the plugin was built against other versions of the packages
+// in the program, or if it contains a build of a namespace package that's already loaded.
+func Load_(path string) error {
+	_, err := plugin.Open(path)
+	return err
+}
+
+// Opens the Go plugin at path, loading the namespaces compiled into it.
+var Load = cljs_core.Fn(func(path interface{}) interface{} {
+	if err := Load_(path.(string)); err != nil {
+		panic(err)
+	}
+	return nil
+})
+
+func init() {
+	cljs_core.Intern_("cljs.plugin", "load", &Load, map[string]interface{}{"doc": "Opens the Go plugin at path, loading the namespaces compiled into it.", "arglists": [][]string{{"path"}}})
+}
@@ -0,0 +1,14 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LoadMissing(t *testing.T) {
+	assert.Contains(t, Load_("missing.so").Error(), "missing.so")
+	assert.Panics(t, func() {
+		Load.X_invoke_Arity1("missing.so")
+	})
+}
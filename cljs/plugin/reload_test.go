@@ -0,0 +1,102 @@
+//go:build plugin && linux
+
+// Builds Go plugins, run with go test -tags plugin.
+
+package plugin
+
+import (
+	"go/build"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	goplugin "plugin"
+	"strings"
+	"testing"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	"github.com/stretchr/testify/assert"
+)
+
+const greeter = `// Compiled by ClojureScript to Go 0.0-2411
+// plugin-test.greeter
+
+package greeter
+
+import cljs_core "github.com/hraberg/cljs2go/cljs/core"
+
+func init() {
+	cljs_core.Set_var_root_(Greet, func(greet *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(greet, 1, func(name interface{}) interface{} {
+			return "VERSION " + name.(string)
+		})
+	}(&cljs_core.AFn{}))
+
+	cljs_core.Intern_("plugin-test.greeter", "greet", Greet, map[string]interface{}{"doc": "VERSION", "arglists": [][]string{{"name"}}})
+}
+
+// VERSION
+var Greet = cljs_core.Var_("plugin-test.greeter", "greet")
+`
+
+// Exports a caller linked against this build of the namespace.
+const main = `package main
+
+import (
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	"plugin_test/VERSION/greeter"
+)
+
+func Greet(name string) interface{} {
+	return cljs_core.Var_root_(greeter.Greet).(*cljs_core.AFn).X_invoke_Arity1(name)
+}
+`
+
+func buildPlugin(t *testing.T, gopath string, version string) string {
+	dir := filepath.Join(gopath, "src", "plugin_test", version)
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "greeter"), 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "greeter", "greeter.go"), []byte(strings.Replace(greeter, "VERSION", version, -1)), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte(strings.Replace(main, "VERSION", version, -1)), 0644))
+	so := filepath.Join(gopath, version+".so")
+	cmd := exec.Command("go", "build", "-buildmode=plugin", "-o", so, ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOPATH="+gopath+string(filepath.ListSeparator)+build.Default.GOPATH)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatal(string(out))
+	}
+	return so
+}
+
+func greetVar() interface{} {
+	return cljs_core.Find_var.X_invoke_Arity1(cljs_core.Symbol.X_invoke_Arity2("plugin-test.greeter", "greet"))
+}
+
+func greet(name string) interface{} {
+	return cljs_core.Deref.X_invoke_Arity1(greetVar()).(*cljs_core.AFn).X_invoke_Arity1(name)
+}
+
+func Test_LoadAndReload(t *testing.T) {
+	gopath, err := ioutil.TempDir("", "plugin_test")
+	assert.Nil(t, err)
+	defer os.RemoveAll(gopath)
+
+	v1 := buildPlugin(t, gopath, "v1")
+	assert.Nil(t, Load_(v1))
+	assert.Equal(t, "v1 world", greet("world"))
+	p, _ := goplugin.Open(v1)
+	linked, _ := p.Lookup("Greet")
+	assert.Equal(t, "v1 world", linked.(func(string) interface{})("world"))
+
+	assert.Nil(t, Load.X_invoke_Arity1(buildPlugin(t, gopath, "v2")))
+	assert.Equal(t, "v2 world", greet("world"))
+	assert.Equal(t, "v2 world", linked.(func(string) interface{})("world"))
+	assert.Equal(t, "v2", cljs_core.Get.X_invoke_Arity2(cljs_core.Meta.X_invoke_Arity1(greetVar()), cljs_core.Keyword.X_invoke_Arity1("doc")))
+
+	cljs_core.Alter_var_root.X_invoke_Arity2(greetVar(), cljs_core.Fn(func(f interface{}) interface{} {
+		return cljs_core.Fn(func(name interface{}) interface{} {
+			return "altered " + f.(*cljs_core.AFn).X_invoke_Arity1(name).(string)
+		})
+	}))
+	assert.Equal(t, "altered v2 world", greet("world"))
+	assert.Equal(t, "altered v2 world", linked.(func(string) interface{})("world"))
+}
@@ -0,0 +1,22 @@
+package reader
+
+import (
+	"time"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+)
+
+// Returns the UTC time.Time of the fields returned by parse-and-validate-timestamp. The fraction
+// is in nanoseconds and the offset in minutes, so nothing is lost to the millisecond precision of
+// a JavaScript Date.
+var Timestamp__GT_time = cljs_core.Fn(func(fields interface{}) interface{} {
+	field := func(n float64) int {
+		return int(cljs_core.Nth.X_invoke_Arity2(fields, n).(float64))
+	}
+	offset := time.Duration(field(7)) * time.Minute
+	return time.Date(field(0), time.Month(field(1)), field(2), field(3), field(4), field(5), field(6), time.UTC).Add(-offset)
+})
+
+func init() {
+	cljs_core.Intern_("cljs.reader", "timestamp->time", &Timestamp__GT_time, map[string]interface{}{"doc": "Returns the UTC time.Time of the fields returned by parse-and-validate-timestamp.", "arglists": [][]string{{"fields"}}})
+}
@@ -0,0 +1,36 @@
+package reader
+
+import (
+	"testing"
+	"time"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	"github.com/hraberg/cljs2go/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InstNanosecondsAndOffsets(t *testing.T) {
+	inst := readString(`#inst "2024-03-01T12:34:56.123456789+02:00"`)
+	assert.Equal(t, `#inst "2024-03-01T10:34:56.123456789-00:00"`, prStr(inst))
+	assert.Equal(t, 123456789.0, inst.(*js.Date).GetNanoseconds())
+	assert.True(t, cljs_core.X_EQ_.X_invoke_Arity2(inst, readString(prStr(inst))).(bool))
+
+	for in, out := range map[string]string{
+		"2024-03-01T12:34:56.1Z":           "2024-03-01T12:34:56.100-00:00",
+		"2024-03-01T12:34:56.000001-00:30": "2024-03-01T13:04:56.000001000-00:00",
+		"2024-03-01T12:34:56-05:00":        "2024-03-01T17:34:56.000-00:00",
+		"2024-03-01":                       "2024-03-01T00:00:00.000-00:00",
+		"1969-12-31T23:59:59.999999999Z":   "1969-12-31T23:59:59.999999999-00:00",
+	} {
+		assert.Equal(t, `#inst "`+out+`"`, prStr(readString(`#inst "`+in+`"`)))
+	}
+	assert.Panics(t, func() { readString(`#inst "2024-02-30"`) })
+	assert.Panics(t, func() { readString(`#inst 2024`) })
+
+	old := Register_tag_parser_BANG_.X_invoke_Arity2("inst", Read_instant_time)
+	defer Register_tag_parser_BANG_.X_invoke_Arity2("inst", old)
+	tm := readString(`#inst "2024-03-01T12:34:56.123456789+02:00"`)
+	assert.Equal(t, time.Date(2024, 3, 1, 10, 34, 56, 123456789, time.UTC), tm)
+	assert.Equal(t, prStr(inst), prStr(tm))
+	assert.Equal(t, tm, readString(prStr(tm)))
+}
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// This file was written by hand, in the form the compiler emits, from src/cljs/reader/overrides.cljs. Regenerate it with lein compile-clojurescript.
 // cljs.reader
 
 // Go overrides.
@@ -14,34 +15,1039 @@ import (
 )
 
 func init() {
+	X_STAR_read_cond_STAR_ = nil
+
+	X_STAR_features_STAR_ = (&cljs_core.CljsCorePersistentHashSet{nil, &cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{(&cljs_core.CljsCoreKeyword{Ns: nil, Name: "go", Fqn: "go", X_hash: float64(-146946655)}), nil}, nil}, nil})
+
+	X_STAR_suppress_read_STAR_ = false
+
+	X__GT_ReaderConditional = func(__GT_ReaderConditional *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(__GT_ReaderConditional, 2, func(form interface{}, splicing_QMARK_ interface{}) interface{} {
+			return (&CljsReaderReaderConditional{form, splicing_QMARK_})
+		})
+	}(&cljs_core.AFn{})
+
+	Reader_conditional = func(reader_conditional *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(reader_conditional, 2, func(form interface{}, splicing_QMARK_ interface{}) interface{} {
+			return (&CljsReaderReaderConditional{form, splicing_QMARK_})
+		})
+	}(&cljs_core.AFn{})
+
+	Reader_conditional_QMARK_ = func(reader_conditional_QMARK_ *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(reader_conditional_QMARK_, 1, func(x interface{}) bool {
+			return cljs_core.Value_(x).Type().AssignableTo(reflect.TypeOf((**CljsReaderReaderConditional)(nil)).Elem())
+		})
+	}(&cljs_core.AFn{})
+
+	X__GT_Splice = func(__GT_Splice *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(__GT_Splice, 1, func(forms interface{}) interface{} {
+			return (&CljsReaderSplice{forms})
+		})
+	}(&cljs_core.AFn{})
+
+	Get_line_number = func(get_line_number *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(get_line_number, 1, func(reader interface{}) interface{} {
+			return cljs_core.Decorate_(reader).(CljsReaderIndexingReader).Get_line_number_Arity1()
+		})
+	}(&cljs_core.AFn{})
+
+	Get_column_number = func(get_column_number *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(get_column_number, 1, func(reader interface{}) interface{} {
+			return cljs_core.Decorate_(reader).(CljsReaderIndexingReader).Get_column_number_Arity1()
+		})
+	}(&cljs_core.AFn{})
+
+	Get_file_name = func(get_file_name *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(get_file_name, 1, func(reader interface{}) interface{} {
+			return cljs_core.Decorate_(reader).(CljsReaderIndexingReader).Get_file_name_Arity1()
+		})
+	}(&cljs_core.AFn{})
+
+	X__GT_IndexingPushbackReader = func(__GT_IndexingPushbackReader *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(__GT_IndexingPushbackReader, 7, func(rdr interface{}, line interface{}, column interface{}, line_start_QMARK_ interface{}, prev interface{}, prev_column interface{}, file_name interface{}) interface{} {
+			return (&CljsReaderIndexingPushbackReader{rdr, line, column, line_start_QMARK_, prev, prev_column, file_name})
+		})
+	}(&cljs_core.AFn{})
+
+	Indexing_push_back_reader = func(indexing_push_back_reader *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(indexing_push_back_reader, 2, func(s interface{}) interface{} {
+			return indexing_push_back_reader.X_invoke_Arity2(s, nil)
+		}, func(s interface{}, file_name interface{}) interface{} {
+			return (&CljsReaderIndexingPushbackReader{func() interface{} {
+				if cljs_core.Value_(s).Kind() == reflect.String {
+					return Push_back_reader.X_invoke_Arity1(s).(*CljsReaderStringPushbackReader)
+				} else {
+					if cljs_core.DecoratedValue_(s).Type().Implements(reflect.TypeOf((*CljsReaderPushbackReader)(nil)).Elem()) {
+						return s
+					} else {
+						return Io_push_back_reader.X_invoke_Arity1(s)
+					}
+				}
+			}(), float64(1), float64(1), true, nil, float64(0), file_name})
+		})
+	}(&cljs_core.AFn{})
+
+	Start_position = func(start_position *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(start_position, 2, func(rdr interface{}) interface{} {
+			return start_position.X_invoke_Arity2(rdr, float64(1))
+		}, func(rdr interface{}, n interface{}) interface{} {
+			if cljs_core.DecoratedValue_(rdr).Type().Implements(reflect.TypeOf((*CljsReaderIndexingReader)(nil)).Elem()) {
+				return (&cljs_core.CljsCorePersistentVector{nil, float64(2), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{cljs_core.Decorate_(rdr).(CljsReaderIndexingReader).Get_line_number_Arity1(), (cljs_core.Decorate_(rdr).(CljsReaderIndexingReader).Get_column_number_Arity1().(float64) - n.(float64))}, nil})
+			} else {
+				return nil
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	With_position = func(with_position *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(with_position, 3, func(rdr interface{}, start interface{}, o interface{}) interface{} {
+			if cljs_core.Truth_(start) {
+				{
+					var m = (&cljs_core.CljsCorePersistentArrayMap{nil, float64(4), []interface{}{(&cljs_core.CljsCoreKeyword{Ns: nil, Name: "line", Fqn: "line", X_hash: float64(212345235)}), cljs_core.Nth.X_invoke_Arity2(start, float64(0)), (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "column", Fqn: "column", X_hash: float64(2078222095)}), cljs_core.Nth.X_invoke_Arity2(start, float64(1)), (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "end-line", Fqn: "end-line", X_hash: float64(1837326455)}), cljs_core.Decorate_(rdr).(CljsReaderIndexingReader).Get_line_number_Arity1(), (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "end-column", Fqn: "end-column", X_hash: float64(1425389514)}), cljs_core.Decorate_(rdr).(CljsReaderIndexingReader).Get_column_number_Arity1()}, nil})
+					_ = m
+					return cljs_core.With_meta.X_invoke_Arity2(o, func() interface{} {
+						var temp__4386__auto__ = cljs_core.Decorate_(rdr).(CljsReaderIndexingReader).Get_file_name_Arity1()
+						_ = temp__4386__auto__
+						if cljs_core.Truth_(temp__4386__auto__) {
+							{
+								var file = temp__4386__auto__
+								_ = file
+								return cljs_core.Assoc.X_invoke_Arity3(m, (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "file", Fqn: "file", X_hash: float64(-1269645878)}), file)
+							}
+						} else {
+							return m
+						}
+					}())
+				}
+			} else {
+				return o
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Macro_terminating_QMARK_ = func(macro_terminating_QMARK_ *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(macro_terminating_QMARK_, 1, func(ch interface{}) bool {
+			{
+				var and__159__auto__ = !(reflect.DeepEqual(ch, "#"))
+				_ = and__159__auto__
+				if cljs_core.Truth_(and__159__auto__) {
+					{
+						var and__159__auto_____1 = !(reflect.DeepEqual(ch, "'"))
+						_ = and__159__auto_____1
+						if cljs_core.Truth_(and__159__auto_____1) {
+							{
+								var and__159__auto_____2 = !(reflect.DeepEqual(ch, ":"))
+								_ = and__159__auto_____2
+								if cljs_core.Truth_(and__159__auto_____2) {
+									{
+										var and__159__auto_____3 = !(reflect.DeepEqual(ch, "%"))
+										_ = and__159__auto_____3
+										if cljs_core.Truth_(and__159__auto_____3) {
+											{
+												var G__14 = ch
+												_ = G__14
+												return cljs_core.Truth_(Macros.X_invoke_Arity1(G__14))
+											}
+										} else {
+											return and__159__auto_____3
+										}
+									}
+								} else {
+									return and__159__auto_____2
+								}
+							}
+						} else {
+							return and__159__auto_____1
+						}
+					}
+				} else {
+					return and__159__auto__
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Reader_error = func(reader_error *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(reader_error, 1, func(rdr_msg__ ...interface{}) interface{} {
+			var rdr = rdr_msg__[0]
+			var msg = cljs_core.Seq.Arity1IQ(rdr_msg__[1])
+			_, _ = rdr, msg
+			{
+				var msg___1 = cljs_core.Apply.X_invoke_Arity2(cljs_core.Str, msg)
+				_ = msg___1
+				panic((&js.Error{func() interface{} {
+					if cljs_core.DecoratedValue_(rdr).Type().Implements(reflect.TypeOf((*CljsReaderIndexingReader)(nil)).Elem()) {
+						return strings.Join([]string{cljs_core.Str.X_invoke_Arity1(msg___1).(string), cljs_core.Str.X_invoke_Arity1(" at line ").(string), cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(rdr).(CljsReaderIndexingReader).Get_line_number_Arity1()).(string), cljs_core.Str.X_invoke_Arity1(", column ").(string), cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(rdr).(CljsReaderIndexingReader).Get_column_number_Arity1()).(string), cljs_core.Str.X_invoke_Arity1(func() interface{} {
+							if cljs_core.Truth_(cljs_core.Decorate_(rdr).(CljsReaderIndexingReader).Get_file_name_Arity1()) {
+								return strings.Join([]string{cljs_core.Str.X_invoke_Arity1(" in ").(string), cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(rdr).(CljsReaderIndexingReader).Get_file_name_Arity1()).(string)}, ``)
+							} else {
+								return nil
+							}
+						}()).(string)}, ``)
+					} else {
+						return msg___1
+					}
+				}()}))
+			}
+		})
+	}(&cljs_core.AFn{})
+
 	Read_2_chars = func(read_2_chars *cljs_core.AFn) *cljs_core.AFn {
 		return cljs_core.Fn(read_2_chars, 1, func(reader interface{}) interface{} {
 			return strings.Join([]string{cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(reader).(CljsReaderPushbackReader).Read_char_Arity1()).(string), cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(reader).(CljsReaderPushbackReader).Read_char_Arity1()).(string)}, ``)
 		})
 	}(&cljs_core.AFn{})
 
-	Read_4_chars = func(read_4_chars *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(read_4_chars, 1, func(reader interface{}) interface{} {
-			return strings.Join([]string{cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(reader).(CljsReaderPushbackReader).Read_char_Arity1()).(string), cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(reader).(CljsReaderPushbackReader).Read_char_Arity1()).(string), cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(reader).(CljsReaderPushbackReader).Read_char_Arity1()).(string), cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(reader).(CljsReaderPushbackReader).Read_char_Arity1()).(string)}, ``)
+	Read_4_chars = func(read_4_chars *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_4_chars, 1, func(reader interface{}) interface{} {
+			return strings.Join([]string{cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(reader).(CljsReaderPushbackReader).Read_char_Arity1()).(string), cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(reader).(CljsReaderPushbackReader).Read_char_Arity1()).(string), cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(reader).(CljsReaderPushbackReader).Read_char_Arity1()).(string), cljs_core.Str.X_invoke_Arity1(cljs_core.Decorate_(reader).(CljsReaderPushbackReader).Read_char_Arity1()).(string)}, ``)
+		})
+	}(&cljs_core.AFn{})
+
+	Read_token = func(read_token *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_token, 2, func(rdr interface{}, initch interface{}) interface{} {
+			{
+				var sb = (&goog_string.StringBuffer{initch})
+				var ch interface{} = cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Read_char_Arity1()
+				_, _ = sb, ch
+				for {
+					if (cljs_core.Nil_(ch)) || (Whitespace_QMARK_.Arity1IB(ch)) || (Macro_terminating_QMARK_.Arity1IB(ch)) {
+						cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Unread_Arity2(ch)
+						return cljs_core.Native_invoke_instance_method.X_invoke_Arity3(sb, "ToString", []interface{}{})
+					} else {
+						sb, ch = func() *goog_string.StringBuffer {
+							cljs_core.Native_invoke_instance_method.X_invoke_Arity3(sb, "Append", []interface{}{ch})
+							return sb
+						}(), cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Read_char_Arity1()
+						continue
+					}
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_symbol = func(read_symbol *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_symbol, 2, func(reader interface{}, initch interface{}) interface{} {
+			{
+				var start = Start_position.X_invoke_Arity1(reader)
+				var token = Read_token.X_invoke_Arity2(reader, initch)
+				var o = func() interface{} {
+					if cljs_core.Truth_(func() interface{} {
+						var and__159__auto__ = !(reflect.DeepEqual(token, "/"))
+						_ = and__159__auto__
+						if and__159__auto__ {
+							{
+								var G__75 = token
+								var G__76 = "/"
+								_, _ = G__75, G__76
+								return cljs_core.Native_invoke_func.X_invoke_Arity2(goog_string.Contains, []interface{}{G__75, G__76})
+							}
+						} else {
+							return and__159__auto__
+						}
+					}()) {
+						return cljs_core.Symbol.X_invoke_Arity2(cljs_core.Subs.X_invoke_Arity3(token, float64(0), cljs_core.Native_invoke_instance_method.X_invoke_Arity3(token, "IndexOf", []interface{}{"/"})), cljs_core.Subs.X_invoke_Arity3(token, (cljs_core.Native_invoke_instance_method.X_invoke_Arity3(token, "IndexOf", []interface{}{"/"}).(float64)+float64(1)), cljs_core.Native_get_instance_field.X_invoke_Arity2(token, "Length"))).(*cljs_core.CljsCoreSymbol)
+					} else {
+						return Special_symbols.X_invoke_Arity2(token, cljs_core.Symbol.X_invoke_Arity1(token))
+					}
+				}()
+				_, _, _ = start, token, o
+				if cljs_core.Value_(o).Type().AssignableTo(reflect.TypeOf((**cljs_core.CljsCoreSymbol)(nil)).Elem()) {
+					return With_position.X_invoke_Arity3(reader, start, o)
+				} else {
+					return o
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_list = func(read_list *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_list, 2, func(rdr interface{}, ___ interface{}) interface{} {
+			{
+				var start = Start_position.X_invoke_Arity1(rdr)
+				_ = start
+				return With_position.X_invoke_Arity3(rdr, start, cljs_core.Apply.X_invoke_Arity2(cljs_core.List, Read_delimited_list.X_invoke_Arity3(")", rdr, true)))
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_vector = func(read_vector *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_vector, 2, func(rdr interface{}, ___ interface{}) interface{} {
+			{
+				var start = Start_position.X_invoke_Arity1(rdr)
+				_ = start
+				return With_position.X_invoke_Arity3(rdr, start, Read_delimited_list.X_invoke_Arity3("]", rdr, true))
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_map = func(read_map *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_map, 2, func(rdr interface{}, ___ interface{}) interface{} {
+			{
+				var start = Start_position.X_invoke_Arity1(rdr)
+				var l = Read_delimited_list.X_invoke_Arity3("}", rdr, true)
+				_, _ = start, l
+				if cljs_core.Odd_QMARK_.Arity1IB(cljs_core.Count.X_invoke_Arity1(l).(float64)) {
+					Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Map literal must contain an even number of forms"}))
+				} else {
+				}
+				return With_position.X_invoke_Arity3(rdr, start, cljs_core.Apply.X_invoke_Arity2(cljs_core.Hash_map, l))
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_set = func(read_set *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_set, 2, func(rdr interface{}, ___ interface{}) interface{} {
+			{
+				var start = Start_position.X_invoke_Arity2(rdr, float64(2))
+				_ = start
+				return With_position.X_invoke_Arity3(rdr, start, cljs_core.Set.X_invoke_Arity1(Read_delimited_list.X_invoke_Arity3("}", rdr, true)))
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_unquote = func(read_unquote *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_unquote, 2, func(rdr interface{}, ___ interface{}) interface{} {
+			{
+				var ch = cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Read_char_Arity1()
+				_ = ch
+				if reflect.DeepEqual(ch, "@") {
+					return cljs_core.Decorate_(cljs_core.CljsCoreList_EMPTY.X_conj_Arity2(Read.X_invoke_Arity4(rdr, true, nil, true))).(cljs_core.CljsCoreICollection).X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: "clojure.core", Name: "unquote-splicing", Str: "clojure.core/unquote-splicing", X_hash: float64(-552003150), X_meta: nil}))
+				} else {
+					cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Unread_Arity2(ch)
+					return cljs_core.Decorate_(cljs_core.CljsCoreList_EMPTY.X_conj_Arity2(Read.X_invoke_Arity4(rdr, true, nil, true))).(cljs_core.CljsCoreICollection).X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: "clojure.core", Name: "unquote", Str: "clojure.core/unquote", X_hash: float64(843087510), X_meta: nil}))
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Unquote_QMARK_ = func(unquote_QMARK_ *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(unquote_QMARK_, 1, func(form interface{}) bool {
+			{
+				var and__159__auto__ = cljs_core.Seq_QMARK_.Arity1IB(form)
+				_ = and__159__auto__
+				if and__159__auto__ {
+					return cljs_core.X_EQ_.Arity2IIB(cljs_core.First.X_invoke_Arity1(form), (&cljs_core.CljsCoreSymbol{Ns: "clojure.core", Name: "unquote", Str: "clojure.core/unquote", X_hash: float64(843087510), X_meta: nil}))
+				} else {
+					return and__159__auto__
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Unquote_splicing_QMARK_ = func(unquote_splicing_QMARK_ *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(unquote_splicing_QMARK_, 1, func(form interface{}) bool {
+			{
+				var and__159__auto__ = cljs_core.Seq_QMARK_.Arity1IB(form)
+				_ = and__159__auto__
+				if and__159__auto__ {
+					return cljs_core.X_EQ_.Arity2IIB(cljs_core.First.X_invoke_Arity1(form), (&cljs_core.CljsCoreSymbol{Ns: "clojure.core", Name: "unquote-splicing", Str: "clojure.core/unquote-splicing", X_hash: float64(-552003150), X_meta: nil}))
+				} else {
+					return and__159__auto__
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Syntax_quote_coll = func(syntax_quote_coll *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(syntax_quote_coll, 3, func(env interface{}, f interface{}, coll interface{}) interface{} {
+			{
+				var res = cljs_core.Decorate_(cljs_core.CljsCoreList_EMPTY.X_conj_Arity2(cljs_core.Cons.X_invoke_Arity2((&cljs_core.CljsCoreSymbol{Ns: "cljs.core", Name: "concat", Str: "cljs.core/concat", X_hash: float64(-1133584918), X_meta: nil}), cljs_core.Mapv.X_invoke_Arity2(func(G__135 *cljs_core.AFn) *cljs_core.AFn {
+					return cljs_core.Fn(G__135, 1, func(item interface{}) interface{} {
+						if Unquote_QMARK_.Arity1IB(item) {
+							return cljs_core.Decorate_(cljs_core.CljsCoreList_EMPTY.X_conj_Arity2(cljs_core.Second.X_invoke_Arity1(item))).(cljs_core.CljsCoreICollection).X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: "cljs.core", Name: "list", Str: "cljs.core/list", X_hash: float64(-1331406371), X_meta: nil}))
+						} else {
+							if Unquote_splicing_QMARK_.Arity1IB(item) {
+								return cljs_core.Second.X_invoke_Arity1(item)
+							} else {
+								return cljs_core.Decorate_(cljs_core.CljsCoreList_EMPTY.X_conj_Arity2(Syntax_quote_STAR_.X_invoke_Arity2(env, item))).(cljs_core.CljsCoreICollection).X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: "cljs.core", Name: "list", Str: "cljs.core/list", X_hash: float64(-1331406371), X_meta: nil}))
+							}
+						}
+					})
+				}(&cljs_core.AFn{}), coll)))).(cljs_core.CljsCoreICollection).X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: "cljs.core", Name: "seq", Str: "cljs.core/seq", X_hash: float64(-1649497689), X_meta: nil}))
+				_ = res
+				if cljs_core.Truth_(f) {
+					return cljs_core.Decorate_(cljs_core.Decorate_(cljs_core.CljsCoreList_EMPTY.X_conj_Arity2(res)).(cljs_core.CljsCoreICollection).X_conj_Arity2(f)).(cljs_core.CljsCoreICollection).X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: "cljs.core", Name: "apply", Str: "cljs.core/apply", X_hash: float64(1757277831), X_meta: nil}))
+				} else {
+					return res
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Core_macros = (&cljs_core.CljsCorePersistentHashSet{nil, &cljs_core.CljsCorePersistentArrayMap{nil, float64(71), []interface{}{(&cljs_core.CljsCoreSymbol{Ns: nil, Name: "->", Str: "->", X_hash: float64(-2.13960543e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "->>", Str: "->>", X_hash: float64(-1.874332161e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "..", Str: "..", X_hash: float64(-3.0050742e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "amap", Str: "amap", X_hash: float64(6.35923055e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "and", Str: "and", X_hash: float64(6.6863171e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "areduce", Str: "areduce", X_hash: float64(-1.873477878e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "as->", Str: "as->", X_hash: float64(1.43069054e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "assert", Str: "assert", X_hash: float64(6.77428501e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "binding", Str: "binding", X_hash: float64(-2.114503176e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "boolean?", Str: "boolean?", X_hash: float64(1.790940868e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "bound-fn", Str: "bound-fn", X_hash: float64(1.25411266e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "case", Str: "case", X_hash: float64(-1.510733573e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "clojurescript-version", Str: "clojurescript-version", X_hash: float64(-5.46383895e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "comment", Str: "comment", X_hash: float64(-2.1222297e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "cond", Str: "cond", X_hash: float64(1.606708055e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "cond->", Str: "cond->", X_hash: float64(5.61741875e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "cond->>", Str: "cond->>", X_hash: float64(3.4884496e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "condp", Str: "condp", X_hash: float64(1.054325175e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "declare", Str: "declare", X_hash: float64(6.54042991e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "defmethod", Str: "defmethod", X_hash: float64(-5.79171823e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "defmulti", Str: "defmulti", X_hash: float64(1.936112154e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "defn", Str: "defn", X_hash: float64(-1.26010802e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "defn-", Str: "defn-", X_hash: float64(1.097765044e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "defonce", Str: "defonce", X_hash: float64(-1.681484013e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "defprotocol", Str: "defprotocol", X_hash: float64(1.388695348e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "defrecord", Str: "defrecord", X_hash: float64(2.73038109e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "deftype", Str: "deftype", X_hash: float64(1.980826088e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "delay", Str: "delay", X_hash: float64(1.066306308e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "doseq", Str: "doseq", X_hash: float64(2.21164135e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "dosync", Str: "dosync", X_hash: float64(3.36328035e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "dotimes", Str: "dotimes", X_hash: float64(-8.18708397e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "doto", Str: "doto", X_hash: float64(1.252536074e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "exists?", Str: "exists?", X_hash: float64(-1.239962053e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "extend-protocol", Str: "extend-protocol", X_hash: float64(3.06378578e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "extend-type", Str: "extend-type", X_hash: float64(1.123355921e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "fn", Str: "fn", X_hash: float64(4.65265323e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "for", Str: "for", X_hash: float64(3.16745208e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "future", Str: "future", X_hash: float64(-7.76593045e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "if-let", Str: "if-let", X_hash: float64(1.80359369e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "if-not", Str: "if-not", X_hash: float64(-2.65415609e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "if-some", Str: "if-some", X_hash: float64(1.960677609e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "implements?", Str: "implements?", X_hash: float64(4.24528006e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "instance?", Str: "instance?", X_hash: float64(1.075939923e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "js-obj", Str: "js-obj", X_hash: float64(3.4238325e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "js-str", Str: "js-str", X_hash: float64(-1.708707067e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "lazy-cat", Str: "lazy-cat", X_hash: float64(-8.9906116e+07), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "lazy-seq", Str: "lazy-seq", X_hash: float64(4.89632906e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "let", Str: "let", X_hash: float64(3.58118826e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "letfn", Str: "letfn", X_hash: float64(-4.80490827e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "loop", Str: "loop", X_hash: float64(1.244978678e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "memfn", Str: "memfn", X_hash: float64(-8.81453486e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "or", Str: "or", X_hash: float64(1.876275696e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "pvalues", Str: "pvalues", X_hash: float64(-7.60719454e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "reify", Str: "reify", X_hash: float64(1.885539699e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "satisfies?", Str: "satisfies?", X_hash: float64(-4.33227199e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "simple-benchmark", Str: "simple-benchmark", X_hash: float64(6.2919109e+07), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "some->", Str: "some->", X_hash: float64(-1.0111722e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "some->>", Str: "some->>", X_hash: float64(-1.499987794e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "specify", Str: "specify", X_hash: float64(1.712789507e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "specify!", Str: "specify!", X_hash: float64(-2.04925145e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "sync", Str: "sync", X_hash: float64(1.016382581e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "time", Str: "time", X_hash: float64(-1.268547887e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "when", Str: "when", X_hash: float64(1.064114221e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "when-first", Str: "when-first", X_hash: float64(8.21699168e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "when-let", Str: "when-let", X_hash: float64(-1.38304348e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "when-not", Str: "when-not", X_hash: float64(-1.22313634e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "when-some", Str: "when-some", X_hash: float64(1.700415903e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "while", Str: "while", X_hash: float64(-1.691317983e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "with-bindings", Str: "with-bindings", X_hash: float64(-1.197589787e+09), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "with-out-str", Str: "with-out-str", X_hash: float64(-1.40201577e+08), X_meta: nil}), nil, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "with-redefs", Str: "with-redefs", X_hash: float64(-1.143728263e+09), X_meta: nil}), nil}, nil}, nil})
+
+	Resolve_symbol = func(resolve_symbol *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(resolve_symbol, 1, func(sym interface{}) interface{} {
+			{
+				var temp__4386__auto__ = cljs_core.Resolve.X_invoke_Arity1(sym)
+				_ = temp__4386__auto__
+				if cljs_core.Truth_(temp__4386__auto__) {
+					{
+						var v = temp__4386__auto__
+						_ = v
+						return cljs_core.Native_get_instance_field.X_invoke_Arity2(v, "Sym")
+					}
+				} else {
+					{
+						var temp__4386__auto_____1 = cljs_core.Namespace.X_invoke_Arity1(sym)
+						_ = temp__4386__auto_____1
+						if cljs_core.Truth_(temp__4386__auto_____1) {
+							{
+								var ns = temp__4386__auto_____1
+								_ = ns
+								{
+									var temp__4386__auto_____2 = cljs_core.Get.X_invoke_Arity2(cljs_core.Ns_aliases.X_invoke_Arity1(cljs_core.Dynamic_("cljs.core/*ns*", cljs_core.X_STAR_ns_STAR_)), cljs_core.Symbol.X_invoke_Arity1(ns))
+									_ = temp__4386__auto_____2
+									if cljs_core.Truth_(temp__4386__auto_____2) {
+										{
+											var target = temp__4386__auto_____2
+											_ = target
+											return cljs_core.Symbol.X_invoke_Arity2(strings.Join([]string{cljs_core.Str.X_invoke_Arity1(cljs_core.Ns_name.X_invoke_Arity1(target)).(string)}, ``), cljs_core.Name.X_invoke_Arity1(sym)).(*cljs_core.CljsCoreSymbol)
+										}
+									} else {
+										return sym
+									}
+								}
+							}
+						} else {
+							if cljs_core.Contains_QMARK_.Arity2IIB(Core_macros, sym) {
+								return cljs_core.Symbol.X_invoke_Arity2("cljs.core", cljs_core.Name.X_invoke_Arity1(sym)).(*cljs_core.CljsCoreSymbol)
+							} else {
+								return cljs_core.Symbol.X_invoke_Arity2(strings.Join([]string{cljs_core.Str.X_invoke_Arity1(cljs_core.Ns_name.X_invoke_Arity1(cljs_core.Dynamic_("cljs.core/*ns*", cljs_core.X_STAR_ns_STAR_))).(string)}, ``), cljs_core.Name.X_invoke_Arity1(sym)).(*cljs_core.CljsCoreSymbol)
+							}
+						}
+					}
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Register_gensym = func(register_gensym *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(register_gensym, 2, func(env interface{}, sym interface{}) interface{} {
+			{
+				var or__171__auto__ = cljs_core.Get.X_invoke_Arity2(cljs_core.Deref.X_invoke_Arity1(env), sym)
+				_ = or__171__auto__
+				if cljs_core.Truth_(or__171__auto__) {
+					return or__171__auto__
+				} else {
+					{
+						var n = cljs_core.Name.X_invoke_Arity1(sym)
+						_ = n
+						{
+							var gs = cljs_core.Symbol.X_invoke_Arity1(strings.Join([]string{cljs_core.Str.X_invoke_Arity1(cljs_core.Gensym.X_invoke_Arity1(strings.Join([]string{cljs_core.Str.X_invoke_Arity1(cljs_core.Subs.X_invoke_Arity3(n, float64(0), (cljs_core.Count.X_invoke_Arity1(n).(float64) - float64(1)))).(string), cljs_core.Str.X_invoke_Arity1("__").(string)}, ``))).(string), cljs_core.Str.X_invoke_Arity1("__auto__").(string)}, ``)).(*cljs_core.CljsCoreSymbol)
+							_ = gs
+							cljs_core.Swap_BANG_.X_invoke_Arity4(env, cljs_core.Assoc, sym, gs)
+							return gs
+						}
+					}
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Syntax_quote_STAR_ = func(syntax_quote_STAR_ *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(syntax_quote_STAR_, 2, func(env interface{}, form interface{}) interface{} {
+			{
+				var ret = func() interface{} {
+					if cljs_core.Special_symbol_QMARK_.Arity1IB(form) {
+						return cljs_core.Decorate_(cljs_core.CljsCoreList_EMPTY.X_conj_Arity2(form)).(cljs_core.CljsCoreICollection).X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: nil, Name: "quote", Str: "quote", X_hash: float64(1377916282), X_meta: nil}))
+					} else {
+						if cljs_core.Value_(form).Type().AssignableTo(reflect.TypeOf((**cljs_core.CljsCoreSymbol)(nil)).Elem()) {
+							return cljs_core.Decorate_(cljs_core.CljsCoreList_EMPTY.X_conj_Arity2(func() interface{} {
+								var n = cljs_core.Name.X_invoke_Arity1(form)
+								_ = n
+								if cljs_core.Truth_(cljs_core.Namespace.X_invoke_Arity1(form)) {
+									return Resolve_symbol.X_invoke_Arity1(form)
+								} else {
+									if cljs_core.Truth_(func() interface{} {
+										var G__136 = n
+										var G__137 = "#"
+										_, _ = G__136, G__137
+										return cljs_core.Native_invoke_func.X_invoke_Arity2(goog_string.EndsWith, []interface{}{G__136, G__137})
+									}()) {
+										return Register_gensym.X_invoke_Arity2(env, form)
+									} else {
+										if cljs_core.Truth_(func() interface{} {
+											var or__171__auto__ = func() interface{} {
+												var G__138 = n
+												var G__139 = "."
+												_, _ = G__138, G__139
+												return cljs_core.Native_invoke_func.X_invoke_Arity2(goog_string.StartsWith, []interface{}{G__138, G__139})
+											}()
+											_ = or__171__auto__
+											if cljs_core.Truth_(or__171__auto__) {
+												return or__171__auto__
+											} else {
+												{
+													var G__140 = n
+													var G__141 = "."
+													_, _ = G__140, G__141
+													return cljs_core.Native_invoke_func.X_invoke_Arity2(goog_string.EndsWith, []interface{}{G__140, G__141})
+												}
+											}
+										}()) {
+											return form
+										} else {
+											return Resolve_symbol.X_invoke_Arity1(form)
+										}
+									}
+								}
+							}())).(cljs_core.CljsCoreICollection).X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: nil, Name: "quote", Str: "quote", X_hash: float64(1377916282), X_meta: nil}))
+						} else {
+							if Unquote_QMARK_.Arity1IB(form) {
+								return cljs_core.Second.X_invoke_Arity1(form)
+							} else {
+								if Unquote_splicing_QMARK_.Arity1IB(form) {
+									return Reader_error.X_invoke_ArityVariadic(nil, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"unquote-splice not in list"}))
+								} else {
+									if cljs_core.DecoratedValue_(form).Type().Implements(reflect.TypeOf((*cljs_core.CljsCoreIRecord)(nil)).Elem()) {
+										return form
+									} else {
+										if cljs_core.Map_QMARK_.Arity1IB(form) {
+											return Syntax_quote_coll.X_invoke_Arity3(env, (&cljs_core.CljsCoreSymbol{Ns: "cljs.core", Name: "hash-map", Str: "cljs.core/hash-map", X_hash: float64(303385767), X_meta: nil}), cljs_core.Mapcat.X_invoke_Arity2(cljs_core.Identity, form))
+										} else {
+											if cljs_core.Vector_QMARK_.Arity1IB(form) {
+												return Syntax_quote_coll.X_invoke_Arity3(env, (&cljs_core.CljsCoreSymbol{Ns: "cljs.core", Name: "vector", Str: "cljs.core/vector", X_hash: float64(720641726), X_meta: nil}), form)
+											} else {
+												if cljs_core.Set_QMARK_.Arity1IB(form) {
+													return Syntax_quote_coll.X_invoke_Arity3(env, (&cljs_core.CljsCoreSymbol{Ns: "cljs.core", Name: "hash-set", Str: "cljs.core/hash-set", X_hash: float64(1130426749), X_meta: nil}), form)
+												} else {
+													if cljs_core.Seq_QMARK_.Arity1IB(form) {
+														if cljs_core.Truth_(cljs_core.Seq.Arity1IQ(form)) {
+															return Syntax_quote_coll.X_invoke_Arity3(env, nil, form)
+														} else {
+															return cljs_core.CljsCoreList_EMPTY.X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: "cljs.core", Name: "list", Str: "cljs.core/list", X_hash: float64(-1331406371), X_meta: nil}))
+														}
+													} else {
+														if cljs_core.Truth_(func() interface{} {
+															var or__171__auto__ = cljs_core.Value_(form).Type().AssignableTo(reflect.TypeOf((**cljs_core.CljsCoreKeyword)(nil)).Elem())
+															_ = or__171__auto__
+															if cljs_core.Truth_(or__171__auto__) {
+																return or__171__auto__
+															} else {
+																{
+																	var or__171__auto_____1 = cljs_core.Value_(form).Kind() == reflect.Float64
+																	_ = or__171__auto_____1
+																	if cljs_core.Truth_(or__171__auto_____1) {
+																		return or__171__auto_____1
+																	} else {
+																		{
+																			var or__171__auto_____2 = cljs_core.Value_(form).Kind() == reflect.String
+																			_ = or__171__auto_____2
+																			if cljs_core.Truth_(or__171__auto_____2) {
+																				return or__171__auto_____2
+																			} else {
+																				{
+																					var or__171__auto_____3 = cljs_core.Nil_(form)
+																					_ = or__171__auto_____3
+																					if cljs_core.Truth_(or__171__auto_____3) {
+																						return or__171__auto_____3
+																					} else {
+																						{
+																							var or__171__auto_____4 = cljs_core.Value_(form).Kind() == reflect.Bool
+																							_ = or__171__auto_____4
+																							if cljs_core.Truth_(or__171__auto_____4) {
+																								return or__171__auto_____4
+																							} else {
+																								return cljs_core.Regexp_QMARK_.X_invoke_Arity1(form)
+																							}
+																						}
+																					}
+																				}
+																			}
+																		}
+																	}
+																}
+															}
+														}()) {
+															return form
+														} else {
+															return cljs_core.Decorate_(cljs_core.CljsCoreList_EMPTY.X_conj_Arity2(form)).(cljs_core.CljsCoreICollection).X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: nil, Name: "quote", Str: "quote", X_hash: float64(1377916282), X_meta: nil}))
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}()
+				var m = cljs_core.Dissoc.X_invoke_ArityVariadic(cljs_core.Meta.X_invoke_Arity1(form), (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "line", Fqn: "line", X_hash: float64(212345235)}), cljs_core.Array_seq.X_invoke_Arity1([]interface{}{(&cljs_core.CljsCoreKeyword{Ns: nil, Name: "column", Fqn: "column", X_hash: float64(2078222095)}), (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "end-line", Fqn: "end-line", X_hash: float64(1837326455)}), (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "end-column", Fqn: "end-column", X_hash: float64(1425389514)}), (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "file", Fqn: "file", X_hash: float64(-1269645878)})}))
+				_, _ = ret, m
+				if (cljs_core.DecoratedValue_(form).Type().Implements(reflect.TypeOf((*cljs_core.CljsCoreIWithMeta)(nil)).Elem())) && (cljs_core.Truth_(cljs_core.Seq.Arity1IQ(m))) {
+					return cljs_core.Decorate_(cljs_core.Decorate_(cljs_core.CljsCoreList_EMPTY.X_conj_Arity2(syntax_quote_STAR_.X_invoke_Arity2(env, m))).(cljs_core.CljsCoreICollection).X_conj_Arity2(ret)).(cljs_core.CljsCoreICollection).X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: "cljs.core", Name: "with-meta", Str: "cljs.core/with-meta", X_hash: float64(749126446), X_meta: nil}))
+				} else {
+					return ret
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_syntax_quote = func(read_syntax_quote *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_syntax_quote, 2, func(rdr interface{}, ___ interface{}) interface{} {
+			return Syntax_quote_STAR_.X_invoke_Arity2(cljs_core.Atom.X_invoke_Arity1(cljs_core.CljsCorePersistentArrayMap_EMPTY), Read.X_invoke_Arity4(rdr, true, nil, true))
+		})
+	}(&cljs_core.AFn{})
+
+	X_STAR_arg_env_STAR_ = nil
+
+	X_STAR_read_eval_STAR_ = nil
+
+	Garg = func(garg *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(garg, 1, func(n interface{}) interface{} {
+			return cljs_core.Symbol.X_invoke_Arity1(strings.Join([]string{cljs_core.Str.X_invoke_Arity1(func() interface{} {
+				if n.(float64) == float64(-1) {
+					return "rest"
+				} else {
+					return strings.Join([]string{cljs_core.Str.X_invoke_Arity1("p").(string), cljs_core.Str.X_invoke_Arity1(n).(string)}, ``)
+				}
+			}()).(string), cljs_core.Str.X_invoke_Arity1("__").(string), cljs_core.Str.X_invoke_Arity1(cljs_core.Gensym.X_invoke_Arity1("")).(string), cljs_core.Str.X_invoke_Arity1("#").(string)}, ``)).(*cljs_core.CljsCoreSymbol)
+		})
+	}(&cljs_core.AFn{})
+
+	Register_arg = func(register_arg *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(register_arg, 1, func(n interface{}) interface{} {
+			{
+				var or__171__auto__ = cljs_core.Get.X_invoke_Arity2(cljs_core.Deref.X_invoke_Arity1(cljs_core.Dynamic_("cljs.reader/*arg-env*", X_STAR_arg_env_STAR_)), n)
+				_ = or__171__auto__
+				if cljs_core.Truth_(or__171__auto__) {
+					return or__171__auto__
+				} else {
+					{
+						var g = Garg.X_invoke_Arity1(n)
+						_ = g
+						cljs_core.Swap_BANG_.X_invoke_Arity4(cljs_core.Dynamic_("cljs.reader/*arg-env*", X_STAR_arg_env_STAR_), cljs_core.Assoc, n, g)
+						return g
+					}
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_arg = func(read_arg *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_arg, 2, func(rdr interface{}, pct interface{}) interface{} {
+			if cljs_core.Not.Arity1IB(cljs_core.Dynamic_("cljs.reader/*arg-env*", X_STAR_arg_env_STAR_)) {
+				return Read_symbol.X_invoke_Arity2(rdr, pct)
+			} else {
+				{
+					var ch = cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Read_char_Arity1()
+					_ = ch
+					cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Unread_Arity2(ch)
+					if (cljs_core.Nil_(ch)) || (Whitespace_QMARK_.Arity1IB(ch)) || (Macro_terminating_QMARK_.Arity1IB(ch)) {
+						return Register_arg.X_invoke_Arity1(float64(1))
+					} else {
+						if reflect.DeepEqual(ch, "&") {
+							cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Read_char_Arity1()
+							return Register_arg.X_invoke_Arity1(float64(-1))
+						} else {
+							{
+								var n = Read.X_invoke_Arity4(rdr, true, nil, true)
+								_ = n
+								if cljs_core.Integer_QMARK_.Arity1IB(n) {
+									return Register_arg.X_invoke_Arity1(n)
+								} else {
+									return Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Arg literal must be %, %& or %integer"}))
+								}
+							}
+						}
+					}
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_fn = func(read_fn *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_fn, 2, func(rdr interface{}, ___ interface{}) interface{} {
+			if cljs_core.Truth_(cljs_core.Dynamic_("cljs.reader/*arg-env*", X_STAR_arg_env_STAR_)) {
+				Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Nested #()s are not allowed"}))
+			} else {
+			}
+			cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.reader/*arg-env*", cljs_core.Atom.X_invoke_Arity1(cljs_core.CljsCorePersistentArrayMap_EMPTY)}, nil}))
+			return func() interface{} {
+				defer func() {
+					cljs_core.Pop_thread_bindings.X_invoke_Arity0()
+
+				}()
+				{
+					cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Unread_Arity2("(")
+					{
+						var form = Read.X_invoke_Arity4(rdr, true, nil, true)
+						var args = cljs_core.Deref.X_invoke_Arity1(cljs_core.Dynamic_("cljs.reader/*arg-env*", X_STAR_arg_env_STAR_))
+						var params = cljs_core.Mapv.X_invoke_Arity2(func(G__142 *cljs_core.AFn) *cljs_core.AFn {
+							return cljs_core.Fn(G__142, 1, func(n interface{}) interface{} {
+								{
+									var or__171__auto__ = cljs_core.Get.X_invoke_Arity2(args, n)
+									_ = or__171__auto__
+									if cljs_core.Truth_(or__171__auto__) {
+										return or__171__auto__
+									} else {
+										return Garg.X_invoke_Arity1(n)
+									}
+								}
+							})
+						}(&cljs_core.AFn{}), cljs_core.Range_.X_invoke_Arity2(float64(1), (cljs_core.Reduce.X_invoke_Arity3(cljs_core.Max, float64(0), cljs_core.Keys.X_invoke_Arity1(args)).(float64)+float64(1))))
+						_, _, _ = form, args, params
+						return cljs_core.Decorate_(cljs_core.Decorate_(cljs_core.CljsCoreList_EMPTY.X_conj_Arity2(form)).(cljs_core.CljsCoreICollection).X_conj_Arity2(func() interface{} {
+							var temp__4386__auto__ = cljs_core.Get.X_invoke_Arity2(args, float64(-1))
+							_ = temp__4386__auto__
+							if cljs_core.Truth_(temp__4386__auto__) {
+								{
+									var rest = temp__4386__auto__
+									_ = rest
+									return cljs_core.Conj.X_invoke_ArityVariadic(params, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "&", Str: "&", X_hash: float64(-2144855648), X_meta: nil}), cljs_core.Array_seq.X_invoke_Arity1([]interface{}{rest}))
+								}
+							} else {
+								return params
+							}
+						}())).(cljs_core.CljsCoreICollection).X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: nil, Name: "fn*", Str: "fn*", X_hash: float64(-752876845), X_meta: nil}))
+					}
+				}
+			}()
+		})
+	}(&cljs_core.AFn{})
+
+	Read_var = func(read_var *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_var, 2, func(rdr interface{}, ___ interface{}) interface{} {
+			return cljs_core.Decorate_(cljs_core.CljsCoreList_EMPTY.X_conj_Arity2(Read.X_invoke_Arity4(rdr, true, nil, true))).(cljs_core.CljsCoreICollection).X_conj_Arity2((&cljs_core.CljsCoreSymbol{Ns: nil, Name: "var", Str: "var", X_hash: float64(870848730), X_meta: nil}))
+		})
+	}(&cljs_core.AFn{})
+
+	Read_eval = func(read_eval *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_eval, 2, func(rdr interface{}, ___ interface{}) interface{} {
+			if cljs_core.Truth_(cljs_core.Dynamic_("cljs.reader/*read-eval*", X_STAR_read_eval_STAR_)) {
+				{
+					var G__143 = Read.X_invoke_Arity4(rdr, true, nil, true)
+					_ = G__143
+					return cljs_core.Dynamic_("cljs.reader/*read-eval*", X_STAR_read_eval_STAR_).(cljs_core.CljsCoreIFn).X_invoke_Arity1(G__143)
+				}
+			} else {
+				return Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"EvalReader not allowed when *read-eval* is nil"}))
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Namespace_keys = func(namespace_keys *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(namespace_keys, 2, func(ns interface{}, ks interface{}) interface{} {
+			return cljs_core.Map_.X_invoke_Arity2(func(G__144 *cljs_core.AFn) *cljs_core.AFn {
+				return cljs_core.Fn(G__144, 1, func(k interface{}) interface{} {
+					if (cljs_core.Value_(k).Type().AssignableTo(reflect.TypeOf((**cljs_core.CljsCoreKeyword)(nil)).Elem())) || (cljs_core.Value_(k).Type().AssignableTo(reflect.TypeOf((**cljs_core.CljsCoreSymbol)(nil)).Elem())) {
+						{
+							var __GT_k = func() *cljs_core.AFn {
+								if cljs_core.Value_(k).Type().AssignableTo(reflect.TypeOf((**cljs_core.CljsCoreKeyword)(nil)).Elem()) {
+									return cljs_core.Keyword
+								} else {
+									return cljs_core.Symbol
+								}
+							}()
+							_ = __GT_k
+							if cljs_core.Nil_(cljs_core.Namespace.X_invoke_Arity1(k)) {
+								return __GT_k.X_invoke_Arity2(ns, cljs_core.Name.X_invoke_Arity1(k))
+							} else {
+								if cljs_core.X_EQ_.Arity2IIB(cljs_core.Namespace.X_invoke_Arity1(k), "_") {
+									return __GT_k.X_invoke_Arity1(cljs_core.Name.X_invoke_Arity1(k))
+								} else {
+									return k
+								}
+							}
+						}
+					} else {
+						return k
+					}
+				})
+			}(&cljs_core.AFn{}), ks)
+		})
+	}(&cljs_core.AFn{})
+
+	Read_namespaced_map = func(read_namespaced_map *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_namespaced_map, 2, func(rdr interface{}, ___ interface{}) interface{} {
+			{
+				var start = Start_position.X_invoke_Arity2(rdr, float64(2))
+				var ch = cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Read_char_Arity1()
+				var token = func() interface{} {
+					if (cljs_core.Nil_(ch)) || (Whitespace_QMARK_.Arity1IB(ch)) || (Macro_terminating_QMARK_.Arity1IB(ch)) {
+						return Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Namespaced map must specify a namespace"}))
+					} else {
+						return Read_token.X_invoke_Arity2(rdr, ch)
+					}
+				}()
+				var ns = func() interface{} {
+					if cljs_core.X_EQ_.Arity2IIB(token, ":") {
+						return strings.Join([]string{cljs_core.Str.X_invoke_Arity1(cljs_core.Ns_name.X_invoke_Arity1(cljs_core.Dynamic_("cljs.core/*ns*", cljs_core.X_STAR_ns_STAR_))).(string)}, ``)
+					} else {
+						if cljs_core.Truth_(func() interface{} {
+							var G__145 = token
+							var G__146 = ":"
+							_, _ = G__145, G__146
+							return cljs_core.Native_invoke_func.X_invoke_Arity2(goog_string.StartsWith, []interface{}{G__145, G__146})
+						}()) {
+							{
+								var sym = cljs_core.Symbol.X_invoke_Arity1(cljs_core.Subs.X_invoke_Arity2(token, float64(1))).(*cljs_core.CljsCoreSymbol)
+								_ = sym
+								{
+									var temp__4386__auto__ = func() interface{} {
+										var or__171__auto__ = cljs_core.Get.X_invoke_Arity2(cljs_core.Ns_aliases.X_invoke_Arity1(cljs_core.Dynamic_("cljs.core/*ns*", cljs_core.X_STAR_ns_STAR_)), sym)
+										_ = or__171__auto__
+										if cljs_core.Truth_(or__171__auto__) {
+											return or__171__auto__
+										} else {
+											return cljs_core.Find_ns.X_invoke_Arity1(sym)
+										}
+									}()
+									_ = temp__4386__auto__
+									if cljs_core.Truth_(temp__4386__auto__) {
+										{
+											var target = temp__4386__auto__
+											_ = target
+											return strings.Join([]string{cljs_core.Str.X_invoke_Arity1(cljs_core.Ns_name.X_invoke_Arity1(target)).(string)}, ``)
+										}
+									} else {
+										return nil
+									}
+								}
+							}
+						} else {
+							return token
+						}
+					}
+				}()
+				_, _, _, _ = start, ch, token, ns
+				if cljs_core.Truth_(func() interface{} {
+					var or__171__auto__ = cljs_core.Nil_(ns)
+					_ = or__171__auto__
+					if cljs_core.Truth_(or__171__auto__) {
+						return or__171__auto__
+					} else {
+						{
+							var G__147 = ns
+							var G__148 = "/"
+							_, _ = G__147, G__148
+							return cljs_core.Native_invoke_func.X_invoke_Arity2(goog_string.Contains, []interface{}{G__147, G__148})
+						}
+					}
+				}()) {
+					Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Invalid value used as namespace in namespaced map: ", token}))
+				} else {
+				}
+				if reflect.DeepEqual(Read_past.X_invoke_Arity2(Whitespace_QMARK_, rdr), "{") {
+				} else {
+					Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Namespaced map with namespace ", token, " does not specify a map"}))
+				}
+				{
+					var items = Read_delimited_list.X_invoke_Arity3("}", rdr, true)
+					_ = items
+					if cljs_core.Odd_QMARK_.Arity1IB(cljs_core.Count.X_invoke_Arity1(items).(float64)) {
+						Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Map literal must contain an even number of forms"}))
+					} else {
+					}
+					return With_position.X_invoke_Arity3(rdr, start, cljs_core.Apply.X_invoke_Arity2(cljs_core.Hash_map, cljs_core.Interleave.X_invoke_Arity2(Namespace_keys.X_invoke_Arity2(ns, cljs_core.Take_nth.X_invoke_Arity2(float64(2), items)), cljs_core.Take_nth.X_invoke_Arity2(float64(2), cljs_core.Rest.X_invoke_Arity1(items)))))
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_symbolic_value = func(read_symbolic_value *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_symbolic_value, 2, func(rdr interface{}, ___ interface{}) interface{} {
+			{
+				var sym = Read.X_invoke_Arity4(rdr, true, nil, true)
+				_ = sym
+				if cljs_core.X_EQ_.Arity2IIB(sym, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "Inf", Str: "Inf", X_hash: float64(647172781), X_meta: nil})) {
+					return js.Infinity
+				} else {
+					if cljs_core.X_EQ_.Arity2IIB(sym, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "-Inf", Str: "-Inf", X_hash: float64(-2123243689), X_meta: nil})) {
+						return (-js.Infinity)
+					} else {
+						if cljs_core.X_EQ_.Arity2IIB(sym, (&cljs_core.CljsCoreSymbol{Ns: nil, Name: "NaN", Str: "NaN", X_hash: float64(666918153), X_meta: nil})) {
+							return js.NaN
+						} else {
+							return Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Invalid token: ##", sym}))
+						}
+					}
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_cond_branches = func(read_cond_branches *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_cond_branches, 1, func(rdr interface{}) interface{} {
+			{
+				var result interface{} = rdr
+				_ = result
+				for {
+					{
+						var ch = Read_past.X_invoke_Arity2(Whitespace_QMARK_, rdr)
+						_ = ch
+						if cljs_core.Nil_(ch) {
+							return Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"EOF while reading"}))
+						} else {
+							if reflect.DeepEqual(ch, ")") {
+								return result
+							} else {
+								{
+									var ___ = cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Unread_Arity2(ch)
+									var feature = Read.X_invoke_Arity4(rdr, true, nil, true)
+									_, _ = ___, feature
+									if cljs_core.Value_(feature).Type().AssignableTo(reflect.TypeOf((**cljs_core.CljsCoreKeyword)(nil)).Elem()) {
+									} else {
+										Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Feature should be a keyword: ", feature}))
+									}
+									{
+										var ch___1 = Read_past.X_invoke_Arity2(Whitespace_QMARK_, rdr)
+										_ = ch___1
+										if reflect.DeepEqual(ch___1, ")") {
+											Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"read-cond requires an even number of forms"}))
+										} else {
+										}
+										cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Unread_Arity2(ch___1)
+									}
+									if (reflect.DeepEqual(result, rdr)) && ((cljs_core.Contains_QMARK_.Arity2IIB(cljs_core.Dynamic_or_zero_("cljs.reader/*features*", X_STAR_features_STAR_).(*cljs_core.CljsCorePersistentHashSet), feature)) || (cljs_core.X_EQ_.Arity2IIB(feature, (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "default", Fqn: "default", X_hash: float64(-1987822328)})))) {
+										result = Read.X_invoke_Arity4(rdr, true, nil, true)
+										continue
+									} else {
+										cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(1), []interface{}{"cljs.reader/*suppress-read*", true}, nil}))
+										func() interface{} {
+											defer func() {
+												cljs_core.Pop_thread_bindings.X_invoke_Arity0()
+
+											}()
+											{
+												return Read.X_invoke_Arity4(rdr, true, nil, true)
+											}
+										}()
+										result = result
+										continue
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_cond = func(read_cond *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_cond, 2, func(rdr interface{}, ___ interface{}) interface{} {
+			if cljs_core.Truth_(cljs_core.Dynamic_("cljs.reader/*read-cond*", X_STAR_read_cond_STAR_)) {
+			} else {
+				Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Conditional read not allowed"}))
+			}
+			{
+				var ch = cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Read_char_Arity1()
+				var splicing_QMARK_ = reflect.DeepEqual(ch, "@")
+				_, _ = ch, splicing_QMARK_
+				if splicing_QMARK_ {
+				} else {
+					cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Unread_Arity2(ch)
+				}
+				if reflect.DeepEqual(Read_past.X_invoke_Arity2(Whitespace_QMARK_, rdr), "(") {
+				} else {
+					Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"read-cond body must be a list"}))
+				}
+				if cljs_core.X_EQ_.Arity2IIB(cljs_core.Dynamic_("cljs.reader/*read-cond*", X_STAR_read_cond_STAR_), (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "preserve", Fqn: "preserve", X_hash: float64(1276846509)})) {
+					{
+						var forms = Read_delimited_list.X_invoke_Arity3(")", rdr, true)
+						_ = forms
+						if cljs_core.Odd_QMARK_.Arity1IB(cljs_core.Count.X_invoke_Arity1(forms).(float64)) {
+							Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"read-cond requires an even number of forms"}))
+						} else {
+						}
+						return Reader_conditional.X_invoke_Arity2(cljs_core.Apply.X_invoke_Arity2(cljs_core.List, forms), splicing_QMARK_)
+					}
+				} else {
+					{
+						var form = Read_cond_branches.X_invoke_Arity1(rdr)
+						_ = form
+						if (reflect.DeepEqual(form, rdr)) || (!(splicing_QMARK_)) {
+							return form
+						} else {
+							if cljs_core.Sequential_QMARK_.Arity1IB(form) {
+								return (&CljsReaderSplice{form})
+							} else {
+								return Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Spliced form list in read-cond-splicing must be sequential"}))
+							}
+						}
+					}
+				}
+			}
 		})
 	}(&cljs_core.AFn{})
 
-	Read_token = func(read_token *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(read_token, 2, func(rdr interface{}, initch interface{}) interface{} {
+	Read_delimited_list = func(read_delimited_list *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_delimited_list, 3, func(delim interface{}, rdr interface{}, recursive_QMARK_ interface{}) interface{} {
 			{
-				var sb = (&goog_string.StringBuffer{initch})
-				var ch interface{} = cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Read_char_Arity1()
-				_, _ = sb, ch
+				var a interface{} = cljs_core.Transient.X_invoke_Arity1(cljs_core.CljsCorePersistentVector_EMPTY)
+				_ = a
 				for {
-					if (cljs_core.Nil_(ch)) || (Whitespace_QMARK_.Arity1IB(ch)) || (Macro_terminating_QMARK_.Arity1IB(ch)) {
-						cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Unread_Arity2(ch)
-						return cljs_core.Native_invoke_instance_method.X_invoke_Arity3(sb, "ToString", []interface{}{})
-					} else {
-						sb, ch = func() *goog_string.StringBuffer {
-							cljs_core.Native_invoke_instance_method.X_invoke_Arity3(sb, "Append", []interface{}{ch})
-							return sb
-						}(), cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Read_char_Arity1()
-						continue
+					{
+						var ch = Read_past.X_invoke_Arity2(Whitespace_QMARK_, rdr)
+						_ = ch
+						if cljs_core.Truth_(ch) {
+						} else {
+							Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"EOF while reading"}))
+						}
+						if reflect.DeepEqual(delim, ch) {
+							return cljs_core.Persistent_BANG_.X_invoke_Arity1(a)
+						} else {
+							{
+								var temp__4386__auto__ = func() interface{} {
+									var G__46 = ch
+									_ = G__46
+									return Macros.X_invoke_Arity1(G__46)
+								}()
+								_ = temp__4386__auto__
+								if cljs_core.Truth_(temp__4386__auto__) {
+									{
+										var macrofn = temp__4386__auto__
+										_ = macrofn
+										{
+											var mret = func() interface{} {
+												var G__47 = rdr
+												var G__48 = ch
+												_, _ = G__47, G__48
+												return macrofn.(cljs_core.CljsCoreIFn).X_invoke_Arity2(G__47, G__48)
+											}()
+											_ = mret
+											a = func() interface{} {
+												if reflect.DeepEqual(mret, rdr) {
+													return a
+												} else {
+													if cljs_core.Value_(mret).Type().AssignableTo(reflect.TypeOf((**CljsReaderSplice)(nil)).Elem()) {
+														return cljs_core.Reduce.X_invoke_Arity3(cljs_core.Conj_BANG_, a, cljs_core.Native_get_instance_field.X_invoke_Arity2(mret, "Forms"))
+													} else {
+														return cljs_core.Conj_BANG_.X_invoke_Arity2(a, mret)
+													}
+												}
+											}()
+											continue
+										}
+									}
+								} else {
+									cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Unread_Arity2(ch)
+									{
+										var o = func() interface{} {
+											var G__49 = rdr
+											var G__50 = true
+											var G__51 interface{} = nil
+											var G__52 = recursive_QMARK_
+											_, _, _, _ = G__49, G__50, G__51, G__52
+											return Read.X_invoke_Arity4(G__49, G__50, G__51, G__52)
+										}()
+										_ = o
+										a = func() interface{} {
+											if reflect.DeepEqual(o, rdr) {
+												return a
+											} else {
+												return cljs_core.Conj_BANG_.X_invoke_Arity2(a, o)
+											}
+										}()
+										continue
+									}
+								}
+							}
+						}
 					}
 				}
 			}
@@ -69,10 +1075,10 @@ func init() {
 									return Read_meta
 								} else {
 									if reflect.DeepEqual(c, "`") {
-										return Not_implemented
+										return Read_syntax_quote
 									} else {
 										if reflect.DeepEqual(c, "~") {
-											return Not_implemented
+											return Read_unquote
 										} else {
 											if reflect.DeepEqual(c, "(") {
 												return Read_list
@@ -102,8 +1108,12 @@ func init() {
 																		if reflect.DeepEqual(c, "#") {
 																			return Read_dispatch
 																		} else {
-																			return nil
+																			if reflect.DeepEqual(c, "%") {
+																				return Read_arg
+																			} else {
+																				return nil
 
+																			}
 																		}
 																	}
 																}
@@ -123,6 +1133,175 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	Dispatch_macros = func(dispatch_macros *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(dispatch_macros, 1, func(s interface{}) interface{} {
+			if reflect.DeepEqual(s, "{") {
+				return Read_set
+			} else {
+				if reflect.DeepEqual(s, "<") {
+					return Throwing_reader.X_invoke_Arity1("Unreadable form").(cljs_core.CljsCoreIFn)
+				} else {
+					if reflect.DeepEqual(s, "\"") {
+						return Read_regex
+					} else {
+						if reflect.DeepEqual(s, "!") {
+							return Read_comment
+						} else {
+							if reflect.DeepEqual(s, "_") {
+								return Read_discard
+							} else {
+								if reflect.DeepEqual(s, "?") {
+									return Read_cond
+								} else {
+									if reflect.DeepEqual(s, "(") {
+										return Read_fn
+									} else {
+										if reflect.DeepEqual(s, "'") {
+											return Read_var
+										} else {
+											if reflect.DeepEqual(s, "=") {
+												return Read_eval
+											} else {
+												if reflect.DeepEqual(s, ":") {
+													return Read_namespaced_map
+												} else {
+													if reflect.DeepEqual(s, "#") {
+														return Read_symbolic_value
+													} else {
+														return nil
+
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read = func(read *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read, 4, func(reader interface{}) interface{} {
+			return read.X_invoke_Arity4(reader, true, nil, false)
+		}, func(opts interface{}, reader interface{}) interface{} {
+			cljs_core.Push_thread_bindings.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(2), []interface{}{"cljs.reader/*read-cond*", cljs_core.Get.X_invoke_Arity3(opts, (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "read-cond", Fqn: "read-cond", X_hash: float64(1056899244)}), cljs_core.Dynamic_("cljs.reader/*read-cond*", X_STAR_read_cond_STAR_)), "cljs.reader/*features*", cljs_core.Set.X_invoke_Arity1(cljs_core.Get.X_invoke_Arity3(opts, (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "features", Fqn: "features", X_hash: float64(-1146962336)}), cljs_core.Dynamic_or_zero_("cljs.reader/*features*", X_STAR_features_STAR_).(*cljs_core.CljsCorePersistentHashSet)))}, nil}))
+			return func() interface{} {
+				defer func() {
+					cljs_core.Pop_thread_bindings.X_invoke_Arity0()
+
+				}()
+				{
+					return read.X_invoke_Arity4(reader, !(cljs_core.Contains_QMARK_.Arity2IIB(opts, (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "eof", Fqn: "eof", X_hash: float64(-489063237)}))), cljs_core.Get.X_invoke_Arity2(opts, (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "eof", Fqn: "eof", X_hash: float64(-489063237)})), false)
+				}
+			}()
+		}, func(reader interface{}, eof_is_error interface{}, sentinel interface{}, is_recursive interface{}) interface{} {
+			for {
+				{
+					var ch = cljs_core.Decorate_(reader).(CljsReaderPushbackReader).Read_char_Arity1()
+					_ = ch
+					if cljs_core.Nil_(ch) {
+						if cljs_core.Truth_(eof_is_error) {
+							return Reader_error.X_invoke_ArityVariadic(reader, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"EOF while reading"}))
+						} else {
+							return sentinel
+						}
+					} else {
+						if Whitespace_QMARK_.Arity1IB(ch) {
+							reader, eof_is_error, sentinel, is_recursive = reader, eof_is_error, sentinel, is_recursive
+							continue
+						} else {
+							if Comment_prefix_QMARK_.Arity1IB(ch) {
+								reader, eof_is_error, sentinel, is_recursive = func() interface{} {
+									var G__119 = reader
+									var G__120 = ch
+									_, _ = G__119, G__120
+									return Read_comment.(cljs_core.CljsCoreIFn).X_invoke_Arity2(G__119, G__120)
+								}(), eof_is_error, sentinel, is_recursive
+								continue
+							} else {
+								{
+									var f = Macros.X_invoke_Arity1(ch)
+									var res = func() interface{} {
+										if cljs_core.Truth_(f) {
+											return func() interface{} {
+												var G__121 = reader
+												var G__122 = ch
+												_, _ = G__121, G__122
+												return f.(cljs_core.CljsCoreIFn).X_invoke_Arity2(G__121, G__122)
+											}()
+										} else {
+											return func() interface{} {
+												if Number_literal_QMARK_.Arity2IIB(reader, ch) {
+													return Read_number.X_invoke_Arity2(reader, ch)
+												} else {
+													return Read_symbol.X_invoke_Arity2(reader, ch)
+												}
+											}()
+										}
+									}()
+									_, _ = f, res
+									if reflect.DeepEqual(res, reader) {
+										reader, eof_is_error, sentinel, is_recursive = reader, eof_is_error, sentinel, is_recursive
+										continue
+									} else {
+										if cljs_core.Value_(res).Type().AssignableTo(reflect.TypeOf((**CljsReaderSplice)(nil)).Elem()) {
+											return Reader_error.X_invoke_ArityVariadic(reader, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Reader conditional splicing not allowed at the top level"}))
+										} else {
+											return res
+										}
+									}
+								}
+
+							}
+						}
+					}
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_seq = func(read_seq *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_seq, 2, func(reader interface{}) interface{} {
+			return read_seq.X_invoke_Arity2(cljs_core.CljsCorePersistentArrayMap_EMPTY, reader)
+		}, func(opts interface{}, reader interface{}) interface{} {
+			{
+				var reader___1 = func() interface{} {
+					if cljs_core.DecoratedValue_(reader).Type().Implements(reflect.TypeOf((*CljsReaderPushbackReader)(nil)).Elem()) {
+						return reader
+					} else {
+						return Io_push_back_reader.X_invoke_Arity1(reader)
+					}
+				}()
+				_ = reader___1
+				return (&cljs_core.CljsCoreLazySeq{nil, func(G__123 *cljs_core.AFn) *cljs_core.AFn {
+					return cljs_core.Fn(G__123, 0, func() interface{} {
+						{
+							var form = Read.X_invoke_Arity2(cljs_core.Assoc.X_invoke_Arity3(opts, (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "eof", Fqn: "eof", X_hash: float64(-489063237)}), reader___1), reader___1)
+							_ = form
+							if reflect.DeepEqual(form, reader___1) {
+								return nil
+							} else {
+								return cljs_core.Cons.X_invoke_Arity2(form, read_seq.X_invoke_Arity2(opts, reader___1).(*cljs_core.CljsCoreLazySeq)).(*cljs_core.CljsCoreCons)
+							}
+						}
+					})
+				}(&cljs_core.AFn{}), nil, nil})
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Read_string = func(read_string *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_string, 2, func(s interface{}) interface{} {
+			return Read.X_invoke_Arity4(Push_back_reader.X_invoke_Arity1(s).(*CljsReaderStringPushbackReader), false, nil, false)
+		}, func(opts interface{}, s interface{}) interface{} {
+			return Read.X_invoke_Arity2(opts, Push_back_reader.X_invoke_Arity1(s).(*CljsReaderStringPushbackReader))
+		})
+	}(&cljs_core.AFn{})
+
 	Days_in_month = func() interface{} {
 		var dim_norm = (&cljs_core.CljsCorePersistentVector{nil, float64(13), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{nil, float64(31), float64(28), float64(31), float64(30), float64(31), float64(30), float64(31), float64(31), float64(30), float64(31), float64(30), float64(31)}, nil})
 		var dim_leap = (&cljs_core.CljsCorePersistentVector{nil, float64(13), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{nil, float64(31), float64(29), float64(31), float64(30), float64(31), float64(30), float64(31), float64(31), float64(30), float64(31), float64(30), float64(31)}, nil})
@@ -140,24 +1319,137 @@ func init() {
 		}(&cljs_core.AFn{}, dim_norm, dim_leap))
 	}()
 
+	Parse_and_validate_timestamp = func(parse_and_validate_timestamp *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(parse_and_validate_timestamp, 1, func(s interface{}) interface{} {
+			{
+				var vec__149 = cljs_core.Re_matches.X_invoke_Arity2(Timestamp_regex, s)
+				var ___ = cljs_core.Nth.X_invoke_Arity3(vec__149, float64(0), nil)
+				var years = cljs_core.Nth.X_invoke_Arity3(vec__149, float64(1), nil)
+				var months = cljs_core.Nth.X_invoke_Arity3(vec__149, float64(2), nil)
+				var days = cljs_core.Nth.X_invoke_Arity3(vec__149, float64(3), nil)
+				var hours = cljs_core.Nth.X_invoke_Arity3(vec__149, float64(4), nil)
+				var minutes = cljs_core.Nth.X_invoke_Arity3(vec__149, float64(5), nil)
+				var seconds = cljs_core.Nth.X_invoke_Arity3(vec__149, float64(6), nil)
+				var fraction = cljs_core.Nth.X_invoke_Arity3(vec__149, float64(7), nil)
+				var offset_sign = cljs_core.Nth.X_invoke_Arity3(vec__149, float64(8), nil)
+				var offset_hours = cljs_core.Nth.X_invoke_Arity3(vec__149, float64(9), nil)
+				var offset_minutes = cljs_core.Nth.X_invoke_Arity3(vec__149, float64(10), nil)
+				var v = vec__149
+				_, _, _, _, _, _, _, _, _, _, _, _, _ = vec__149, ___, years, months, days, hours, minutes, seconds, fraction, offset_sign, offset_hours, offset_minutes, v
+				if cljs_core.Not.Arity1IB(v) {
+					return Reader_error.X_invoke_ArityVariadic(nil, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Unrecognized date/time syntax: ").(string), cljs_core.Str.X_invoke_Arity1(s).(string)}, ``)}))
+				} else {
+					{
+						var years___1 = Parse_int.X_invoke_Arity1(years)
+						var months___1 = func() interface{} {
+							var or__171__auto__ = Parse_int.X_invoke_Arity1(months)
+							_ = or__171__auto__
+							if cljs_core.Truth_(or__171__auto__) {
+								return or__171__auto__
+							} else {
+								return float64(1)
+							}
+						}()
+						var days___1 = func() interface{} {
+							var or__171__auto__ = Parse_int.X_invoke_Arity1(days)
+							_ = or__171__auto__
+							if cljs_core.Truth_(or__171__auto__) {
+								return or__171__auto__
+							} else {
+								return float64(1)
+							}
+						}()
+						var hours___1 = func() interface{} {
+							var or__171__auto__ = Parse_int.X_invoke_Arity1(hours)
+							_ = or__171__auto__
+							if cljs_core.Truth_(or__171__auto__) {
+								return or__171__auto__
+							} else {
+								return float64(0)
+							}
+						}()
+						var minutes___1 = func() interface{} {
+							var or__171__auto__ = Parse_int.X_invoke_Arity1(minutes)
+							_ = or__171__auto__
+							if cljs_core.Truth_(or__171__auto__) {
+								return or__171__auto__
+							} else {
+								return float64(0)
+							}
+						}()
+						var seconds___1 = func() interface{} {
+							var or__171__auto__ = Parse_int.X_invoke_Arity1(seconds)
+							_ = or__171__auto__
+							if cljs_core.Truth_(or__171__auto__) {
+								return or__171__auto__
+							} else {
+								return float64(0)
+							}
+						}()
+						var fraction___1 = func() interface{} {
+							var or__171__auto__ = Parse_int.X_invoke_Arity1(Zero_fill_right_and_truncate.X_invoke_Arity2(fraction, float64(9)))
+							_ = or__171__auto__
+							if cljs_core.Truth_(or__171__auto__) {
+								return or__171__auto__
+							} else {
+								return float64(0)
+							}
+						}()
+						var offset_sign___1 = func() float64 {
+							if cljs_core.X_EQ_.Arity2IIB(offset_sign, "-") {
+								return float64(-1)
+							} else {
+								return float64(1)
+							}
+						}()
+						var offset_hours___1 = func() interface{} {
+							var or__171__auto__ = Parse_int.X_invoke_Arity1(offset_hours)
+							_ = or__171__auto__
+							if cljs_core.Truth_(or__171__auto__) {
+								return or__171__auto__
+							} else {
+								return float64(0)
+							}
+						}()
+						var offset_minutes___1 = func() interface{} {
+							var or__171__auto__ = Parse_int.X_invoke_Arity1(offset_minutes)
+							_ = or__171__auto__
+							if cljs_core.Truth_(or__171__auto__) {
+								return or__171__auto__
+							} else {
+								return float64(0)
+							}
+						}()
+						var offset = (offset_sign___1 * ((offset_hours___1.(float64) * float64(60)) + offset_minutes___1.(float64)))
+						_, _, _, _, _, _, _, _, _, _, _ = years___1, months___1, days___1, hours___1, minutes___1, seconds___1, fraction___1, offset_sign___1, offset_hours___1, offset_minutes___1, offset
+						return (&cljs_core.CljsCorePersistentVector{nil, float64(8), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{years___1, Check.X_invoke_Arity4(float64(1), months___1, float64(12), "timestamp month field must be in range 1..12"), Check.X_invoke_Arity4(float64(1), days___1, func() interface{} {
+							var G__150 = months___1
+							var G__151 = cljs_core.Truth_(Leap_year_QMARK_.X_invoke_Arity1(years___1))
+							_, _ = G__150, G__151
+							return Days_in_month.(cljs_core.CljsCoreIFn).X_invoke_Arity2(G__150, G__151)
+						}(), "timestamp day field must be in range 1..last day in month"), Check.X_invoke_Arity4(float64(0), hours___1, float64(23), "timestamp hour field must be in range 0..23"), Check.X_invoke_Arity4(float64(0), minutes___1, float64(59), "timestamp minute field must be in range 0..59"), Check.X_invoke_Arity4(float64(0), seconds___1, func() float64 {
+							if cljs_core.X_EQ_.Arity2IIB(minutes___1, float64(59)) {
+								return float64(60)
+							} else {
+								return float64(59)
+							}
+						}(), "timestamp second field must be in range 0..60"), Check.X_invoke_Arity4(float64(0), fraction___1, float64(999999999), "timestamp nanosecond field must be in range 0..999999999"), offset}, nil})
+					}
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
 	Parse_timestamp = func(parse_timestamp *cljs_core.AFn) *cljs_core.AFn {
 		return cljs_core.Fn(parse_timestamp, 1, func(ts interface{}) interface{} {
 			{
-				var temp__4386__auto__ = Parse_and_validate_timestamp.X_invoke_Arity1(ts).(cljs_core.CljsCoreIVector)
+				var temp__4386__auto__ = Parse_and_validate_timestamp.X_invoke_Arity1(ts)
 				_ = temp__4386__auto__
 				if cljs_core.Truth_(temp__4386__auto__) {
 					{
-						var vec__172 = temp__4386__auto__
-						var years = cljs_core.Nth.X_invoke_Arity3(vec__172, float64(0), nil)
-						var months = cljs_core.Nth.X_invoke_Arity3(vec__172, float64(1), nil)
-						var days = cljs_core.Nth.X_invoke_Arity3(vec__172, float64(2), nil)
-						var hours = cljs_core.Nth.X_invoke_Arity3(vec__172, float64(3), nil)
-						var minutes = cljs_core.Nth.X_invoke_Arity3(vec__172, float64(4), nil)
-						var seconds = cljs_core.Nth.X_invoke_Arity3(vec__172, float64(5), nil)
-						var ms = cljs_core.Nth.X_invoke_Arity3(vec__172, float64(6), nil)
-						var offset = cljs_core.Nth.X_invoke_Arity3(vec__172, float64(7), nil)
-						_, _, _, _, _, _, _, _, _ = vec__172, years, months, days, hours, minutes, seconds, ms, offset
-						return (&js.Date{ts})
+						var fields = temp__4386__auto__
+						_ = fields
+						return (&js.Date{Timestamp__GT_time.X_invoke_Arity1(fields)})
 					}
 				} else {
 					return Reader_error.X_invoke_ArityVariadic(nil, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Unrecognized date/time syntax: ").(string), cljs_core.Str.X_invoke_Arity1(ts).(string)}, ``)}))
@@ -186,6 +1478,16 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	Read_instant_time = func(read_instant_time *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(read_instant_time, 1, func(s interface{}) interface{} {
+			if cljs_core.Value_(s).Kind() == reflect.String {
+				return Timestamp__GT_time.X_invoke_Arity1(Parse_and_validate_timestamp.X_invoke_Arity1(s))
+			} else {
+				return Reader_error.X_invoke_ArityVariadic(nil, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Instance literal expects a string for its timestamp."}))
+			}
+		})
+	}(&cljs_core.AFn{})
+
 	Read_uuid = func(read_uuid *cljs_core.AFn) *cljs_core.AFn {
 		return cljs_core.Fn(read_uuid, 1, func(uuid interface{}) interface{} {
 			if cljs_core.Value_(uuid).Kind() == reflect.String {
@@ -198,24 +1500,379 @@ func init() {
 
 	X_STAR_tag_table_STAR_ = cljs_core.Atom.X_invoke_Arity1((&cljs_core.CljsCorePersistentArrayMap{nil, float64(3), []interface{}{"inst", Read_date, "uuid", Read_uuid, "queue", Read_queue}, nil})).(*cljs_core.CljsCoreAtom)
 
+	Maybe_read_tagged_type = func(maybe_read_tagged_type *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(maybe_read_tagged_type, 2, func(rdr interface{}, initch interface{}) interface{} {
+			{
+				var tag = Read_symbol.X_invoke_Arity2(rdr, initch)
+				var pfn = cljs_core.Get.X_invoke_Arity2(cljs_core.Deref.X_invoke_Arity1(X_STAR_tag_table_STAR_), strings.Join([]string{cljs_core.Str.X_invoke_Arity1(tag).(string)}, ``))
+				var dfn = cljs_core.Deref.X_invoke_Arity1(X_STAR_default_data_reader_fn_STAR_)
+				_, _, _ = tag, pfn, dfn
+				if cljs_core.Truth_(pfn) {
+					{
+						var G__164 = Read.X_invoke_Arity4(rdr, true, nil, false)
+						_ = G__164
+						return pfn.(cljs_core.CljsCoreIFn).X_invoke_Arity1(G__164)
+					}
+				} else {
+					if cljs_core.Truth_(dfn) {
+						{
+							var G__165 = tag
+							var G__166 = Read.X_invoke_Arity4(rdr, true, nil, false)
+							_, _ = G__165, G__166
+							return dfn.(cljs_core.CljsCoreIFn).X_invoke_Arity2(G__165, G__166)
+						}
+					} else {
+						if cljs_core.Truth_(cljs_core.Dynamic_("cljs.reader/*suppress-read*", X_STAR_suppress_read_STAR_)) {
+							return Read.X_invoke_Arity4(rdr, true, nil, false)
+						} else {
+							return Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Could not find tag parser for ", strings.Join([]string{cljs_core.Str.X_invoke_Arity1(tag).(string)}, ``), " in ", cljs_core.Pr_str.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{cljs_core.Keys.X_invoke_Arity1(cljs_core.Deref.X_invoke_Arity1(X_STAR_tag_table_STAR_))})).(string)}))
+						}
+					}
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	cljs_core.Intern_("cljs.reader", "*arg-env*", &X_STAR_arg_env_STAR_, map[string]interface{}{"dynamic": true, "private": true})
+	cljs_core.Intern_("cljs.reader", "*features*", &X_STAR_features_STAR_, map[string]interface{}{"doc": "The features the branches of reader conditionals are picked by.", "dynamic": true})
+	cljs_core.Intern_("cljs.reader", "*read-cond*", &X_STAR_read_cond_STAR_, map[string]interface{}{"doc": "How reader conditionals are read. :allow reads the form of the first\nfeature in *features*, or :default, that matches, :preserve reads them\nas ReaderConditionals and nil, the default, throws.", "dynamic": true})
+	cljs_core.Intern_("cljs.reader", "*read-eval*", &X_STAR_read_eval_STAR_, map[string]interface{}{"doc": "A fn #=form is read as the result of calling with form, like eval, or\nnil, the default, to throw on #=.", "dynamic": true})
+	cljs_core.Intern_("cljs.reader", "*suppress-read*", &X_STAR_suppress_read_STAR_, map[string]interface{}{"dynamic": true, "private": true})
+	cljs_core.Intern_("cljs.reader", "*tag-table*", &X_STAR_tag_table_STAR_, map[string]interface{}{"dynamic": true})
+	cljs_core.Intern_("cljs.reader", "->IndexingPushbackReader", &X__GT_IndexingPushbackReader, map[string]interface{}{"arglists": [][]string{{"rdr", "line", "column", "line-start?", "prev", "prev-column", "file-name"}}})
+	cljs_core.Intern_("cljs.reader", "->ReaderConditional", &X__GT_ReaderConditional, map[string]interface{}{"arglists": [][]string{{"form", "splicing?"}}})
+	cljs_core.Intern_("cljs.reader", "->Splice", &X__GT_Splice, map[string]interface{}{"arglists": [][]string{{"forms"}}})
+	cljs_core.Intern_("cljs.reader", "core-macros", &Core_macros, map[string]interface{}{"doc": "The cljs.core macros, which syntax-quote qualifies like the vars of\ncljs.core although they aren't vars at run time.", "private": true})
+	cljs_core.Intern_("cljs.reader", "days-in-month", &Days_in_month, map[string]interface{}{"private": true})
+	cljs_core.Intern_("cljs.reader", "dispatch-macros", &Dispatch_macros, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("cljs.reader", "garg", &Garg, map[string]interface{}{"arglists": [][]string{{"n"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "get-column-number", &Get_column_number, map[string]interface{}{"arglists": [][]string{{"reader"}}})
+	cljs_core.Intern_("cljs.reader", "get-file-name", &Get_file_name, map[string]interface{}{"arglists": [][]string{{"reader"}}})
+	cljs_core.Intern_("cljs.reader", "get-line-number", &Get_line_number, map[string]interface{}{"arglists": [][]string{{"reader"}}})
+	cljs_core.Intern_("cljs.reader", "indexing-push-back-reader", &Indexing_push_back_reader, map[string]interface{}{"doc": "Returns a PushbackReader over s, a string, PushbackReader or io.Reader,\nwhich tracks the line and column of what it reads. Collections and\nsymbols read from it have :line, :column, :end-line and :end-column\nmetadata, and :file if file-name is given. Errors include the position.", "arglists": [][]string{{"s"}, {"s", "file-name"}}})
+	cljs_core.Intern_("cljs.reader", "macro-terminating?", &Macro_terminating_QMARK_, map[string]interface{}{"arglists": [][]string{{"ch"}}})
+	cljs_core.Intern_("cljs.reader", "macros", &Macros, map[string]interface{}{"arglists": [][]string{{"c"}}})
+	cljs_core.Intern_("cljs.reader", "maybe-read-tagged-type", &Maybe_read_tagged_type, map[string]interface{}{"arglists": [][]string{{"rdr", "initch"}}})
+	cljs_core.Intern_("cljs.reader", "namespace-keys", &Namespace_keys, map[string]interface{}{"arglists": [][]string{{"ns", "ks"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "parse-and-validate-timestamp", &Parse_and_validate_timestamp, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("cljs.reader", "parse-timestamp", &Parse_timestamp, map[string]interface{}{"arglists": [][]string{{"ts"}}})
+	cljs_core.Intern_("cljs.reader", "read", &Read, map[string]interface{}{"doc": "Reads the first object from a PushbackReader. Returns the object read.\nIf EOF, throws if eof-is-error is true. Otherwise returns sentinel.\nGiven an opts map, returns the value of :eof on EOF, or throws if there\nis no :eof. Reader conditionals are read as given by :read-cond and\n:features, see *read-cond*.", "arglists": [][]string{{"reader"}, {"opts", "reader"}, {"reader", "eof-is-error", "sentinel", "is-recursive"}}})
+	cljs_core.Intern_("cljs.reader", "read-2-chars", &Read_2_chars, map[string]interface{}{"arglists": [][]string{{"reader"}}})
+	cljs_core.Intern_("cljs.reader", "read-4-chars", &Read_4_chars, map[string]interface{}{"arglists": [][]string{{"reader"}}})
+	cljs_core.Intern_("cljs.reader", "read-arg", &Read_arg, map[string]interface{}{"arglists": [][]string{{"rdr", "pct"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-cond", &Read_cond, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-cond-branches", &Read_cond_branches, map[string]interface{}{"doc": "Reads the feature and form pairs of a reader conditional up to the\nclosing paren, returning the form of the first matching feature, or rdr\nif none does. The other forms are read with unknown tags ignored.", "arglists": [][]string{{"rdr"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-date", &Read_date, map[string]interface{}{"arglists": [][]string{{"s"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-delimited-list", &Read_delimited_list, map[string]interface{}{"arglists": [][]string{{"delim", "rdr", "recursive?"}}})
+	cljs_core.Intern_("cljs.reader", "read-eval", &Read_eval, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-fn", &Read_fn, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-instant-time", &Read_instant_time, map[string]interface{}{"doc": "Reads the timestamp string s of an #inst as a UTC time.Time, keeping\nall its nanoseconds. #inst is read as a js/Date unless this is its tag\nparser: (register-tag-parser! \"inst\" read-instant-time)", "arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("cljs.reader", "read-list", &Read_list, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}})
+	cljs_core.Intern_("cljs.reader", "read-map", &Read_map, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}})
+	cljs_core.Intern_("cljs.reader", "read-namespaced-map", &Read_namespaced_map, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-queue", &Read_queue, map[string]interface{}{"arglists": [][]string{{"elems"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-seq", &Read_seq, map[string]interface{}{"doc": "Returns a lazy sequence of the objects read from reader, a PushbackReader\nor an io.Reader. The input is read as the sequence is realized. Given an\nopts map, the objects are read like read does.", "arglists": [][]string{{"reader"}, {"opts", "reader"}}})
+	cljs_core.Intern_("cljs.reader", "read-set", &Read_set, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}})
+	cljs_core.Intern_("cljs.reader", "read-string", &Read_string, map[string]interface{}{"doc": "Reads one object from the string s. Given an opts map, reads it like\nread does.", "arglists": [][]string{{"s"}, {"opts", "s"}}})
+	cljs_core.Intern_("cljs.reader", "read-symbol", &Read_symbol, map[string]interface{}{"arglists": [][]string{{"reader", "initch"}}})
+	cljs_core.Intern_("cljs.reader", "read-symbolic-value", &Read_symbolic_value, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-syntax-quote", &Read_syntax_quote, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-token", &Read_token, map[string]interface{}{"arglists": [][]string{{"rdr", "initch"}}})
+	cljs_core.Intern_("cljs.reader", "read-unquote", &Read_unquote, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-uuid", &Read_uuid, map[string]interface{}{"arglists": [][]string{{"uuid"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-var", &Read_var, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "read-vector", &Read_vector, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}})
+	cljs_core.Intern_("cljs.reader", "reader-conditional", &Reader_conditional, map[string]interface{}{"doc": "Returns a reader conditional of form, a list, like #? or #?@ if\nsplicing? is true, read with :read-cond :preserve.", "arglists": [][]string{{"form", "splicing?"}}})
+	cljs_core.Intern_("cljs.reader", "reader-conditional?", &Reader_conditional_QMARK_, map[string]interface{}{"doc": "Returns true if x is a reader conditional.", "arglists": [][]string{{"x"}}})
+	cljs_core.Intern_("cljs.reader", "reader-error", &Reader_error, map[string]interface{}{"arglists": [][]string{{"rdr", "&", "msg"}}})
+	cljs_core.Intern_("cljs.reader", "register-arg", &Register_arg, map[string]interface{}{"doc": "Returns the parameter for %n in the enclosing #(), -1 standing for %&.", "arglists": [][]string{{"n"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "register-gensym", &Register_gensym, map[string]interface{}{"doc": "Returns the auto-gensym for sym, foo# becoming foo__123__auto__, which\nis the same for each occurrence in a syntax-quoted form.", "arglists": [][]string{{"env", "sym"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "resolve-symbol", &Resolve_symbol, map[string]interface{}{"doc": "Returns sym qualified by the namespace of the var it resolves to in\n*ns*, by the namespace its alias stands for, by cljs.core if it names\none of its macros, or by *ns* itself.", "arglists": [][]string{{"sym"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "start-position", &Start_position, map[string]interface{}{"doc": "Returns the line and column of the character just read from rdr, or of\nthe nth last one, or nil unless rdr is an IndexingReader.", "arglists": [][]string{{"rdr"}, {"rdr", "n"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "syntax-quote*", &Syntax_quote_STAR_, map[string]interface{}{"arglists": [][]string{{"env", "form"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "syntax-quote-coll", &Syntax_quote_coll, map[string]interface{}{"doc": "Expands the items of coll into (seq (concat ...)), applying f to the\nresult unless it's nil.", "arglists": [][]string{{"env", "f", "coll"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "unquote-splicing?", &Unquote_splicing_QMARK_, map[string]interface{}{"arglists": [][]string{{"form"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "unquote?", &Unquote_QMARK_, map[string]interface{}{"arglists": [][]string{{"form"}}, "private": true})
+	cljs_core.Intern_("cljs.reader", "with-position", &With_position, map[string]interface{}{"doc": "Returns o with the position from start to where rdr is now as metadata.", "arglists": [][]string{{"rdr", "start", "o"}}, "private": true})
+}
+
+// How reader conditionals are read. :allow reads the form of the first
+// feature in *features*, or :default, that matches, :preserve reads them
+// as ReaderConditionals and nil, the default, throws.
+var X_STAR_read_cond_STAR_ interface{}
+
+// The features the branches of reader conditionals are picked by.
+var X_STAR_features_STAR_ *cljs_core.CljsCorePersistentHashSet
+
+var X_STAR_suppress_read_STAR_ bool
+
+type CljsReaderReaderConditional struct {
+	Form            interface{}
+	Splicing_QMARK_ interface{}
+}
+
+func (_ *CljsReaderReaderConditional) CljsCoreIEquiv__() {}
+func (___ *CljsReaderReaderConditional) X_equiv_Arity2(other interface{}) bool {
+	return (cljs_core.Value_(other).Type().AssignableTo(reflect.TypeOf((**CljsReaderReaderConditional)(nil)).Elem())) && (cljs_core.X_EQ_.Arity2IIB(___.Form, cljs_core.Native_get_instance_field.X_invoke_Arity2(other, "Form"))) && (cljs_core.X_EQ_.Arity2IIB(___.Splicing_QMARK_, cljs_core.Native_get_instance_field.X_invoke_Arity2(other, "Splicing_QMARK_")))
+}
+
+func (_ *CljsReaderReaderConditional) CljsCoreIHash__() {}
+func (___ *CljsReaderReaderConditional) X_hash_Arity1() interface{} {
+	return cljs_core.Hash.X_invoke_Arity1((&cljs_core.CljsCorePersistentVector{nil, float64(2), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{___.Form, ___.Splicing_QMARK_}, nil}))
+}
+
+func (_ *CljsReaderReaderConditional) CljsCoreILookup__() {}
+func (this *CljsReaderReaderConditional) X_lookup_Arity2(k interface{}) interface{} {
+	return this.X_lookup_Arity3(k, nil)
+}
+
+func (___ *CljsReaderReaderConditional) X_lookup_Arity3(k interface{}, not_found interface{}) interface{} {
+	if cljs_core.X_EQ_.Arity2IIB(k, (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "form", Fqn: "form", X_hash: float64(-1624062471)})) {
+		return ___.Form
+	} else {
+		if cljs_core.X_EQ_.Arity2IIB(k, (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "splicing?", Fqn: "splicing?", X_hash: float64(-428596366)})) {
+			return ___.Splicing_QMARK_
+		} else {
+			return not_found
+		}
+	}
+}
+
+func (_ *CljsReaderReaderConditional) CljsCoreIPrintWithWriter__() {}
+func (___ *CljsReaderReaderConditional) X_pr_writer_Arity3(writer interface{}, opts interface{}) interface{} {
+	cljs_core.Decorate_(writer).(cljs_core.CljsCoreIWriter).X_write_Arity2(func() interface{} {
+		if cljs_core.Truth_(___.Splicing_QMARK_) {
+			return "#?@"
+		} else {
+			return "#?"
+		}
+	}())
+	return cljs_core.Pr_writer.X_invoke_Arity3(___.Form, writer, opts)
+}
+
+var X__GT_ReaderConditional *cljs_core.AFn
+
+// Returns a reader conditional of form, a list, like #? or #?@ if
+// splicing? is true, read with :read-cond :preserve.
+var Reader_conditional *cljs_core.AFn
+
+// Returns true if x is a reader conditional.
+var Reader_conditional_QMARK_ *cljs_core.AFn
+
+type CljsReaderSplice struct{ Forms interface{} }
+
+var X__GT_Splice *cljs_core.AFn
+
+type CljsReaderIndexingReader interface {
+	CljsReaderIndexingReader__()
+	Get_line_number_Arity1() interface{}
+	Get_column_number_Arity1() interface{}
+	Get_file_name_Arity1() interface{}
+}
+
+var Get_line_number *cljs_core.AFn
+
+var Get_column_number *cljs_core.AFn
+
+var Get_file_name *cljs_core.AFn
+
+type CljsReaderIndexingPushbackReader struct {
+	Rdr               interface{}
+	Line              interface{}
+	Column            interface{}
+	Line_start_QMARK_ interface{}
+	Prev              interface{}
+	Prev_column       interface{}
+	File_name         interface{}
+}
+
+func (_ *CljsReaderIndexingPushbackReader) CljsReaderPushbackReader__() {}
+func (reader *CljsReaderIndexingPushbackReader) Read_char_Arity1() interface{} {
+	{
+		var ch = cljs_core.Decorate_(reader.Rdr).(CljsReaderPushbackReader).Read_char_Arity1()
+		_ = ch
+		if !(cljs_core.Nil_(ch)) {
+			reader.Prev = reader.Line_start_QMARK_
+
+			reader.Line_start_QMARK_ = reflect.DeepEqual(ch, "\n")
+
+			if cljs_core.Truth_(reader.Line_start_QMARK_) {
+				reader.Prev_column = reader.Column
+
+				reader.Column = float64(0)
+
+				reader.Line = (reader.Line.(float64) + float64(1))
+			} else {
+			}
+			reader.Column = (reader.Column.(float64) + float64(1))
+		} else {
+		}
+		return ch
+	}
+}
+
+func (reader *CljsReaderIndexingPushbackReader) Unread_Arity2(ch interface{}) interface{} {
+	if !(cljs_core.Nil_(ch)) {
+		if cljs_core.Truth_(reader.Line_start_QMARK_) {
+			reader.Line = (reader.Line.(float64) - float64(1))
+
+			reader.Column = reader.Prev_column
+		} else {
+			reader.Column = (reader.Column.(float64) - float64(1))
+		}
+		reader.Line_start_QMARK_ = reader.Prev
+	} else {
+	}
+	return cljs_core.Decorate_(reader.Rdr).(CljsReaderPushbackReader).Unread_Arity2(ch)
+}
+
+func (_ *CljsReaderIndexingPushbackReader) CljsReaderIndexingReader__() {}
+func (reader *CljsReaderIndexingPushbackReader) Get_line_number_Arity1() interface{} {
+	return reader.Line
+}
+
+func (reader *CljsReaderIndexingPushbackReader) Get_column_number_Arity1() interface{} {
+	return reader.Column
+}
+
+func (reader *CljsReaderIndexingPushbackReader) Get_file_name_Arity1() interface{} {
+	return reader.File_name
 }
 
+var X__GT_IndexingPushbackReader *cljs_core.AFn
+
+// Returns a PushbackReader over s, a string, PushbackReader or io.Reader,
+// which tracks the line and column of what it reads. Collections and
+// symbols read from it have :line, :column, :end-line and :end-column
+// metadata, and :file if file-name is given. Errors include the position.
+var Indexing_push_back_reader *cljs_core.AFn
+
+// Returns the line and column of the character just read from rdr, or of
+// the nth last one, or nil unless rdr is an IndexingReader.
+var Start_position *cljs_core.AFn
+
+// Returns o with the position from start to where rdr is now as metadata.
+var With_position *cljs_core.AFn
+
+var Macro_terminating_QMARK_ *cljs_core.AFn
+
+// @param {...*} var_args
+var Reader_error *cljs_core.AFn
+
 var Read_2_chars *cljs_core.AFn
 
 var Read_4_chars *cljs_core.AFn
 
 var Read_token *cljs_core.AFn
 
+var Read_symbol *cljs_core.AFn
+
+var Read_list *cljs_core.AFn
+
+var Read_vector *cljs_core.AFn
+
+var Read_map *cljs_core.AFn
+
+var Read_set *cljs_core.AFn
+
+var Read_unquote *cljs_core.AFn
+
+var Unquote_QMARK_ *cljs_core.AFn
+
+var Unquote_splicing_QMARK_ *cljs_core.AFn
+
+// Expands the items of coll into (seq (concat ...)), applying f to the
+// result unless it's nil.
+var Syntax_quote_coll *cljs_core.AFn
+
+// The cljs.core macros, which syntax-quote qualifies like the vars of
+// cljs.core although they aren't vars at run time.
+var Core_macros *cljs_core.CljsCorePersistentHashSet
+
+// Returns sym qualified by the namespace of the var it resolves to in
+// *ns*, by the namespace its alias stands for, by cljs.core if it names
+// one of its macros, or by *ns* itself.
+var Resolve_symbol *cljs_core.AFn
+
+// Returns the auto-gensym for sym, foo# becoming foo__123__auto__, which
+// is the same for each occurrence in a syntax-quoted form.
+var Register_gensym *cljs_core.AFn
+
+var Syntax_quote_STAR_ *cljs_core.AFn
+
+var Read_syntax_quote *cljs_core.AFn
+
+var X_STAR_arg_env_STAR_ interface{}
+
+// A fn #=form is read as the result of calling with form, like eval, or
+// nil, the default, to throw on #=.
+var X_STAR_read_eval_STAR_ interface{}
+
+var Garg *cljs_core.AFn
+
+// Returns the parameter for %n in the enclosing #(), -1 standing for %&.
+var Register_arg *cljs_core.AFn
+
+var Read_arg *cljs_core.AFn
+
+var Read_fn *cljs_core.AFn
+
+var Read_var *cljs_core.AFn
+
+var Read_eval *cljs_core.AFn
+
+var Namespace_keys *cljs_core.AFn
+
+var Read_namespaced_map *cljs_core.AFn
+
+var Read_symbolic_value *cljs_core.AFn
+
+// Reads the feature and form pairs of a reader conditional up to the
+// closing paren, returning the form of the first matching feature, or rdr
+// if none does. The other forms are read with unknown tags ignored.
+var Read_cond_branches *cljs_core.AFn
+
+var Read_cond *cljs_core.AFn
+
+var Read_delimited_list *cljs_core.AFn
+
 var Macros *cljs_core.AFn
 
+var Dispatch_macros *cljs_core.AFn
+
+// Reads the first object from a PushbackReader. Returns the object read.
+// If EOF, throws if eof-is-error is true. Otherwise returns sentinel.
+// Given an opts map, returns the value of :eof on EOF, or throws if there
+// is no :eof. Reader conditionals are read as given by :read-cond and
+// :features, see *read-cond*.
+var Read *cljs_core.AFn
+
+// Returns a lazy sequence of the objects read from reader, a PushbackReader
+// or an io.Reader. The input is read as the sequence is realized. Given an
+// opts map, the objects are read like read does.
+var Read_seq *cljs_core.AFn
+
+// Reads one object from the string s. Given an opts map, reads it like
+// read does.
+var Read_string *cljs_core.AFn
+
 var Days_in_month interface{}
 
+var Parse_and_validate_timestamp *cljs_core.AFn
+
 var Parse_timestamp *cljs_core.AFn
 
 var Read_queue *cljs_core.AFn
 
 var Read_date *cljs_core.AFn
 
+// Reads the timestamp string s of an #inst as a UTC time.Time, keeping
+// all its nanoseconds. #inst is read as a js/Date unless this is its tag
+// parser: (register-tag-parser! "inst" read-instant-time)
+var Read_instant_time *cljs_core.AFn
+
 var Read_uuid *cljs_core.AFn
 
 var X_STAR_tag_table_STAR_ *cljs_core.CljsCoreAtom
+
+var Maybe_read_tagged_type *cljs_core.AFn
@@ -0,0 +1,112 @@
+package reader
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	"github.com/hraberg/cljs2go/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func prStr(x interface{}) string {
+	return cljs_core.Pr_str.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{x})).(string)
+}
+
+func Test_SyntaxQuote(t *testing.T) {
+	assert.Equal(t, "(cljs.core/seq (cljs.core/concat (cljs.core/list (quote cljs.core/map)) (cljs.core/list x) xs))", prStr(readString("`(map ~x ~@xs)")))
+	assert.Equal(t, "(quote if)", prStr(readString("`if")))
+	assert.Equal(t, "(quote cljs.user/foo)", prStr(readString("`foo")))
+	assert.Equal(t, "(quote cljs.core/when)", prStr(readString("`when")))
+	assert.Equal(t, "(quote cljs.core/->)", prStr(readString("`->")))
+	assert.Equal(t, "(quote .toString)", prStr(readString("`.toString")))
+	assert.Equal(t, "(cljs.core/list)", prStr(readString("`()")))
+	assert.Equal(t, `[:a 1 "b" nil true]`, prStr(readString(`[`+"`:a `1 `\"b\" `nil `true]")))
+	assert.Equal(t, "(cljs.core/apply cljs.core/vector (cljs.core/seq (cljs.core/concat (cljs.core/list 1) xs)))", prStr(readString("`[1 ~@xs]")))
+	assert.Equal(t, "(cljs.core/apply cljs.core/hash-set (cljs.core/seq (cljs.core/concat (cljs.core/list x))))", prStr(readString("`#{~x}")))
+	assert.Equal(t, "(cljs.core/apply cljs.core/hash-map (cljs.core/seq (cljs.core/concat (cljs.core/list :a) (cljs.core/list x))))", prStr(readString("`{:a ~x}")))
+
+	concat := cljs_core.Nth.X_invoke_Arity2(cljs_core.Nth.X_invoke_Arity2(readString("`[x# x# y#]"), 2.0), 1.0)
+	gensym := func(n float64) string {
+		quoted := cljs_core.Second.X_invoke_Arity1(cljs_core.Nth.X_invoke_Arity2(concat, n))
+		return cljs_core.Second.X_invoke_Arity1(quoted).(*cljs_core.CljsCoreSymbol).Name.(string)
+	}
+	assert.True(t, strings.HasPrefix(gensym(1), "x__") && strings.HasSuffix(gensym(1), "__auto__"))
+	assert.Equal(t, gensym(1), gensym(2))
+	assert.True(t, strings.HasPrefix(gensym(3), "y__"))
+
+	assert.Equal(t, "(clojure.core/unquote x)", prStr(readString("~x")))
+	assert.Equal(t, "(clojure.core/unquote-splicing x)", prStr(readString("~@x")))
+	assert.Panics(t, func() { readString("`~@x") })
+}
+
+func Test_ReaderConditionals(t *testing.T) {
+	read := func(opts string, s string) string {
+		return prStr(Read_string.X_invoke_Arity2(readString(opts), s))
+	}
+	assert.Equal(t, "[1 :go]", read("{:read-cond :allow}", "[1 #?(:cljs :cljs :go :go :default :default)]"))
+	assert.Equal(t, "[1 :default]", read("{:read-cond :allow}", "[1 #?(:cljs :cljs :default :default)]"))
+	assert.Equal(t, "[1 2]", read("{:read-cond :allow}", "[1 #?(:cljs #js {}) 2]"))
+	assert.Equal(t, "[1 :cljs]", read("{:read-cond :allow :features #{:cljs}}", "[1 #?(:go :go :cljs :cljs)]"))
+	assert.Equal(t, "[1 :cljs]", read("{:read-cond :allow :features [:cljs]}", "[1 #?(:go :go :cljs :cljs)]"))
+	assert.Equal(t, "(1 2 3 4)", read("{:read-cond :allow}", "(1 #?@(:go [2 3] :cljs [5]) 4)"))
+	assert.Equal(t, "{:a 1}", read("{:read-cond :allow}", "{#?@(:go [:a 1])}"))
+	assert.Equal(t, "[]", read("{:read-cond :allow}", "[#?@(:cljs [1])]"))
+	assert.Equal(t, "[#?(:go 1 :cljs 2) #?@(:go [3])]", read("{:read-cond :preserve}", "[#?(:go 1 :cljs 2) #?@(:go [3])]"))
+
+	rc := Read_string.X_invoke_Arity2(readString("{:read-cond :preserve}"), "#?@(:go [3])")
+	assert.True(t, Reader_conditional_QMARK_.X_invoke_Arity1(rc).(bool))
+	assert.Equal(t, true, cljs_core.Get.X_invoke_Arity2(rc, readString(":splicing?")))
+	assert.True(t, cljs_core.X_EQ_.X_invoke_Arity2(readString("(:go [3])"), cljs_core.Get.X_invoke_Arity2(rc, readString(":form"))).(bool))
+	assert.True(t, cljs_core.X_EQ_.X_invoke_Arity2(Reader_conditional.X_invoke_Arity2(readString("(:go [3])"), true), rc).(bool))
+
+	forms := Read_seq.X_invoke_Arity2(readString("{:read-cond :allow}"), strings.NewReader("#?(:cljs 1) #?(:go 2) 3"))
+	assert.Equal(t, "(2 3)", prStr(forms))
+
+	assert.Panics(t, func() { readString("#?(:go 1)") })
+	assert.Panics(t, func() { read("{:read-cond :allow}", "#?@(:go [1])") })
+	assert.Panics(t, func() { read("{:read-cond :allow}", "#?(:go)") })
+	assert.Panics(t, func() { read("{:read-cond :allow}", "#?(go 1)") })
+	assert.Panics(t, func() { read("{:read-cond :allow}", "#?[:go 1]") })
+	assert.Panics(t, func() { read("{:read-cond :allow}", "[#?@(:go 1)]") })
+	assert.Panics(t, func() { read("{:read-cond :allow}", "[#?(:go #js {})]") })
+}
+
+func Test_DispatchForms(t *testing.T) {
+	fn := readString("#(+ % %2 %&)")
+	params := cljs_core.Second.X_invoke_Arity1(fn)
+	assert.Equal(t, 4.0, cljs_core.Count.X_invoke_Arity1(params))
+	assert.Equal(t, "&", prStr(cljs_core.Nth.X_invoke_Arity2(params, 2.0)))
+	body := cljs_core.Nth.X_invoke_Arity2(fn, 2.0)
+	assert.True(t, cljs_core.X_EQ_.X_invoke_Arity2(cljs_core.Rest.X_invoke_Arity1(body), cljs_core.Seq.X_invoke_Arity1(cljs_core.Remove.X_invoke_Arity2(readString("#{&}"), params))).(bool))
+	assert.True(t, strings.HasPrefix(prStr(cljs_core.First.X_invoke_Arity1(params)), "p1__"))
+	assert.True(t, strings.HasPrefix(prStr(cljs_core.Nth.X_invoke_Arity2(params, 3.0)), "rest__"))
+	assert.Equal(t, "(fn* [] (rand))", prStr(readString("#(rand)")))
+	assert.Equal(t, 3.0, cljs_core.Count.X_invoke_Arity1(cljs_core.Second.X_invoke_Arity1(readString("#(vector %3)"))))
+	assert.Equal(t, "[% foo%bar]", prStr(readString("[% foo%bar]")))
+	assert.Panics(t, func() { readString("#(#(%))") })
+	assert.Panics(t, func() { readString("#(%a)") })
+
+	assert.Equal(t, "(var foo/bar)", prStr(readString("#'foo/bar")))
+
+	assert.Panics(t, func() { readString("#=(+ 1 2)") })
+	cljs_core.Push_thread_bindings.X_invoke_Arity1(cljs_core.Hash_map.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"cljs.reader/*read-eval*", cljs_core.Count})))
+	assert.Equal(t, 3.0, readString("#=(+ 1 2)"))
+	cljs_core.Pop_thread_bindings.X_invoke_Arity0()
+
+	assert.True(t, cljs_core.X_EQ_.X_invoke_Arity2(readString(`{:a/x 1 :y 2 :b/z 3 a/s "s" 4 5}`), readString(`#:a{:x 1 :_/y 2 :b/z 3 s "s" 4 5}`)).(bool))
+	assert.Equal(t, "{:cljs.user/x 1}", prStr(readString("#::{:x 1}")))
+	assert.Equal(t, "{:cljs.core/x 1}", prStr(readString("#::cljs.core {:x 1}")))
+	assert.Equal(t, []interface{}{1.0, 2.0, 1.0, 11.0, nil}, position(Read.X_invoke_Arity1(Indexing_push_back_reader.X_invoke_Arity1(" #:a{:x 1}"))))
+	assert.Panics(t, func() { readString("#:{:x 1}") })
+	assert.Panics(t, func() { readString("#:a/b{:x 1}") })
+	assert.Panics(t, func() { readString("#::nope{:x 1}") })
+	assert.Panics(t, func() { readString("#:a[1 2]") })
+	assert.Panics(t, func() { readString("#:a{:x}") })
+
+	assert.Equal(t, js.Infinity, readString("##Inf"))
+	assert.Equal(t, -js.Infinity, readString("##-Inf"))
+	assert.True(t, math.IsNaN(readString("##NaN").(float64)))
+	assert.Panics(t, func() { readString("##Foo") })
+}
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// Edited by hand since it was compiled, to follow the compiler changes made for the reader. Regenerate it with lein compile-clojurescript.
 // cljs.reader
 
 package reader
@@ -82,49 +83,6 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
-	Reader_error = func(reader_error *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(reader_error, 1, func(rdr_msg__ ...interface{}) interface{} {
-			var rdr = rdr_msg__[0]
-			var msg = cljs_core.Seq.Arity1IQ(rdr_msg__[1])
-			_, _ = rdr, msg
-			panic((&js.Error{cljs_core.Apply.X_invoke_Arity2(cljs_core.Str, msg)}))
-		})
-	}(&cljs_core.AFn{})
-
-	Macro_terminating_QMARK_ = func(macro_terminating_QMARK_ *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(macro_terminating_QMARK_, 1, func(ch interface{}) bool {
-			{
-				var and__159__auto__ = !(reflect.DeepEqual(ch, "#"))
-				_ = and__159__auto__
-				if cljs_core.Truth_(and__159__auto__) {
-					{
-						var and__159__auto_____1 = !(reflect.DeepEqual(ch, "'"))
-						_ = and__159__auto_____1
-						if cljs_core.Truth_(and__159__auto_____1) {
-							{
-								var and__159__auto_____2 = !(reflect.DeepEqual(ch, ":"))
-								_ = and__159__auto_____2
-								if cljs_core.Truth_(and__159__auto_____2) {
-									{
-										var G__14 = ch
-										_ = G__14
-										return cljs_core.Truth_(Macros.X_invoke_Arity1(G__14))
-									}
-								} else {
-									return and__159__auto_____2
-								}
-							}
-						} else {
-							return and__159__auto_____1
-						}
-					}
-				} else {
-					return and__159__auto__
-				}
-			}
-		})
-	}(&cljs_core.AFn{})
-
 	Skip_line = func(skip_line *cljs_core.AFn) *cljs_core.AFn {
 		return cljs_core.Fn(skip_line, 2, func(reader interface{}, ___ interface{}) interface{} {
 			{
@@ -406,81 +364,6 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
-	Read_delimited_list = func(read_delimited_list *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(read_delimited_list, 3, func(delim interface{}, rdr interface{}, recursive_QMARK_ interface{}) interface{} {
-			{
-				var a interface{} = cljs_core.Transient.X_invoke_Arity1(cljs_core.CljsCorePersistentVector_EMPTY)
-				_ = a
-				for {
-					{
-						var ch = Read_past.X_invoke_Arity2(Whitespace_QMARK_, rdr)
-						_ = ch
-						if cljs_core.Truth_(ch) {
-						} else {
-							Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"EOF while reading"}))
-						}
-						if reflect.DeepEqual(delim, ch) {
-							return cljs_core.Persistent_BANG_.X_invoke_Arity1(a)
-						} else {
-							{
-								var temp__4386__auto__ = func() interface{} {
-									var G__46 = ch
-									_ = G__46
-									return Macros.X_invoke_Arity1(G__46)
-								}()
-								_ = temp__4386__auto__
-								if cljs_core.Truth_(temp__4386__auto__) {
-									{
-										var macrofn = temp__4386__auto__
-										_ = macrofn
-										{
-											var mret = func() interface{} {
-												var G__47 = rdr
-												var G__48 = ch
-												_, _ = G__47, G__48
-												return macrofn.(cljs_core.CljsCoreIFn).X_invoke_Arity2(G__47, G__48)
-											}()
-											_ = mret
-											a = func() interface{} {
-												if reflect.DeepEqual(mret, rdr) {
-													return a
-												} else {
-													return cljs_core.Conj_BANG_.X_invoke_Arity2(a, mret)
-												}
-											}()
-											continue
-										}
-									}
-								} else {
-									cljs_core.Decorate_(rdr).(CljsReaderPushbackReader).Unread_Arity2(ch)
-									{
-										var o = func() interface{} {
-											var G__49 = rdr
-											var G__50 = true
-											var G__51 interface{} = nil
-											var G__52 = recursive_QMARK_
-											_, _, _, _ = G__49, G__50, G__51, G__52
-											return Read.X_invoke_Arity4(G__49, G__50, G__51, G__52)
-										}()
-										_ = o
-										a = func() interface{} {
-											if reflect.DeepEqual(o, rdr) {
-												return a
-											} else {
-												return cljs_core.Conj_BANG_.X_invoke_Arity2(a, o)
-											}
-										}()
-										continue
-									}
-								}
-							}
-						}
-					}
-				}
-			}
-		})
-	}(&cljs_core.AFn{})
-
 	Not_implemented = func(not_implemented *cljs_core.AFn) *cljs_core.AFn {
 		return cljs_core.Fn(not_implemented, 2, func(rdr interface{}, ch interface{}) interface{} {
 			return Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Reader for ", ch, " not implemented yet"}))
@@ -534,34 +417,8 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
-	Read_list = func(read_list *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(read_list, 2, func(rdr interface{}, ___ interface{}) interface{} {
-			return cljs_core.Apply.X_invoke_Arity2(cljs_core.List, Read_delimited_list.X_invoke_Arity3(")", rdr, true))
-		})
-	}(&cljs_core.AFn{})
-
 	Read_comment = Skip_line
 
-	Read_vector = func(read_vector *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(read_vector, 2, func(rdr interface{}, ___ interface{}) interface{} {
-			return Read_delimited_list.X_invoke_Arity3("]", rdr, true)
-		})
-	}(&cljs_core.AFn{})
-
-	Read_map = func(read_map *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(read_map, 2, func(rdr interface{}, ___ interface{}) interface{} {
-			{
-				var l = Read_delimited_list.X_invoke_Arity3("}", rdr, true)
-				_ = l
-				if cljs_core.Odd_QMARK_.Arity1IB(cljs_core.Count.X_invoke_Arity1(l).(float64)) {
-					Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Map literal must contain an even number of forms"}))
-				} else {
-				}
-				return cljs_core.Apply.X_invoke_Arity2(cljs_core.Hash_map, l)
-			}
-		})
-	}(&cljs_core.AFn{})
-
 	Read_number = func(read_number *cljs_core.AFn) *cljs_core.AFn {
 		return cljs_core.Fn(read_number, 2, func(reader interface{}, initch interface{}) interface{} {
 			{
@@ -716,25 +573,6 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
-	Read_symbol = func(read_symbol *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(read_symbol, 2, func(reader interface{}, initch interface{}) interface{} {
-			{
-				var token = Read_token.X_invoke_Arity2(reader, initch)
-				_ = token
-				if cljs_core.Truth_(func() interface{} {
-					var G__75 = token
-					var G__76 = "/"
-					_, _ = G__75, G__76
-					return cljs_core.Native_invoke_func.X_invoke_Arity2(goog_string.Contains, []interface{}{G__75, G__76})
-				}()) {
-					return cljs_core.Symbol.X_invoke_Arity2(cljs_core.Subs.X_invoke_Arity3(token, float64(0), cljs_core.Native_invoke_instance_method.X_invoke_Arity3(token, "IndexOf", []interface{}{"/"})), cljs_core.Subs.X_invoke_Arity3(token, (cljs_core.Native_invoke_instance_method.X_invoke_Arity3(token, "IndexOf", []interface{}{"/"}).(float64)+float64(1)), cljs_core.Native_get_instance_field.X_invoke_Arity2(token, "Length"))).(*cljs_core.CljsCoreSymbol)
-				} else {
-					return Special_symbols.X_invoke_Arity2(token, cljs_core.Symbol.X_invoke_Arity1(token))
-				}
-			}
-		})
-	}(&cljs_core.AFn{})
-
 	Read_keyword = func(read_keyword *cljs_core.AFn) *cljs_core.AFn {
 		return cljs_core.Fn(read_keyword, 2, func(reader interface{}, initch interface{}) interface{} {
 			{
@@ -839,12 +677,6 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
-	Read_set = func(read_set *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(read_set, 2, func(rdr interface{}, ___ interface{}) interface{} {
-			return cljs_core.Set.X_invoke_Arity1(Read_delimited_list.X_invoke_Arity3("}", rdr, true))
-		})
-	}(&cljs_core.AFn{})
-
 	Read_regex = func(read_regex *cljs_core.AFn) *cljs_core.AFn {
 		return cljs_core.Fn(read_regex, 2, func(rdr interface{}, ch interface{}) interface{} {
 			return cljs_core.Re_pattern.X_invoke_Arity1(Read_raw_string_STAR_.X_invoke_Arity2(rdr, ch))
@@ -865,106 +697,6 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
-	Dispatch_macros = func(dispatch_macros *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(dispatch_macros, 1, func(s interface{}) interface{} {
-			if reflect.DeepEqual(s, "{") {
-				return Read_set
-			} else {
-				if reflect.DeepEqual(s, "<") {
-					return Throwing_reader.X_invoke_Arity1("Unreadable form").(cljs_core.CljsCoreIFn)
-				} else {
-					if reflect.DeepEqual(s, "\"") {
-						return Read_regex
-					} else {
-						if reflect.DeepEqual(s, "!") {
-							return Read_comment
-						} else {
-							if reflect.DeepEqual(s, "_") {
-								return Read_discard
-							} else {
-								return nil
-
-							}
-						}
-					}
-				}
-			}
-		})
-	}(&cljs_core.AFn{})
-
-	Read = func(read *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(read, 4, func(reader interface{}, eof_is_error interface{}, sentinel interface{}, is_recursive interface{}) interface{} {
-			for {
-				{
-					var ch = cljs_core.Decorate_(reader).(CljsReaderPushbackReader).Read_char_Arity1()
-					_ = ch
-					if cljs_core.Nil_(ch) {
-						if cljs_core.Truth_(eof_is_error) {
-							return Reader_error.X_invoke_ArityVariadic(reader, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"EOF while reading"}))
-						} else {
-							return sentinel
-						}
-					} else {
-						if Whitespace_QMARK_.Arity1IB(ch) {
-							reader, eof_is_error, sentinel, is_recursive = reader, eof_is_error, sentinel, is_recursive
-							continue
-						} else {
-							if Comment_prefix_QMARK_.Arity1IB(ch) {
-								reader, eof_is_error, sentinel, is_recursive = func() interface{} {
-									var G__119 = reader
-									var G__120 = ch
-									_, _ = G__119, G__120
-									return Read_comment.(cljs_core.CljsCoreIFn).X_invoke_Arity2(G__119, G__120)
-								}(), eof_is_error, sentinel, is_recursive
-								continue
-							} else {
-								{
-									var f = Macros.X_invoke_Arity1(ch)
-									var res = func() interface{} {
-										if cljs_core.Truth_(f) {
-											return func() interface{} {
-												var G__121 = reader
-												var G__122 = ch
-												_, _ = G__121, G__122
-												return f.(cljs_core.CljsCoreIFn).X_invoke_Arity2(G__121, G__122)
-											}()
-										} else {
-											return func() interface{} {
-												if Number_literal_QMARK_.Arity2IIB(reader, ch) {
-													return Read_number.X_invoke_Arity2(reader, ch)
-												} else {
-													return Read_symbol.X_invoke_Arity2(reader, ch)
-												}
-											}()
-										}
-									}()
-									_, _ = f, res
-									if reflect.DeepEqual(res, reader) {
-										reader, eof_is_error, sentinel, is_recursive = reader, eof_is_error, sentinel, is_recursive
-										continue
-									} else {
-										return res
-									}
-								}
-
-							}
-						}
-					}
-				}
-			}
-		})
-	}(&cljs_core.AFn{})
-
-	Read_string = func(read_string *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(read_string, 1, func(s interface{}) interface{} {
-			{
-				var r = Push_back_reader.X_invoke_Arity1(s).(*CljsReaderStringPushbackReader)
-				_ = r
-				return Read.X_invoke_Arity4(r, false, nil, false)
-			}
-		})
-	}(&cljs_core.AFn{})
-
 	Zero_fill_right_and_truncate = func(zero_fill_right_and_truncate *cljs_core.AFn) *cljs_core.AFn {
 		return cljs_core.Fn(zero_fill_right_and_truncate, 2, func(s interface{}, width interface{}) interface{} {
 			if cljs_core.X_EQ_.Arity2IIB(width, cljs_core.Count.X_invoke_Arity1(s).(float64)) {
@@ -1044,159 +776,8 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
-	Parse_and_validate_timestamp = func(parse_and_validate_timestamp *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(parse_and_validate_timestamp, 1, func(s interface{}) interface{} {
-			{
-				var vec__132 = cljs_core.Re_matches.X_invoke_Arity2(Timestamp_regex, s)
-				var ___ = cljs_core.Nth.X_invoke_Arity3(vec__132, float64(0), nil)
-				var years = cljs_core.Nth.X_invoke_Arity3(vec__132, float64(1), nil)
-				var months = cljs_core.Nth.X_invoke_Arity3(vec__132, float64(2), nil)
-				var days = cljs_core.Nth.X_invoke_Arity3(vec__132, float64(3), nil)
-				var hours = cljs_core.Nth.X_invoke_Arity3(vec__132, float64(4), nil)
-				var minutes = cljs_core.Nth.X_invoke_Arity3(vec__132, float64(5), nil)
-				var seconds = cljs_core.Nth.X_invoke_Arity3(vec__132, float64(6), nil)
-				var fraction = cljs_core.Nth.X_invoke_Arity3(vec__132, float64(7), nil)
-				var offset_sign = cljs_core.Nth.X_invoke_Arity3(vec__132, float64(8), nil)
-				var offset_hours = cljs_core.Nth.X_invoke_Arity3(vec__132, float64(9), nil)
-				var offset_minutes = cljs_core.Nth.X_invoke_Arity3(vec__132, float64(10), nil)
-				var v = vec__132
-				_, _, _, _, _, _, _, _, _, _, _, _, _ = vec__132, ___, years, months, days, hours, minutes, seconds, fraction, offset_sign, offset_hours, offset_minutes, v
-				if cljs_core.Not.Arity1IB(v) {
-					return Reader_error.X_invoke_ArityVariadic(nil, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Unrecognized date/time syntax: ").(string), cljs_core.Str.X_invoke_Arity1(s).(string)}, ``)}))
-				} else {
-					{
-						var years___1 = Parse_int.X_invoke_Arity1(years)
-						var months___1 = func() interface{} {
-							var or__171__auto__ = Parse_int.X_invoke_Arity1(months)
-							_ = or__171__auto__
-							if cljs_core.Truth_(or__171__auto__) {
-								return or__171__auto__
-							} else {
-								return float64(1)
-							}
-						}()
-						var days___1 = func() interface{} {
-							var or__171__auto__ = Parse_int.X_invoke_Arity1(days)
-							_ = or__171__auto__
-							if cljs_core.Truth_(or__171__auto__) {
-								return or__171__auto__
-							} else {
-								return float64(1)
-							}
-						}()
-						var hours___1 = func() interface{} {
-							var or__171__auto__ = Parse_int.X_invoke_Arity1(hours)
-							_ = or__171__auto__
-							if cljs_core.Truth_(or__171__auto__) {
-								return or__171__auto__
-							} else {
-								return float64(0)
-							}
-						}()
-						var minutes___1 = func() interface{} {
-							var or__171__auto__ = Parse_int.X_invoke_Arity1(minutes)
-							_ = or__171__auto__
-							if cljs_core.Truth_(or__171__auto__) {
-								return or__171__auto__
-							} else {
-								return float64(0)
-							}
-						}()
-						var seconds___1 = func() interface{} {
-							var or__171__auto__ = Parse_int.X_invoke_Arity1(seconds)
-							_ = or__171__auto__
-							if cljs_core.Truth_(or__171__auto__) {
-								return or__171__auto__
-							} else {
-								return float64(0)
-							}
-						}()
-						var fraction___1 = func() interface{} {
-							var or__171__auto__ = Parse_int.X_invoke_Arity1(Zero_fill_right_and_truncate.X_invoke_Arity2(fraction, float64(3)))
-							_ = or__171__auto__
-							if cljs_core.Truth_(or__171__auto__) {
-								return or__171__auto__
-							} else {
-								return float64(0)
-							}
-						}()
-						var offset_sign___1 = func() float64 {
-							if cljs_core.X_EQ_.Arity2IIB(offset_sign, "-") {
-								return float64(-1)
-							} else {
-								return float64(1)
-							}
-						}()
-						var offset_hours___1 = func() interface{} {
-							var or__171__auto__ = Parse_int.X_invoke_Arity1(offset_hours)
-							_ = or__171__auto__
-							if cljs_core.Truth_(or__171__auto__) {
-								return or__171__auto__
-							} else {
-								return float64(0)
-							}
-						}()
-						var offset_minutes___1 = func() interface{} {
-							var or__171__auto__ = Parse_int.X_invoke_Arity1(offset_minutes)
-							_ = or__171__auto__
-							if cljs_core.Truth_(or__171__auto__) {
-								return or__171__auto__
-							} else {
-								return float64(0)
-							}
-						}()
-						var offset = (offset_sign___1 * ((offset_hours___1.(float64) * float64(60)) + offset_minutes___1.(float64)))
-						_, _, _, _, _, _, _, _, _, _, _ = years___1, months___1, days___1, hours___1, minutes___1, seconds___1, fraction___1, offset_sign___1, offset_hours___1, offset_minutes___1, offset
-						return (&cljs_core.CljsCorePersistentVector{nil, float64(8), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{years___1, Check.X_invoke_Arity4(float64(1), months___1, float64(12), "timestamp month field must be in range 1..12"), Check.X_invoke_Arity4(float64(1), days___1, func() interface{} {
-							var G__133 = months___1
-							var G__134 = cljs_core.Truth_(Leap_year_QMARK_.X_invoke_Arity1(years___1))
-							_, _ = G__133, G__134
-							return Days_in_month.(cljs_core.CljsCoreIFn).X_invoke_Arity2(G__133, G__134)
-						}(), "timestamp day field must be in range 1..last day in month"), Check.X_invoke_Arity4(float64(0), hours___1, float64(23), "timestamp hour field must be in range 0..23"), Check.X_invoke_Arity4(float64(0), minutes___1, float64(59), "timestamp minute field must be in range 0..59"), Check.X_invoke_Arity4(float64(0), seconds___1, func() float64 {
-							if cljs_core.X_EQ_.Arity2IIB(minutes___1, float64(59)) {
-								return float64(60)
-							} else {
-								return float64(59)
-							}
-						}(), "timestamp second field must be in range 0..60"), Check.X_invoke_Arity4(float64(0), fraction___1, float64(999), "timestamp millisecond field must be in range 0..999"), offset}, nil})
-					}
-				}
-			}
-		})
-	}(&cljs_core.AFn{})
-
 	X_STAR_default_data_reader_fn_STAR_ = cljs_core.Atom.X_invoke_Arity1(nil).(*cljs_core.CljsCoreAtom)
 
-	Maybe_read_tagged_type = func(maybe_read_tagged_type *cljs_core.AFn) *cljs_core.AFn {
-		return cljs_core.Fn(maybe_read_tagged_type, 2, func(rdr interface{}, initch interface{}) interface{} {
-			{
-				var tag = Read_symbol.X_invoke_Arity2(rdr, initch)
-				var pfn = cljs_core.Get.X_invoke_Arity2(cljs_core.Deref.X_invoke_Arity1(X_STAR_tag_table_STAR_), strings.Join([]string{cljs_core.Str.X_invoke_Arity1(tag).(string)}, ``))
-				var dfn = cljs_core.Deref.X_invoke_Arity1(X_STAR_default_data_reader_fn_STAR_)
-				_, _, _ = tag, pfn, dfn
-				if cljs_core.Truth_(pfn) {
-					{
-						var G__164 = Read.X_invoke_Arity4(rdr, true, nil, false)
-						_ = G__164
-						return pfn.(cljs_core.CljsCoreIFn).X_invoke_Arity1(G__164)
-					}
-				} else {
-					if cljs_core.Truth_(dfn) {
-						{
-							var G__165 = tag
-							var G__166 = Read.X_invoke_Arity4(rdr, true, nil, false)
-							_, _ = G__165, G__166
-							return dfn.(cljs_core.CljsCoreIFn).X_invoke_Arity2(G__165, G__166)
-						}
-					} else {
-						return Reader_error.X_invoke_ArityVariadic(rdr, cljs_core.Array_seq.X_invoke_Arity1([]interface{}{"Could not find tag parser for ", strings.Join([]string{cljs_core.Str.X_invoke_Arity1(tag).(string)}, ``), " in ", cljs_core.Pr_str.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{cljs_core.Keys.X_invoke_Arity1(cljs_core.Deref.X_invoke_Arity1(X_STAR_tag_table_STAR_))})).(string)}))
-
-					}
-				}
-			}
-		})
-	}(&cljs_core.AFn{})
-
 	Register_tag_parser_BANG_ = func(register_tag_parser_BANG_ *cljs_core.AFn) *cljs_core.AFn {
 		return cljs_core.Fn(register_tag_parser_BANG_, 2, func(tag interface{}, f interface{}) interface{} {
 			{
@@ -1251,6 +832,58 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("cljs.reader", "*default-data-reader-fn*", &X_STAR_default_data_reader_fn_STAR_, map[string]interface{}{"dynamic": true})
+	cljs_core.Intern_("cljs.reader", "->StringPushbackReader", &X__GT_StringPushbackReader, map[string]interface{}{"arglists": [][]string{{"s", "buffer", "idx"}}})
+	cljs_core.Intern_("cljs.reader", "check", &Check, map[string]interface{}{"arglists": [][]string{{"low", "n", "high", "msg"}}})
+	cljs_core.Intern_("cljs.reader", "comment-prefix?", &Comment_prefix_QMARK_, map[string]interface{}{"doc": "Checks whether the character begins a comment.", "arglists": [][]string{{"ch"}}})
+	cljs_core.Intern_("cljs.reader", "deregister-default-tag-parser!", &Deregister_default_tag_parser_BANG_, map[string]interface{}{"arglists": [][]string{{}}})
+	cljs_core.Intern_("cljs.reader", "deregister-tag-parser!", &Deregister_tag_parser_BANG_, map[string]interface{}{"arglists": [][]string{{"tag"}}})
+	cljs_core.Intern_("cljs.reader", "desugar-meta", &Desugar_meta, map[string]interface{}{"arglists": [][]string{{"f"}}})
+	cljs_core.Intern_("cljs.reader", "divisible?", &Divisible_QMARK_, map[string]interface{}{"arglists": [][]string{{"num", "div"}}})
+	cljs_core.Intern_("cljs.reader", "escape-char", &Escape_char, map[string]interface{}{"arglists": [][]string{{"buffer", "reader"}}})
+	cljs_core.Intern_("cljs.reader", "escape-char-map", &Escape_char_map, map[string]interface{}{"arglists": [][]string{{"c"}}})
+	cljs_core.Intern_("cljs.reader", "float-pattern", &Float_pattern, map[string]interface{}{})
+	cljs_core.Intern_("cljs.reader", "indivisible?", &Indivisible_QMARK_, map[string]interface{}{"arglists": [][]string{{"num", "div"}}})
+	cljs_core.Intern_("cljs.reader", "int-pattern", &Int_pattern, map[string]interface{}{})
+	cljs_core.Intern_("cljs.reader", "leap-year?", &Leap_year_QMARK_, map[string]interface{}{"arglists": [][]string{{"year"}}})
+	cljs_core.Intern_("cljs.reader", "make-unicode-char", &Make_unicode_char, map[string]interface{}{"arglists": [][]string{{"code-str"}}})
+	cljs_core.Intern_("cljs.reader", "match-float", &Match_float, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("cljs.reader", "match-int", &Match_int, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("cljs.reader", "match-number", &Match_number, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("cljs.reader", "match-ratio", &Match_ratio, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("cljs.reader", "not-implemented", &Not_implemented, map[string]interface{}{"arglists": [][]string{{"rdr", "ch"}}})
+	cljs_core.Intern_("cljs.reader", "number-literal?", &Number_literal_QMARK_, map[string]interface{}{"doc": "Checks whether the reader is at the start of a number literal", "arglists": [][]string{{"reader", "initch"}}})
+	cljs_core.Intern_("cljs.reader", "numeric?", &Numeric_QMARK_, map[string]interface{}{"doc": "Checks whether a given character is numeric", "arglists": [][]string{{"ch"}}})
+	cljs_core.Intern_("cljs.reader", "parse-int", &Parse_int, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("cljs.reader", "push-back-reader", &Push_back_reader, map[string]interface{}{"arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("cljs.reader", "ratio-pattern", &Ratio_pattern, map[string]interface{}{})
+	cljs_core.Intern_("cljs.reader", "re-matches*", &Re_matches_STAR_, map[string]interface{}{"arglists": [][]string{{"re", "s"}}})
+	cljs_core.Intern_("cljs.reader", "read-char", &Read_char, map[string]interface{}{"arglists": [][]string{{"reader"}}})
+	cljs_core.Intern_("cljs.reader", "read-comment", &Read_comment, map[string]interface{}{})
+	cljs_core.Intern_("cljs.reader", "read-discard", &Read_discard, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}})
+	cljs_core.Intern_("cljs.reader", "read-dispatch", &Read_dispatch, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}})
+	cljs_core.Intern_("cljs.reader", "read-keyword", &Read_keyword, map[string]interface{}{"arglists": [][]string{{"reader", "initch"}}})
+	cljs_core.Intern_("cljs.reader", "read-meta", &Read_meta, map[string]interface{}{"arglists": [][]string{{"rdr", "_"}}})
+	cljs_core.Intern_("cljs.reader", "read-number", &Read_number, map[string]interface{}{"arglists": [][]string{{"reader", "initch"}}})
+	cljs_core.Intern_("cljs.reader", "read-past", &Read_past, map[string]interface{}{"doc": "Read until first character that doesn't match pred, returning\nchar.", "arglists": [][]string{{"pred", "rdr"}}})
+	cljs_core.Intern_("cljs.reader", "read-raw-string*", &Read_raw_string_STAR_, map[string]interface{}{"arglists": [][]string{{"reader", "_"}}})
+	cljs_core.Intern_("cljs.reader", "read-regex", &Read_regex, map[string]interface{}{"arglists": [][]string{{"rdr", "ch"}}})
+	cljs_core.Intern_("cljs.reader", "read-string*", &Read_string_STAR_, map[string]interface{}{"arglists": [][]string{{"reader", "_"}}})
+	cljs_core.Intern_("cljs.reader", "read-unmatched-delimiter", &Read_unmatched_delimiter, map[string]interface{}{"arglists": [][]string{{"rdr", "ch"}}})
+	cljs_core.Intern_("cljs.reader", "register-default-tag-parser!", &Register_default_tag_parser_BANG_, map[string]interface{}{"arglists": [][]string{{"f"}}})
+	cljs_core.Intern_("cljs.reader", "register-tag-parser!", &Register_tag_parser_BANG_, map[string]interface{}{"arglists": [][]string{{"tag", "f"}}})
+	cljs_core.Intern_("cljs.reader", "skip-line", &Skip_line, map[string]interface{}{"doc": "Advances the reader to the end of a line. Returns the reader", "arglists": [][]string{{"reader", "_"}}})
+	cljs_core.Intern_("cljs.reader", "special-symbols", &Special_symbols, map[string]interface{}{"arglists": [][]string{{"t", "not-found"}}})
+	cljs_core.Intern_("cljs.reader", "symbol-pattern", &Symbol_pattern, map[string]interface{}{})
+	cljs_core.Intern_("cljs.reader", "throwing-reader", &Throwing_reader, map[string]interface{}{"arglists": [][]string{{"msg"}}})
+	cljs_core.Intern_("cljs.reader", "timestamp-regex", &Timestamp_regex, map[string]interface{}{})
+	cljs_core.Intern_("cljs.reader", "unicode-2-pattern", &Unicode_2_pattern, map[string]interface{}{})
+	cljs_core.Intern_("cljs.reader", "unicode-4-pattern", &Unicode_4_pattern, map[string]interface{}{})
+	cljs_core.Intern_("cljs.reader", "unread", &Unread, map[string]interface{}{"arglists": [][]string{{"reader", "ch"}}})
+	cljs_core.Intern_("cljs.reader", "validate-unicode-escape", &Validate_unicode_escape, map[string]interface{}{"arglists": [][]string{{"unicode-pattern", "reader", "escape-char", "unicode-str"}}})
+	cljs_core.Intern_("cljs.reader", "whitespace?", &Whitespace_QMARK_, map[string]interface{}{"doc": "Checks whether a given character is whitespace", "arglists": [][]string{{"ch"}}})
+	cljs_core.Intern_("cljs.reader", "wrapping-reader", &Wrapping_reader, map[string]interface{}{"arglists": [][]string{{"sym"}}})
+	cljs_core.Intern_("cljs.reader", "zero-fill-right-and-truncate", &Zero_fill_right_and_truncate, map[string]interface{}{"arglists": [][]string{{"s", "width"}}})
 }
 
 type CljsReaderPushbackReader interface {
@@ -1300,11 +933,6 @@ var Comment_prefix_QMARK_ *cljs_core.AFn
 // Checks whether the reader is at the start of a number literal
 var Number_literal_QMARK_ *cljs_core.AFn
 
-// @param {...*} var_args
-var Reader_error *cljs_core.AFn
-
-var Macro_terminating_QMARK_ *cljs_core.AFn
-
 // Advances the reader to the end of a line. Returns the reader
 var Skip_line *cljs_core.AFn
 
@@ -1342,22 +970,14 @@ var Escape_char *cljs_core.AFn
 // char.
 var Read_past *cljs_core.AFn
 
-var Read_delimited_list *cljs_core.AFn
-
 var Not_implemented *cljs_core.AFn
 
 var Read_dispatch *cljs_core.AFn
 
 var Read_unmatched_delimiter *cljs_core.AFn
 
-var Read_list *cljs_core.AFn
-
 var Read_comment interface{}
 
-var Read_vector *cljs_core.AFn
-
-var Read_map *cljs_core.AFn
-
 var Read_number *cljs_core.AFn
 
 var Read_string_STAR_ *cljs_core.AFn
@@ -1366,8 +986,6 @@ var Read_raw_string_STAR_ *cljs_core.AFn
 
 var Special_symbols *cljs_core.AFn
 
-var Read_symbol *cljs_core.AFn
-
 var Read_keyword *cljs_core.AFn
 
 var Desugar_meta *cljs_core.AFn
@@ -1378,21 +996,10 @@ var Throwing_reader *cljs_core.AFn
 
 var Read_meta *cljs_core.AFn
 
-var Read_set *cljs_core.AFn
-
 var Read_regex *cljs_core.AFn
 
 var Read_discard *cljs_core.AFn
 
-var Dispatch_macros *cljs_core.AFn
-
-// Reads the first object from a PushbackReader. Returns the object read.
-// If EOF, throws if eof-is-error is true. Otherwise returns sentinel.
-var Read *cljs_core.AFn
-
-// Reads one object from the string s
-var Read_string *cljs_core.AFn
-
 var Zero_fill_right_and_truncate *cljs_core.AFn
 
 var Divisible_QMARK_ *cljs_core.AFn
@@ -1407,12 +1014,8 @@ var Parse_int *cljs_core.AFn
 
 var Check *cljs_core.AFn
 
-var Parse_and_validate_timestamp *cljs_core.AFn
-
 var X_STAR_default_data_reader_fn_STAR_ *cljs_core.CljsCoreAtom
 
-var Maybe_read_tagged_type *cljs_core.AFn
-
 var Register_tag_parser_BANG_ *cljs_core.AFn
 
 var Deregister_tag_parser_BANG_ *cljs_core.AFn
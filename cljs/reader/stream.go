@@ -0,0 +1,56 @@
+package reader
+
+import (
+	"bufio"
+	"io"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+)
+
+// A PushbackReader reading runes from an io.Reader as they are needed, so input too large to fit
+// in a string, like EDN log files, can be read one form at a time with read or read-seq. Unread
+// characters, including nil at EOF, are kept in Buffer and read again before the input.
+type CljsReaderIOPushbackReader struct {
+	In     *bufio.Reader
+	Buffer []interface{}
+}
+
+func (_ *CljsReaderIOPushbackReader) CljsReaderPushbackReader__() {}
+func (reader *CljsReaderIOPushbackReader) Read_char_Arity1() interface{} {
+	if n := len(reader.Buffer); n > 0 {
+		ch := reader.Buffer[n-1]
+		reader.Buffer = reader.Buffer[:n-1]
+		return ch
+	}
+	r, _, err := reader.In.ReadRune()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		panic(err)
+	}
+	return string(r)
+}
+
+func (reader *CljsReaderIOPushbackReader) Unread_Arity2(ch interface{}) interface{} {
+	reader.Buffer = append(reader.Buffer, ch)
+	return nil
+}
+
+// Returns a PushbackReader over the io.Reader in. Errors other than io.EOF are thrown.
+func Io_push_back_reader_(in io.Reader) *CljsReaderIOPushbackReader {
+	r, ok := in.(*bufio.Reader)
+	if !ok {
+		r = bufio.NewReader(in)
+	}
+	return &CljsReaderIOPushbackReader{In: r}
+}
+
+// Returns a PushbackReader over the io.Reader in. Errors other than io.EOF are thrown.
+var Io_push_back_reader = cljs_core.Fn(func(in interface{}) interface{} {
+	return Io_push_back_reader_(in.(io.Reader))
+})
+
+func init() {
+	cljs_core.Intern_("cljs.reader", "io-push-back-reader", &Io_push_back_reader, map[string]interface{}{"doc": "Returns a PushbackReader over the io.Reader in. Errors other than io.EOF are thrown.", "arglists": [][]string{{"in"}}})
+}
@@ -0,0 +1,105 @@
+package reader
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func readString(s string) interface{} {
+	return Read_string.X_invoke_Arity1(s)
+}
+
+func Test_IOPushbackReader(t *testing.T) {
+	r := Io_push_back_reader_(strings.NewReader("λx"))
+	assert.Equal(t, "λ", Read_char.X_invoke_Arity1(r))
+	Unread.X_invoke_Arity2(r, "λ")
+	assert.Equal(t, "λ", Read_char.X_invoke_Arity1(r))
+	assert.Equal(t, "x", Read_char.X_invoke_Arity1(r))
+	assert.Nil(t, Read_char.X_invoke_Arity1(r))
+	Unread.X_invoke_Arity2(r, nil)
+	assert.Nil(t, Read_char.X_invoke_Arity1(r))
+
+	b := bufio.NewReader(strings.NewReader(""))
+	assert.Equal(t, b, Io_push_back_reader_(b).In)
+}
+
+func Test_ReadWithEOF(t *testing.T) {
+	eof := readString(":eof")
+	opts := cljs_core.Hash_map.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{eof, eof}))
+	r := Io_push_back_reader_(strings.NewReader("[1 2] ; done\n"))
+	assert.True(t, cljs_core.X_EQ_.X_invoke_Arity2(readString("[1 2]"), Read.X_invoke_Arity2(opts, r)).(bool))
+	assert.Equal(t, eof, Read.X_invoke_Arity2(opts, r))
+	assert.Equal(t, eof, Read.X_invoke_Arity2(opts, r))
+
+	assert.Panics(t, func() { Read.X_invoke_Arity1(Io_push_back_reader_(strings.NewReader(" "))) })
+	assert.Panics(t, func() {
+		Read.X_invoke_Arity2(cljs_core.CljsCorePersistentArrayMap_EMPTY, Push_back_reader.X_invoke_Arity1(""))
+	})
+	assert.Panics(t, func() { Read.X_invoke_Arity2(opts, Io_push_back_reader_(strings.NewReader("[1 2"))) })
+}
+
+func Test_ReadSeq(t *testing.T) {
+	in := strings.NewReader(`{:level :info :msg "привет"} ; first
+{:level :warn :msg "你好"}
+#inst "2012-01-01T00:00:00.000-00:00"`)
+	forms := Read_seq.X_invoke_Arity1(in)
+	assert.Equal(t, 3.0, cljs_core.Count.X_invoke_Arity1(forms))
+	assert.True(t, cljs_core.X_EQ_.X_invoke_Arity2(readString(`{:level :warn :msg "你好"}`), cljs_core.Second.X_invoke_Arity1(forms)).(bool))
+	assert.Equal(t, 0, in.Len())
+
+	lines := strings.Repeat("[1 2 3]\n", 1000)
+	r := Io_push_back_reader_(bufio.NewReaderSize(strings.NewReader(lines), 16))
+	assert.Equal(t, 3.0, cljs_core.Count.X_invoke_Arity1(cljs_core.First.X_invoke_Arity1(Read_seq.X_invoke_Arity1(r))))
+	assert.True(t, r.In.Buffered() <= 16)
+	assert.Equal(t, 999.0, cljs_core.Count.X_invoke_Arity1(Read_seq.X_invoke_Arity1(r)))
+
+	assert.Nil(t, cljs_core.Seq.X_invoke_Arity1(Read_seq.X_invoke_Arity1(Push_back_reader.X_invoke_Arity1(" ;nothing"))))
+}
+
+func position(x interface{}) []interface{} {
+	meta := cljs_core.Meta.X_invoke_Arity1(x)
+	var p []interface{}
+	for _, k := range []string{":line", ":column", ":end-line", ":end-column", ":file"} {
+		p = append(p, cljs_core.Get.X_invoke_Arity2(meta, readString(k)))
+	}
+	return p
+}
+
+func Test_IndexingPushbackReader(t *testing.T) {
+	r := Indexing_push_back_reader.X_invoke_Arity2(strings.NewReader(`{:name "cljs2go"
+ :deps [foo/bar
+        #{baz}]}
+(λ x)`), "config.edn")
+	config := Read.X_invoke_Arity1(r)
+	assert.Equal(t, []interface{}{1.0, 1.0, 3.0, 17.0, "config.edn"}, position(config))
+	deps := cljs_core.Get.X_invoke_Arity2(config, readString(":deps"))
+	assert.Equal(t, []interface{}{2.0, 8.0, 3.0, 16.0, "config.edn"}, position(deps))
+	assert.Equal(t, []interface{}{2.0, 9.0, 2.0, 16.0, "config.edn"}, position(cljs_core.First.X_invoke_Arity1(deps)))
+	assert.Equal(t, []interface{}{3.0, 9.0, 3.0, 15.0, "config.edn"}, position(cljs_core.Second.X_invoke_Arity1(deps)))
+	assert.Nil(t, cljs_core.Meta.X_invoke_Arity1(cljs_core.Get.X_invoke_Arity2(config, readString(":name"))))
+
+	list := Read.X_invoke_Arity1(r)
+	assert.Equal(t, []interface{}{4.0, 1.0, 4.0, 6.0, "config.edn"}, position(list))
+	assert.Equal(t, []interface{}{4.0, 2.0, 4.0, 3.0, "config.edn"}, position(cljs_core.First.X_invoke_Arity1(list)))
+
+	assert.Equal(t, []interface{}{1.0, 2.0, 1.0, 5.0, nil}, position(Read.X_invoke_Arity1(Indexing_push_back_reader.X_invoke_Arity1(" [a]"))))
+	assert.Nil(t, cljs_core.Meta.X_invoke_Arity1(readString("[a]")))
+	assert.Nil(t, cljs_core.Meta.X_invoke_Arity1(Read.X_invoke_Arity1(Indexing_push_back_reader.X_invoke_Arity1("nil"))))
+}
+
+func Test_ReaderErrorPosition(t *testing.T) {
+	read := func(r interface{}) (err interface{}) {
+		defer func() {
+			err = recover()
+		}()
+		Read.X_invoke_Arity1(r)
+		return nil
+	}
+	assert.EqualError(t, read(Indexing_push_back_reader.X_invoke_Arity2("{:a\n [1 2", "config.edn")).(error), "EOF while reading at line 2, column 6 in config.edn")
+	assert.EqualError(t, read(Indexing_push_back_reader.X_invoke_Arity1("(a\n b]")).(error), "Unmached delimiter ] at line 2, column 4")
+	assert.EqualError(t, read(Push_back_reader.X_invoke_Arity1("(a")).(error), "EOF while reading")
+}
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // cljs.reader-test
 
 package reader_test
@@ -833,6 +834,10 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("cljs.reader-test", "->R", &X__GT_R, map[string]interface{}{})
+	cljs_core.Intern_("cljs.reader-test", "->T", &X__GT_T, map[string]interface{}{"arglists": [][]string{{"a", "b"}}})
+	cljs_core.Intern_("cljs.reader-test", "map->R", &Map__GT_R, map[string]interface{}{})
+	cljs_core.Intern_("cljs.reader-test", "test-reader", &Test_reader, map[string]interface{}{"arglists": [][]string{{}}})
 }
 
 type CljsReader_testT struct {
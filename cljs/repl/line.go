@@ -0,0 +1,193 @@
+package repl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// A minimal line editor for terminals, with Emacs style key bindings and history. When the input
+// isn't a terminal lines are read as they are.
+
+var errInterrupted = errors.New("interrupted")
+
+type lineReader struct {
+	in      *bufio.Reader
+	out     io.Writer
+	fd      int
+	tty     bool
+	history []string
+}
+
+func newLineReader(in io.Reader, out io.Writer) *lineReader {
+	r := &lineReader{in: bufio.NewReader(in), out: out, fd: -1}
+	if f, ok := in.(*os.File); ok && isTerminal(int(f.Fd())) {
+		r.fd, r.tty = int(f.Fd()), true
+	}
+	return r
+}
+
+// Reads a line after printing prompt, returning io.EOF at the end of the input and errInterrupted
+// if the line was cancelled with Ctrl-C.
+func (r *lineReader) readLine(prompt string) (string, error) {
+	fmt.Fprint(r.out, prompt)
+	if r.tty {
+		if restore, err := makeRaw(r.fd); err == nil {
+			defer restore()
+			return r.edit(prompt)
+		}
+	}
+	line, err := r.in.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	return strings.TrimRight(line, "\r\n"), err
+}
+
+func (r *lineReader) edit(prompt string) (string, error) {
+	var buf []rune
+	pos, h := 0, len(r.history)
+	saved := ""
+	refresh := func() {
+		fmt.Fprintf(r.out, "\r%s%s\x1b[K", prompt, string(buf))
+		if n := len(buf) - pos; n > 0 {
+			fmt.Fprintf(r.out, "\x1b[%dD", n)
+		}
+	}
+	setLine := func(s string) {
+		buf = []rune(s)
+		pos = len(buf)
+		refresh()
+	}
+	for {
+		c, _, err := r.in.ReadRune()
+		if err != nil {
+			return "", err
+		}
+		switch c {
+		case '\r', '\n':
+			fmt.Fprint(r.out, "\r\n")
+			line := string(buf)
+			if strings.TrimSpace(line) != "" && (len(r.history) == 0 || r.history[len(r.history)-1] != line) {
+				r.history = append(r.history, line)
+			}
+			return line, nil
+		case 3: // Ctrl-C
+			fmt.Fprint(r.out, "^C\r\n")
+			return "", errInterrupted
+		case 4: // Ctrl-D
+			if len(buf) == 0 {
+				fmt.Fprint(r.out, "\r\n")
+				return "", io.EOF
+			}
+			if pos < len(buf) {
+				buf = append(buf[:pos], buf[pos+1:]...)
+			}
+		case 127, 8: // Backspace, Ctrl-H
+			if pos > 0 {
+				buf = append(buf[:pos-1], buf[pos:]...)
+				pos--
+			}
+		case 1: // Ctrl-A
+			pos = 0
+		case 5: // Ctrl-E
+			pos = len(buf)
+		case 2: // Ctrl-B
+			if pos > 0 {
+				pos--
+			}
+		case 6: // Ctrl-F
+			if pos < len(buf) {
+				pos++
+			}
+		case 11: // Ctrl-K
+			buf = buf[:pos]
+		case 21: // Ctrl-U
+			buf, pos = buf[pos:], 0
+		case 23: // Ctrl-W
+			start := pos
+			for start > 0 && buf[start-1] == ' ' {
+				start--
+			}
+			for start > 0 && buf[start-1] != ' ' {
+				start--
+			}
+			buf, pos = append(buf[:start], buf[pos:]...), start
+		case 12: // Ctrl-L
+			fmt.Fprint(r.out, "\x1b[H\x1b[2J")
+		case 16, 14: // Ctrl-P, Ctrl-N
+			h, saved = r.move(h, c == 16, string(buf), saved, setLine)
+			continue
+		case 27: // Escape sequences for arrow keys, Home, End and Delete.
+			seq := r.escape()
+			switch seq {
+			case "[A", "OA", "[B", "OB":
+				h, saved = r.move(h, seq[1] == 'A', string(buf), saved, setLine)
+				continue
+			case "[C", "OC":
+				if pos < len(buf) {
+					pos++
+				}
+			case "[D", "OD":
+				if pos > 0 {
+					pos--
+				}
+			case "[H", "OH", "[1~", "[7~":
+				pos = 0
+			case "[F", "OF", "[4~", "[8~":
+				pos = len(buf)
+			case "[3~":
+				if pos < len(buf) {
+					buf = append(buf[:pos], buf[pos+1:]...)
+				}
+			}
+		default:
+			if c >= ' ' {
+				buf = append(buf[:pos], append([]rune{c}, buf[pos:]...)...)
+				pos++
+			}
+		}
+		refresh()
+	}
+}
+
+func (r *lineReader) escape() string {
+	var seq []rune
+	for {
+		c, _, err := r.in.ReadRune()
+		if err != nil {
+			return string(seq)
+		}
+		seq = append(seq, c)
+		if len(seq) > 1 && (c >= 'A' && c <= 'Z' || c == '~') {
+			return string(seq)
+		}
+		if len(seq) == 1 && c != '[' && c != 'O' {
+			return string(seq)
+		}
+	}
+}
+
+// Moves up or down in the history from the entry h, keeping the line being edited around.
+func (r *lineReader) move(h int, up bool, line string, saved string, setLine func(string)) (int, string) {
+	if h == len(r.history) {
+		saved = line
+	}
+	switch {
+	case up && h > 0:
+		h--
+	case !up && h < len(r.history):
+		h++
+	default:
+		return h, saved
+	}
+	if h == len(r.history) {
+		setLine(saved)
+	} else {
+		setLine(r.history[h])
+	}
+	return h, saved
+}
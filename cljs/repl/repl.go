@@ -0,0 +1,253 @@
+// cljs.repl
+
+// A read-eval-print loop for the compiled runtime. Forms are read with cljs.reader, evaluated by
+// cljs.eval against the compiled namespaces linked into the program, and printed with pr-str.
+// in-ns, ns, require and doc are handled by the REPL itself, as in ClojureScript's REPL.
+package repl
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	"github.com/hraberg/cljs2go/cljs/eval"
+	"github.com/hraberg/cljs2go/cljs/reader"
+	"github.com/hraberg/cljs2go/js"
+)
+
+// bound in a repl thread to the most recent exception caught by the repl
+var X_STAR_e interface{}
+
+func init() {
+	cljs_core.Intern_("cljs.core", "*e", &X_STAR_e, map[string]interface{}{"doc": "bound in a repl thread to the most recent exception caught by the repl", "dynamic": true})
+}
+
+func symbol(name string) interface{} {
+	return cljs_core.Symbol.X_invoke_Arity1(name)
+}
+
+func keyword(name string) interface{} {
+	return cljs_core.Keyword.X_invoke_Arity1(name)
+}
+
+func prStr(x interface{}) string {
+	return cljs_core.Pr_str.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{x})).(string)
+}
+
+func items(coll interface{}) []interface{} {
+	var xs []interface{}
+	for s := cljs_core.Seq.X_invoke_Arity1(coll); s != nil; s = cljs_core.Next.X_invoke_Arity1(s) {
+		xs = append(xs, cljs_core.First.X_invoke_Arity1(s))
+	}
+	return xs
+}
+
+func printLine(s string) {
+	cljs_core.Dynamic_("cljs.core/*print-fn*", cljs_core.X_STAR_print_fn_STAR_).(cljs_core.CljsCoreIFn).X_invoke_Arity1(s)
+}
+
+// Returns the initial bindings of a REPL session printing to out, to be installed with
+// push-thread-bindings or with-bindings* around calls to Eval_.
+func Bindings_(out io.Writer) interface{} {
+	return cljs_core.Hash_map.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{
+		symbol("cljs.core/*ns*"), cljs_core.Find_ns.X_invoke_Arity1(symbol("cljs.user")),
+		symbol("cljs.core/*1"), nil,
+		symbol("cljs.core/*2"), nil,
+		symbol("cljs.core/*3"), nil,
+		symbol("cljs.core/*e"), nil,
+		symbol("cljs.core/*print-newline*"), false,
+		symbol("cljs.core/*print-fn*"), cljs_core.Fn(func(x interface{}) interface{} {
+			fmt.Fprintln(out, x)
+			return nil
+		}),
+	}))
+}
+
+// Returns the name of the current namespace, *ns*.
+func Ns_() string {
+	ns := cljs_core.Dynamic_("cljs.core/*ns*", cljs_core.X_STAR_ns_STAR_)
+	return cljs_core.Ns_name.X_invoke_Arity1(ns).(*cljs_core.CljsCoreSymbol).Str.(string)
+}
+
+// Reads all forms in s. Returns false if s ends inside a form, so more input is needed.
+func Read_forms_(s string) (forms []interface{}, complete bool) {
+	defer func() {
+		if x := recover(); x != nil {
+			if err, ok := x.(error); ok && strings.HasPrefix(err.Error(), "EOF while reading") {
+				forms, complete = nil, false
+				return
+			}
+			panic(x)
+		}
+	}()
+	r := reader.Push_back_reader.X_invoke_Arity1(s)
+	eof := new(interface{})
+	for {
+		form := reader.Read.X_invoke_Arity4(r, false, eof, false)
+		if form == eof {
+			return forms, true
+		}
+		forms = append(forms, form)
+	}
+}
+
+// Evaluates form as typed at the REPL and updates *1, *2, *3, or *e if it throws. Expects the
+// Bindings_ of a session to be in effect.
+func Eval_(form interface{}) (result interface{}) {
+	defer func() {
+		if x := recover(); x != nil {
+			cljs_core.Set_dynamic_("cljs.core/*e", x)
+			panic(x)
+		}
+	}()
+	result = evalSpecial(form)
+	cljs_core.Set_dynamic_("cljs.core/*3", cljs_core.Dynamic_("cljs.core/*2", nil))
+	cljs_core.Set_dynamic_("cljs.core/*2", cljs_core.Dynamic_("cljs.core/*1", nil))
+	cljs_core.Set_dynamic_("cljs.core/*1", result)
+	return result
+}
+
+func evalSpecial(form interface{}) interface{} {
+	if s, ok := form.(cljs_core.CljsCoreISeq); ok {
+		if sym, ok := cljs_core.First.X_invoke_Arity1(s).(*cljs_core.CljsCoreSymbol); ok && sym.Ns == nil {
+			args := items(cljs_core.Rest.X_invoke_Arity1(s))
+			switch sym.Name {
+			case "in-ns":
+				return inNs(eval.Eval.X_invoke_Arity1(args[0]))
+			case "ns":
+				ns := inNs(args[0])
+				for _, clause := range args[1:] {
+					if c, ok := clause.(cljs_core.CljsCoreISeq); ok && cljs_core.Keyword_identical_QMARK_.Arity2IIB(cljs_core.First.X_invoke_Arity1(c), keyword("require")) {
+						for _, spec := range items(cljs_core.Rest.X_invoke_Arity1(c)) {
+							require(spec)
+						}
+					}
+				}
+				return ns
+			case "require":
+				for _, spec := range args {
+					require(eval.Eval.X_invoke_Arity1(spec))
+				}
+				return nil
+			case "doc":
+				doc(args[0])
+				return nil
+			}
+		}
+	}
+	return eval.Eval.X_invoke_Arity1(form)
+}
+
+func inNs(name interface{}) interface{} {
+	if _, ok := name.(*cljs_core.CljsCoreSymbol); !ok {
+		panic(&js.Error{"Namespace name must be a symbol, got: " + prStr(name)})
+	}
+	ns := cljs_core.Create_ns.X_invoke_Arity1(name)
+	cljs_core.Set_dynamic_("cljs.core/*ns*", ns)
+	return ns
+}
+
+// Requires a namespace compiled into this program, given as a symbol or a libspec like
+// [clojure.string :as str :refer [join]].
+func require(spec interface{}) {
+	name, opts := spec, interface{}(nil)
+	if _, ok := spec.(cljs_core.CljsCoreIVector); ok {
+		name = cljs_core.First.X_invoke_Arity1(spec)
+		opts = cljs_core.Apply.X_invoke_Arity2(cljs_core.Hash_map, cljs_core.Rest.X_invoke_Arity1(spec))
+	}
+	if cljs_core.Find_ns.X_invoke_Arity1(name) == nil {
+		panic(&js.Error{"No namespace: " + prStr(name) + " compiled into this program"})
+	}
+	if alias := cljs_core.Get.X_invoke_Arity2(opts, keyword("as")); alias != nil {
+		cljs_core.Alias.X_invoke_Arity2(alias, name)
+	}
+	if refer := cljs_core.Get.X_invoke_Arity2(opts, keyword("refer")); refer != nil {
+		if cljs_core.Keyword_identical_QMARK_.Arity2IIB(refer, keyword("all")) {
+			cljs_core.Refer.X_invoke_ArityVariadic(name, nil)
+		} else {
+			cljs_core.Refer.X_invoke_ArityVariadic(name, cljs_core.List.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{keyword("only"), refer})))
+		}
+	}
+}
+
+// Prints the documentation of the var or namespace named by sym.
+func doc(sym interface{}) {
+	if v, ok := cljs_core.Resolve.X_invoke_Arity1(sym).(*cljs_core.CljsCoreVar); ok {
+		meta := v.X_meta
+		printLine("-------------------------")
+		printLine(v.Sym.(*cljs_core.CljsCoreSymbol).Str.(string))
+		if arglists := cljs_core.Get.X_invoke_Arity2(meta, keyword("arglists")); arglists != nil {
+			printLine(prStr(arglists))
+		}
+		if docstring, ok := cljs_core.Get.X_invoke_Arity2(meta, keyword("doc")).(string); ok {
+			printLine("  " + strings.Replace(docstring, "\n", "\n  ", -1))
+		}
+	} else if ns := cljs_core.Find_ns.X_invoke_Arity1(sym); ns != nil {
+		printLine("-------------------------")
+		printLine(prStr(cljs_core.Ns_name.X_invoke_Arity1(ns)))
+	}
+}
+
+// Returns the message printed for the exception x caught by the REPL.
+func Error_string_(x interface{}) string {
+	if err, ok := x.(error); ok {
+		return fmt.Sprintf("%+v", err)
+	}
+	return prStr(x)
+}
+
+// Runs a read-eval-print loop reading from in and printing to out until the end of the input, or
+// Ctrl-D at the terminal. Forms can span several lines.
+func Repl_(in io.Reader, out io.Writer) {
+	cljs_core.Push_thread_bindings.X_invoke_Arity1(Bindings_(out))
+	defer cljs_core.Pop_thread_bindings.X_invoke_Arity0()
+	lines := newLineReader(in, out)
+	text := ""
+	for {
+		prompt := Ns_() + "=> "
+		if text != "" {
+			prompt = strings.Repeat(" ", len(prompt)-5) + "#_=> "
+		}
+		line, err := lines.readLine(prompt)
+		if err == errInterrupted {
+			text = ""
+			continue
+		}
+		if err != nil {
+			if !lines.tty {
+				fmt.Fprintln(out)
+			}
+			return
+		}
+		text += line + "\n"
+		forms, complete := readForms(text, out)
+		if !complete {
+			continue
+		}
+		text = ""
+		for _, form := range forms {
+			evalPrint(form, out)
+		}
+	}
+}
+
+func readForms(text string, out io.Writer) (forms []interface{}, complete bool) {
+	defer func() {
+		if x := recover(); x != nil {
+			cljs_core.Set_dynamic_("cljs.core/*e", x)
+			fmt.Fprintln(out, Error_string_(x))
+			forms, complete = nil, true
+		}
+	}()
+	return Read_forms_(text)
+}
+
+func evalPrint(form interface{}, out io.Writer) {
+	defer func() {
+		if x := recover(); x != nil {
+			fmt.Fprintln(out, Error_string_(x))
+		}
+	}()
+	fmt.Fprintln(out, prStr(Eval_(form)))
+}
@@ -0,0 +1,42 @@
+package repl
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func repl(input string) string {
+	var out bytes.Buffer
+	Repl_(strings.NewReader(input), &out)
+	return out.String()
+}
+
+func Test_Repl(t *testing.T) {
+	assert.Equal(t, "cljs.user=> 3\ncljs.user=> \n", repl("(+ 1 2)\n"))
+	assert.Equal(t, "cljs.user=>        #_=> 3\ncljs.user=> \n", repl("(/ 6\n 2)\n"))
+	assert.Equal(t, "cljs.user=> 1\ncljs.user=> 2\n3\ncljs.user=> [3 2 1]\ncljs.user=> \n", repl("1\n2 3\n[*1 *2 *3]\n"))
+	assert.Equal(t, "cljs.user=> boom {:a 1}\ncljs.user=> {:a 1}\ncljs.user=> \n", repl(`(throw (ex-info "boom" {:a 1}))
+(ex-data *e)
+`))
+	assert.Equal(t, "cljs.user=> hello\nnil\ncljs.user=> \n", repl(`(println "hello")`))
+}
+
+func Test_NamespacesAndDoc(t *testing.T) {
+	assert.Equal(t, "cljs.user=> #<Namespace foo>\nfoo=> #'foo/x\n42\nfoo=> \n", repl("(in-ns 'foo)\n(def x 42) foo/x\n"))
+	assert.Equal(t, "cljs.user=> nil\ncljs.user=> 3\ncljs.user=> \n", repl(`(require '[cljs.eval :as e :refer [load-string]])
+(+ (e/eval 1) (load-string "2"))
+`))
+	assert.Equal(t, "cljs.user=> #<Namespace bar>\nbar=> [1 true]\nbar=> \n", repl(`(ns bar (:require [cljs.eval :as e] [cljs.reader :refer :all]))
+[(e/eval 1) (= read-string cljs.reader/read-string)]
+`))
+	assert.Equal(t, "cljs.user=> No namespace: clojure.missing compiled into this program\ncljs.user=> \n", repl("(require 'clojure.missing)\n"))
+	assert.Equal(t, `cljs.user=> -------------------------
+cljs.eval/load-string
+([s])
+  Sequentially reads and evaluates the forms in the string s, returning the value of the last one.
+nil
+cljs.user=> `+"\n", repl("(doc cljs.eval/load-string)\n"))
+}
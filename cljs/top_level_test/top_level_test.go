@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // cljs.top-level-test
 
 package top_level_test
@@ -47,6 +48,9 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("cljs.top-level-test", "bar", &Bar, map[string]interface{}{})
+	cljs_core.Intern_("cljs.top-level-test", "baz", &Baz, map[string]interface{}{})
+	cljs_core.Intern_("cljs.top-level-test", "test", &Test, map[string]interface{}{"arglists": [][]string{{}}})
 }
 
 var Bar *cljs_core.AFn
@@ -0,0 +1,136 @@
+// Written by hand from test/cljs/var_test.cljs to match ClojureScript to Go 0.0-2411,
+// until regenerated by lein compile-clojurescript-tests.
+// cljs.var-test
+
+package var_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	cljs_var_test_other_ns "github.com/hraberg/cljs2go/cljs/var_test_other_ns"
+	"github.com/hraberg/cljs2go/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	Test_with_redefs = func(test_with_redefs *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(test_with_redefs, 0, func() interface{} {
+			{
+				var greet1_3 = cljs_core.Var_root_(cljs_var_test_other_ns.Greet).(*cljs_core.AFn)
+				var answer2_4 = cljs_core.Var_root_(cljs_var_test_other_ns.Answer).(float64)
+				_, _ = greet1_3, answer2_4
+				func() {
+					defer func() {
+						cljs_core.Set_var_root_(cljs_var_test_other_ns.Answer, answer2_4)
+
+						cljs_core.Set_var_root_(cljs_var_test_other_ns.Greet, greet1_3)
+
+					}()
+					{
+						cljs_core.Set_var_root_(cljs_var_test_other_ns.Greet, func(G__5 *cljs_core.AFn) *cljs_core.AFn {
+							return cljs_core.Fn(G__5, 1, func(name interface{}) interface{} {
+								return strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Hi ").(string), cljs_core.Str.X_invoke_Arity1(name).(string)}, ``)
+							})
+						}(&cljs_core.AFn{}))
+
+						cljs_core.Set_var_root_(cljs_var_test_other_ns.Answer, float64(43))
+
+						if cljs_core.X_EQ_.Arity2IIB((&cljs_core.CljsCorePersistentVector{nil, float64(2), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{"Hi a", "Hi b"}, nil}), cljs_core.Var_root_(cljs_var_test_other_ns.Greet_all).(*cljs_core.AFn).X_invoke_Arity1((&cljs_core.CljsCorePersistentVector{nil, float64(2), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{"a", "b"}, nil}))) {
+						} else {
+							panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= [\"Hi a\" \"Hi b\"] (o/greet-all [\"a\" \"b\"]))").(string)}, ``)}))
+						}
+						if cljs_core.X_EQ_.Arity2IIB(float64(43), cljs_core.Var_root_(cljs_var_test_other_ns.Answer).(float64)) {
+						} else {
+							panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= 43 o/answer)").(string)}, ``)}))
+						}
+					}
+				}()
+			}
+			if cljs_core.X_EQ_.Arity2IIB((&cljs_core.CljsCorePersistentVector{nil, float64(1), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{"Hello a"}, nil}), cljs_core.Var_root_(cljs_var_test_other_ns.Greet_all).(*cljs_core.AFn).X_invoke_Arity1((&cljs_core.CljsCorePersistentVector{nil, float64(1), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{"a"}, nil}))) {
+			} else {
+				panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= [\"Hello a\"] (o/greet-all [\"a\"]))").(string)}, ``)}))
+			}
+			if cljs_core.X_EQ_.Arity2IIB(float64(42), cljs_core.Var_root_(cljs_var_test_other_ns.Answer).(float64)) {
+				return nil
+			} else {
+				panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= 42 o/answer)").(string)}, ``)}))
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Test_alter_var_root = func(test_alter_var_root *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(test_alter_var_root, 0, func() interface{} {
+			{
+				var calls = cljs_core.Atom.X_invoke_Arity1(float64(0)).(*cljs_core.CljsCoreAtom)
+				var greet = cljs_core.Deref.X_invoke_Arity1(cljs_var_test_other_ns.Greet)
+				_, _ = calls, greet
+				cljs_core.Alter_var_root.X_invoke_Arity2(cljs_var_test_other_ns.Greet, func(G__6 *cljs_core.AFn) *cljs_core.AFn {
+					return cljs_core.Fn(G__6, 1, func(f interface{}) interface{} {
+						return func(G__7 *cljs_core.AFn) *cljs_core.AFn {
+							return cljs_core.Fn(G__7, 1, func(name interface{}) interface{} {
+								cljs_core.Swap_BANG_.X_invoke_Arity2(calls, cljs_core.Inc)
+								return f.(cljs_core.CljsCoreIFn).X_invoke_Arity1(name)
+							})
+						}(&cljs_core.AFn{})
+					})
+				}(&cljs_core.AFn{}))
+				if cljs_core.X_EQ_.Arity2IIB((&cljs_core.CljsCorePersistentVector{nil, float64(2), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{"Hello a", "Hello b"}, nil}), cljs_core.Var_root_(cljs_var_test_other_ns.Greet_all).(*cljs_core.AFn).X_invoke_Arity1((&cljs_core.CljsCorePersistentVector{nil, float64(2), float64(5), cljs_core.CljsCorePersistentVector_EMPTY_NODE, []interface{}{"a", "b"}, nil}))) {
+				} else {
+					panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= [\"Hello a\" \"Hello b\"] (o/greet-all [\"a\" \"b\"]))").(string)}, ``)}))
+				}
+				if cljs_core.X_EQ_.Arity2IIB(float64(2), cljs_core.Deref.X_invoke_Arity1(calls)) {
+				} else {
+					panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= 2 (clojure.core/deref calls))").(string)}, ``)}))
+				}
+				cljs_core.Alter_var_root.X_invoke_Arity2(cljs_var_test_other_ns.Greet, cljs_core.Constantly.X_invoke_Arity1(greet))
+				if cljs_core.X_EQ_.Arity2IIB("Hello a", cljs_core.Var_root_(cljs_var_test_other_ns.Greet).(*cljs_core.AFn).X_invoke_Arity1("a")) {
+				} else {
+					panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= \"Hello a\" (o/greet \"a\"))").(string)}, ``)}))
+				}
+				if cljs_core.X_EQ_.Arity2IIB(float64(2), cljs_core.Deref.X_invoke_Arity1(calls)) {
+					return nil
+				} else {
+					panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= 2 (clojure.core/deref calls))").(string)}, ``)}))
+				}
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	Test_var = func(test_var *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(test_var, 0, func() interface{} {
+			if reflect.DeepEqual(cljs_var_test_other_ns.Greet, cljs_core.Resolve.X_invoke_Arity1((&cljs_core.CljsCoreSymbol{Ns: "cljs.var-test-other-ns", Name: "greet", Str: "cljs.var-test-other-ns/greet", X_hash: float64(2011590600), X_meta: nil}))) {
+			} else {
+				panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(identical? (var o/greet) (resolve (quote cljs.var-test-other-ns/greet)))").(string)}, ``)}))
+			}
+			if cljs_core.X_EQ_.Arity2IIB("Hello a", cljs_core.Deref.X_invoke_Arity1(cljs_var_test_other_ns.Greet).(cljs_core.CljsCoreIFn).X_invoke_Arity1("a")) {
+			} else {
+				panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= \"Hello a\" ((clojure.core/deref (var o/greet)) \"a\"))").(string)}, ``)}))
+			}
+			if cljs_core.X_EQ_.Arity2IIB("Return a greeting.", (&cljs_core.CljsCoreKeyword{Ns: nil, Name: "doc", Fqn: "doc", X_hash: float64(1913296891)}).X_invoke_Arity1(cljs_core.Meta.X_invoke_Arity1(cljs_var_test_other_ns.Greet))) {
+				return nil
+			} else {
+				panic((&js.Error{strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Assert failed: ").(string), cljs_core.Str.X_invoke_Arity1("(= \"Return a greeting.\" (:doc (meta (var o/greet))))").(string)}, ``)}))
+			}
+		})
+	}(&cljs_core.AFn{})
+
+	cljs_core.Intern_("cljs.var-test", "test-alter-var-root", &Test_alter_var_root, map[string]interface{}{"arglists": [][]string{{}}})
+	cljs_core.Intern_("cljs.var-test", "test-var", &Test_var, map[string]interface{}{"arglists": [][]string{{}}})
+	cljs_core.Intern_("cljs.var-test", "test-with-redefs", &Test_with_redefs, map[string]interface{}{"arglists": [][]string{{}}})
+}
+
+var Test_with_redefs *cljs_core.AFn
+
+var Test_alter_var_root *cljs_core.AFn
+
+var Test_var *cljs_core.AFn
+
+func Test_runner(t *testing.T) {
+	Test_with_redefs.X_invoke_Arity0()
+	Test_alter_var_root.X_invoke_Arity0()
+	Test_var.X_invoke_Arity0()
+	assert.True(t, true)
+}
@@ -0,0 +1,38 @@
+// Written by hand from test/cljs/var_test_other_ns.cljs to match ClojureScript to Go 0.0-2411
+// and its ^:go-var-indirection ns, until regenerated by lein compile-clojurescript-tests.
+// cljs.var-test-other-ns
+
+package var_test_other_ns
+
+import (
+	"strings"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+)
+
+func init() {
+	cljs_core.Set_var_root_(Answer, float64(42))
+
+	cljs_core.Set_var_root_(Greet, func(greet *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(greet, 1, func(name interface{}) interface{} {
+			return strings.Join([]string{cljs_core.Str.X_invoke_Arity1("Hello ").(string), cljs_core.Str.X_invoke_Arity1(name).(string)}, ``)
+		})
+	}(&cljs_core.AFn{}))
+
+	cljs_core.Set_var_root_(Greet_all, func(greet_all *cljs_core.AFn) *cljs_core.AFn {
+		return cljs_core.Fn(greet_all, 1, func(names interface{}) interface{} {
+			return cljs_core.Map_.X_invoke_Arity2(cljs_core.Var_root_(Greet).(*cljs_core.AFn), names)
+		})
+	}(&cljs_core.AFn{}))
+
+	cljs_core.Intern_("cljs.var-test-other-ns", "answer", Answer, map[string]interface{}{})
+	cljs_core.Intern_("cljs.var-test-other-ns", "greet", Greet, map[string]interface{}{"doc": "Return a greeting.", "arglists": [][]string{{"name"}}})
+	cljs_core.Intern_("cljs.var-test-other-ns", "greet-all", Greet_all, map[string]interface{}{"arglists": [][]string{{"names"}}})
+}
+
+var Answer = cljs_core.Var_("cljs.var-test-other-ns", "answer")
+
+// Return a greeting.
+var Greet = cljs_core.Var_("cljs.var-test-other-ns", "greet")
+
+var Greet_all = cljs_core.Var_("cljs.var-test-other-ns", "greet-all")
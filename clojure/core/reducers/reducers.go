@@ -0,0 +1,414 @@
+// clojure.core.reducers
+
+// A library for reduction and parallel folding. Alpha and subject
+// to change. fold splits vectors and hash maps and combines the
+// results of the parts, which are reduced on separate goroutines.
+package reducers
+
+import (
+	"math"
+	"runtime"
+
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+)
+
+// The number of forked folds running at once is bounded by the number of CPUs.
+// When the pool is exhausted the fold runs on the calling goroutine instead.
+var forkPool = make(chan struct{}, runtime.NumCPU())
+
+func fork(f func() interface{}) func() interface{} {
+	select {
+	case forkPool <- struct{}{}:
+		var val, err interface{}
+		failed, done := false, make(chan struct{})
+		conveyed := cljs_core.Binding_conveyor_fn_(cljs_core.Fn(f))
+		go func() {
+			defer close(done)
+			defer func() {
+				<-forkPool
+				if e := recover(); e != nil {
+					err, failed = e, true
+				}
+			}()
+			val = conveyed.X_invoke_Arity0()
+		}()
+		return func() interface{} {
+			<-done
+			if failed {
+				panic(err)
+			}
+			return val
+		}
+	default:
+		val := f()
+		return func() interface{} {
+			return val
+		}
+	}
+}
+
+func foldvec(v interface{}, n float64, combinef interface{}, reducef interface{}) interface{} {
+	cnt := cljs_core.Count.X_invoke_Arity1(v).(float64)
+	switch {
+	case cnt == 0:
+		return combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity0()
+	case cnt <= n:
+		return Reduce.X_invoke_Arity3(reducef, combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity0(), v)
+	default:
+		split := math.Floor(cnt / 2)
+		v1, v2 := cljs_core.Subvec.X_invoke_Arity3(v, float64(0), split), cljs_core.Subvec.X_invoke_Arity3(v, split, cnt)
+		join := fork(func() interface{} {
+			return foldvec(v2, n, combinef, reducef)
+		})
+		return combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity2(foldvec(v1, n, combinef, reducef), join())
+	}
+}
+
+type kvReducible interface {
+	Kv_reduce(f interface{}, init interface{}) interface{}
+}
+
+func foldnode(node interface{}, combinef interface{}, reducef interface{}) interface{} {
+	if node, ok := node.(*cljs_core.CljsCoreArrayNode); ok {
+		var joins []func() interface{}
+		for _, child := range node.Arr.([]interface{}) {
+			if child := child; child != nil {
+				joins = append(joins, fork(func() interface{} {
+					return foldnode(child, combinef, reducef)
+				}))
+			}
+		}
+		if len(joins) == 0 {
+			return combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity0()
+		}
+		ret := joins[0]()
+		for _, join := range joins[1:] {
+			ret = combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity2(ret, join())
+		}
+		return ret
+	}
+	return cljs_core.Unreduced.X_invoke_Arity1(node.(kvReducible).Kv_reduce(reducef, combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity0()))
+}
+
+func foldmap(m *cljs_core.CljsCorePersistentHashMap, combinef interface{}, reducef interface{}) interface{} {
+	ret := combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity0()
+	if m.Root != nil {
+		ret = combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity2(ret, foldnode(m.Root, combinef, reducef))
+	}
+	if m.Has_nil_QMARK_ {
+		ret = combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity2(ret, reducef.(cljs_core.CljsCoreIFn).X_invoke_Arity3(combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity0(), nil, m.Nil_val))
+	}
+	return ret
+}
+
+type ClojureCoreReducersCollFold interface {
+	ClojureCoreReducersCollFold__()
+	X_coll_fold_Arity4(n interface{}, combinef interface{}, reducef interface{}) interface{}
+}
+
+var Coll_fold = cljs_core.Fn(func(coll interface{}, n interface{}, combinef interface{}, reducef interface{}) interface{} {
+	switch coll := coll.(type) {
+	case ClojureCoreReducersCollFold:
+		return coll.X_coll_fold_Arity4(n, combinef, reducef)
+	case nil:
+		return combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity0()
+	case *cljs_core.CljsCorePersistentVector, *cljs_core.CljsCoreSubvec:
+		return foldvec(coll, n.(float64), combinef, reducef)
+	case *cljs_core.CljsCorePersistentHashMap:
+		return foldmap(coll, combinef, reducef)
+	default:
+		return Reduce.X_invoke_Arity3(reducef, combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity0(), coll)
+	}
+})
+
+// Like core/reduce except:
+// When init is not provided, (f) is used.
+// Maps are reduced with reduce-kv
+var Reduce = func(reduce *cljs_core.AFn) *cljs_core.AFn {
+	return cljs_core.Fn(reduce, func(f interface{}, coll interface{}) interface{} {
+		return reduce.X_invoke_Arity3(f, f.(cljs_core.CljsCoreIFn).X_invoke_Arity0(), coll)
+	}, func(f interface{}, init interface{}, coll interface{}) interface{} {
+		if cljs_core.Map_QMARK_.Arity1IB(coll) {
+			return cljs_core.Reduce_kv.X_invoke_Arity3(f, init, coll)
+		}
+		return cljs_core.Reduce.X_invoke_Arity3(f, init, coll)
+	})
+}(&cljs_core.AFn{})
+
+// Reduces a collection using a (potentially parallel) reduce-combine
+// strategy. The collection is partitioned into groups of approximately
+// n (default 512), each of which is reduced with reducef (with a seed
+// value obtained by calling (combinef) with no arguments). The results
+// of these reductions are then reduced with combinef (default
+// reducef). combinef must be associative, and, when called with no
+// arguments, (combinef) must produce its identity element. These
+// operations may be performed in parallel, but the results will
+// preserve order. Vectors and hash maps are folded on separate
+// goroutines, other collections are reduced sequentially.
+var Fold = func(fold *cljs_core.AFn) *cljs_core.AFn {
+	return cljs_core.Fn(fold, func(reducef interface{}, coll interface{}) interface{} {
+		return fold.X_invoke_Arity3(reducef, reducef, coll)
+	}, func(combinef interface{}, reducef interface{}, coll interface{}) interface{} {
+		return fold.X_invoke_Arity4(float64(512), combinef, reducef, coll)
+	}, func(n interface{}, combinef interface{}, reducef interface{}, coll interface{}) interface{} {
+		return Coll_fold.X_invoke_Arity4(coll, n, combinef, reducef)
+	})
+}(&cljs_core.AFn{})
+
+type ClojureCoreReducersReducer struct {
+	Coll interface{}
+	Xf   interface{}
+}
+
+func (_ *ClojureCoreReducersReducer) CljsCoreIReduce__() {}
+func (this *ClojureCoreReducersReducer) X_reduce_Arity2(f1 interface{}) interface{} {
+	return this.X_reduce_Arity3(f1, f1.(cljs_core.CljsCoreIFn).X_invoke_Arity0())
+}
+func (this *ClojureCoreReducersReducer) X_reduce_Arity3(f1 interface{}, init interface{}) interface{} {
+	return Reduce.X_invoke_Arity3(this.Xf.(cljs_core.CljsCoreIFn).X_invoke_Arity1(f1), init, this.Coll)
+}
+
+type ClojureCoreReducersFolder struct {
+	ClojureCoreReducersReducer
+}
+
+func (_ *ClojureCoreReducersFolder) ClojureCoreReducersCollFold__() {}
+func (this *ClojureCoreReducersFolder) X_coll_fold_Arity4(n interface{}, combinef interface{}, reducef interface{}) interface{} {
+	return Coll_fold.X_invoke_Arity4(this.Coll, n, combinef, this.Xf.(cljs_core.CljsCoreIFn).X_invoke_Arity1(reducef))
+}
+
+// Given a reducible collection, and a transformation function xf,
+// returns a reducible collection, where any supplied reducing
+// fn will be transformed by xf. xf is a function of reducing fn to
+// reducing fn.
+var Reducer = cljs_core.Fn(func(coll interface{}, xf interface{}) interface{} {
+	return &ClojureCoreReducersReducer{coll, xf}
+})
+
+// Given a foldable collection, and a transformation function xf,
+// returns a foldable collection, where any supplied reducing
+// fn will be transformed by xf. xf is a function of reducing fn to
+// reducing fn.
+var Folder = cljs_core.Fn(func(coll interface{}, xf interface{}) interface{} {
+	return &ClojureCoreReducersFolder{ClojureCoreReducersReducer{coll, xf}}
+})
+
+// Builds a reducing fn from f1 which transforms its input with f, called with either one
+// element, or with a key and a value when reducing maps.
+func rfn(f1 interface{}, f func(ret interface{}, args ...interface{}) interface{}) *cljs_core.AFn {
+	return cljs_core.Fn(func() interface{} {
+		return f1.(cljs_core.CljsCoreIFn).X_invoke_Arity0()
+	}, func(ret interface{}, k interface{}) interface{} {
+		return f(ret, k)
+	}, func(ret interface{}, k interface{}, v interface{}) interface{} {
+		return f(ret, k, v)
+	})
+}
+
+func invoke(f interface{}, args ...interface{}) interface{} {
+	if len(args) == 1 {
+		return f.(cljs_core.CljsCoreIFn).X_invoke_Arity1(args[0])
+	}
+	return f.(cljs_core.CljsCoreIFn).X_invoke_Arity2(args[0], args[1])
+}
+
+func curried(f func(fn interface{}, coll interface{}) interface{}) *cljs_core.AFn {
+	return cljs_core.Fn(func(fn interface{}) interface{} {
+		return cljs_core.Fn(func(coll interface{}) interface{} {
+			return f(fn, coll)
+		})
+	}, f)
+}
+
+// Applies f to every value in the reduction of coll. Foldable.
+var Map_ = curried(func(f interface{}, coll interface{}) interface{} {
+	return Folder.X_invoke_Arity2(coll, cljs_core.Fn(func(f1 interface{}) interface{} {
+		return rfn(f1, func(ret interface{}, args ...interface{}) interface{} {
+			return f1.(cljs_core.CljsCoreIFn).X_invoke_Arity2(ret, invoke(f, args...))
+		})
+	}))
+})
+
+// Applies f to every value in the reduction of coll, concatenating the result
+// colls of (f val). Foldable.
+var Mapcat = curried(func(f interface{}, coll interface{}) interface{} {
+	return Folder.X_invoke_Arity2(coll, cljs_core.Fn(func(f1 interface{}) interface{} {
+		return rfn(f1, func(ret interface{}, args ...interface{}) interface{} {
+			return Reduce.X_invoke_Arity3(f1, ret, invoke(f, args...))
+		})
+	}))
+})
+
+// Retains values in the reduction of coll for which (pred val)
+// returns logical true. Foldable.
+var Filter = curried(func(pred interface{}, coll interface{}) interface{} {
+	return Folder.X_invoke_Arity2(coll, cljs_core.Fn(func(f1 interface{}) interface{} {
+		return rfn(f1, func(ret interface{}, args ...interface{}) interface{} {
+			if cljs_core.Truth_(invoke(pred, args...)) {
+				return invoke(f1, append([]interface{}{ret}, args...)...)
+			}
+			return ret
+		})
+	}))
+})
+
+// Removes values in the reduction of coll for which (pred val)
+// returns logical true. Foldable.
+var Remove = curried(func(pred interface{}, coll interface{}) interface{} {
+	return Filter.X_invoke_Arity2(cljs_core.Fn(0, func(args__ ...interface{}) interface{} {
+		return !cljs_core.Truth_(cljs_core.Apply.X_invoke_Arity2(pred, args__[0]))
+	}), coll)
+})
+
+// Takes any nested combination of sequential things (lists, vectors,
+// etc.) and returns their contents as a single, flat foldable
+// collection.
+var Flatten = func(flatten *cljs_core.AFn) *cljs_core.AFn {
+	return cljs_core.Fn(flatten, func() interface{} {
+		return flatten
+	}, func(coll interface{}) interface{} {
+		return Folder.X_invoke_Arity2(coll, cljs_core.Fn(func(f1 interface{}) interface{} {
+			return cljs_core.Fn(func() interface{} {
+				return f1.(cljs_core.CljsCoreIFn).X_invoke_Arity0()
+			}, func(ret interface{}, v interface{}) interface{} {
+				if cljs_core.Sequential_QMARK_.Arity1IB(v) {
+					return flatten.X_invoke_Arity1(v).(cljs_core.CljsCoreIReduce).X_reduce_Arity3(f1, ret)
+				}
+				return f1.(cljs_core.CljsCoreIFn).X_invoke_Arity2(ret, v)
+			})
+		}))
+	})
+}(&cljs_core.AFn{})
+
+// Ends the reduction of coll when (pred val) returns logical false.
+var Take_while = curried(func(pred interface{}, coll interface{}) interface{} {
+	return Reducer.X_invoke_Arity2(coll, cljs_core.Fn(func(f1 interface{}) interface{} {
+		return rfn(f1, func(ret interface{}, args ...interface{}) interface{} {
+			if cljs_core.Truth_(invoke(pred, args...)) {
+				return invoke(f1, append([]interface{}{ret}, args...)...)
+			}
+			return cljs_core.Reduced.X_invoke_Arity1(ret)
+		})
+	}))
+})
+
+// Ends the reduction of coll after consuming n values.
+var Take = curried(func(n interface{}, coll interface{}) interface{} {
+	return Reducer.X_invoke_Arity2(coll, cljs_core.Fn(func(f1 interface{}) interface{} {
+		cnt := n.(float64)
+		return rfn(f1, func(ret interface{}, args ...interface{}) interface{} {
+			if cnt--; cnt < 0 {
+				return cljs_core.Reduced.X_invoke_Arity1(ret)
+			}
+			return invoke(f1, append([]interface{}{ret}, args...)...)
+		})
+	}))
+})
+
+// Elides the first n values from the reduction of coll.
+var Drop = curried(func(n interface{}, coll interface{}) interface{} {
+	return Reducer.X_invoke_Arity2(coll, cljs_core.Fn(func(f1 interface{}) interface{} {
+		cnt := n.(float64)
+		return rfn(f1, func(ret interface{}, args ...interface{}) interface{} {
+			if cnt--; cnt < 0 {
+				return invoke(f1, append([]interface{}{ret}, args...)...)
+			}
+			return ret
+		})
+	}))
+})
+
+type ClojureCoreReducersCat struct {
+	Cnt   interface{}
+	Left  interface{}
+	Right interface{}
+}
+
+func leaf(coll interface{}) interface{} {
+	if coll, ok := coll.(*cljs_core.CljsCoreArrayList); ok {
+		return coll.ToArray()
+	}
+	return coll
+}
+
+func (_ *ClojureCoreReducersCat) CljsCoreICounted__() {}
+func (this *ClojureCoreReducersCat) X_count_Arity1() float64 {
+	return this.Cnt.(float64)
+}
+
+func (_ *ClojureCoreReducersCat) CljsCoreISeqable__() {}
+func (this *ClojureCoreReducersCat) X_seq_Arity1() interface{} {
+	return cljs_core.Seq.X_invoke_Arity1(cljs_core.Concat.X_invoke_Arity2(leaf(this.Left), leaf(this.Right)))
+}
+
+func (_ *ClojureCoreReducersCat) CljsCoreIReduce__() {}
+func (this *ClojureCoreReducersCat) X_reduce_Arity2(f1 interface{}) interface{} {
+	return this.X_reduce_Arity3(f1, f1.(cljs_core.CljsCoreIFn).X_invoke_Arity0())
+}
+func (this *ClojureCoreReducersCat) X_reduce_Arity3(f1 interface{}, init interface{}) interface{} {
+	return cljs_core.Reduce.X_invoke_Arity3(f1, cljs_core.Reduce.X_invoke_Arity3(f1, init, leaf(this.Left)), leaf(this.Right))
+}
+
+func (_ *ClojureCoreReducersCat) ClojureCoreReducersCollFold__() {}
+func (this *ClojureCoreReducersCat) X_coll_fold_Arity4(n interface{}, combinef interface{}, reducef interface{}) interface{} {
+	join := fork(func() interface{} {
+		return Coll_fold.X_invoke_Arity4(leaf(this.Right), n, combinef, reducef)
+	})
+	return combinef.(cljs_core.CljsCoreIFn).X_invoke_Arity2(Coll_fold.X_invoke_Arity4(leaf(this.Left), n, combinef, reducef), join())
+}
+
+func count(coll interface{}) float64 {
+	if coll, ok := coll.(*cljs_core.CljsCoreArrayList); ok {
+		return coll.Size().(float64)
+	}
+	return cljs_core.Count.X_invoke_Arity1(coll).(float64)
+}
+
+// A high-performance combining fn that yields the catenation of the
+// reduced values. The result is reducible, foldable, seqable and
+// counted, providing the identity collections are reducible, seqable
+// and counted. The single argument version will build a combining fn
+// with the supplied identity constructor. Tests for identity
+// with (zero? (count x)). See also foldcat.
+var Cat = func(cat *cljs_core.AFn) *cljs_core.AFn {
+	return cljs_core.Fn(cat, func() interface{} {
+		return cljs_core.Array_list.X_invoke_Arity0()
+	}, func(ctor interface{}) interface{} {
+		return cljs_core.Fn(func() interface{} {
+			return ctor.(cljs_core.CljsCoreIFn).X_invoke_Arity0()
+		}, func(left interface{}, right interface{}) interface{} {
+			return cat.X_invoke_Arity2(left, right)
+		})
+	}, func(left interface{}, right interface{}) interface{} {
+		switch {
+		case count(left) == 0:
+			return right
+		case count(right) == 0:
+			return left
+		default:
+			return &ClojureCoreReducersCat{count(left) + count(right), left, right}
+		}
+	})
+}(&cljs_core.AFn{})
+
+// .adds x to acc and returns acc
+var Append_BANG_ = cljs_core.Fn(func(acc interface{}, x interface{}) interface{} {
+	acc.(*cljs_core.CljsCoreArrayList).Add(x)
+	return acc
+})
+
+// Equivalent to (fold cat append! coll)
+var Foldcat = cljs_core.Fn(func(coll interface{}) interface{} {
+	return Fold.X_invoke_Arity3(Cat, Append_BANG_, coll)
+})
+
+// Builds a combining fn out of the supplied operator and identity
+// constructor. op must be associative and ctor called with no args
+// must return an identity value for it.
+var Monoid = cljs_core.Fn(func(op interface{}, ctor interface{}) interface{} {
+	return cljs_core.Fn(func() interface{} {
+		return ctor.(cljs_core.CljsCoreIFn).X_invoke_Arity0()
+	}, func(a interface{}, b interface{}) interface{} {
+		return op.(cljs_core.CljsCoreIFn).X_invoke_Arity2(a, b)
+	})
+})
@@ -0,0 +1,103 @@
+package reducers
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+import (
+	cljs_core "github.com/hraberg/cljs2go/cljs/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func vector(xs ...interface{}) interface{} {
+	return cljs_core.Vector.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1(xs))
+}
+
+func Test_Fold(t *testing.T) {
+	v := cljs_core.Vec.X_invoke_Arity1(cljs_core.Range_.X_invoke_Arity1(10000.0))
+	assert.Equal(t, 49995000.0, Fold.X_invoke_Arity2(cljs_core.X_PLUS_, v))
+	assert.Equal(t, 49995000.0, Fold.X_invoke_Arity4(100.0, cljs_core.X_PLUS_, cljs_core.X_PLUS_, v))
+	assert.Equal(t, 0.0, Fold.X_invoke_Arity2(cljs_core.X_PLUS_, vector()))
+	assert.Equal(t, 0.0, Fold.X_invoke_Arity2(cljs_core.X_PLUS_, nil))
+	assert.Equal(t, 6.0, Fold.X_invoke_Arity2(cljs_core.X_PLUS_, cljs_core.List.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{1.0, 2.0, 3.0}))))
+
+	m := cljs_core.Zipmap.X_invoke_Arity2(cljs_core.Range_.X_invoke_Arity1(1000.0), cljs_core.Range_.X_invoke_Arity1(1000.0))
+	m = cljs_core.Assoc.X_invoke_Arity3(m, nil, 1.0)
+	assert.IsType(t, &cljs_core.CljsCorePersistentHashMap{}, m)
+	sumVals := cljs_core.Fn(func() interface{} {
+		return 0.0
+	}, func(acc interface{}, k interface{}, v interface{}) interface{} {
+		return acc.(float64) + v.(float64)
+	})
+	assert.Equal(t, 499501.0, Fold.X_invoke_Arity3(cljs_core.X_PLUS_, sumVals, m))
+	assert.Equal(t, 499501.0, Reduce.X_invoke_Arity2(sumVals, m))
+
+	var running, maxRunning int32
+	slowPlus := cljs_core.Fn(func() interface{} {
+		return 0.0
+	}, func(acc interface{}, x interface{}) interface{} {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return acc.(float64) + x.(float64)
+	})
+	assert.Equal(t, 4950.0, Fold.X_invoke_Arity4(10.0, cljs_core.X_PLUS_, slowPlus, cljs_core.Vec.X_invoke_Arity1(cljs_core.Range_.X_invoke_Arity1(100.0))))
+	if cap(forkPool) > 1 {
+		assert.True(t, atomic.LoadInt32(&maxRunning) > 1)
+	}
+	assert.True(t, atomic.LoadInt32(&maxRunning) <= int32(cap(forkPool))+1)
+
+	assert.Panics(t, func() {
+		Fold.X_invoke_Arity4(1.0, cljs_core.X_PLUS_, cljs_core.Fn(func() interface{} {
+			return 0.0
+		}, func(acc interface{}, x interface{}) interface{} {
+			panic("Boom")
+		}), v)
+	})
+}
+
+func Test_Transformers(t *testing.T) {
+	v := cljs_core.Vec.X_invoke_Arity1(cljs_core.Range_.X_invoke_Arity1(10.0))
+	str := func(x interface{}) interface{} {
+		return cljs_core.Pr_str.X_invoke_ArityVariadic(cljs_core.Array_seq.X_invoke_Arity1([]interface{}{x}))
+	}
+	into := func(coll interface{}) interface{} {
+		return str(cljs_core.Into.X_invoke_Arity2(vector(), coll))
+	}
+	assert.Equal(t, "[1 2 3 4 5 6 7 8 9 10]", into(Map_.X_invoke_Arity2(cljs_core.Inc, v)))
+	assert.Equal(t, "[0 2 4 6 8]", into(Filter.X_invoke_Arity2(cljs_core.Even_QMARK_, v)))
+	assert.Equal(t, "[1 3 5 7 9]", into(Remove.X_invoke_Arity2(cljs_core.Even_QMARK_, v)))
+	assert.Equal(t, "[0 1 2]", into(Take.X_invoke_Arity2(3.0, v)))
+	assert.Equal(t, "[7 8 9]", into(Drop.X_invoke_Arity2(7.0, v)))
+	assert.Equal(t, "[0 1 2 3]", into(Take_while.X_invoke_Arity2(cljs_core.Fn(func(x interface{}) interface{} { return x.(float64) < 4 }), v)))
+	assert.Equal(t, "[0 0 1 1 2 2]", into(Mapcat.X_invoke_Arity2(cljs_core.Fn(func(x interface{}) interface{} { return vector(x, x) }), Take.X_invoke_Arity2(3.0, v))))
+	assert.Equal(t, "[1 2 3 4]", into(Flatten.X_invoke_Arity1(vector(1.0, vector(2.0, vector(3.0)), 4.0))))
+	assert.Equal(t, "[1 3 5 7 9]", into(Map_.X_invoke_Arity1(cljs_core.Inc).(cljs_core.CljsCoreIFn).X_invoke_Arity1(Filter.X_invoke_Arity1(cljs_core.Even_QMARK_).(cljs_core.CljsCoreIFn).X_invoke_Arity1(v))))
+
+	assert.Equal(t, 30.0, Fold.X_invoke_Arity2(cljs_core.X_PLUS_, Filter.X_invoke_Arity2(cljs_core.Even_QMARK_, Map_.X_invoke_Arity2(cljs_core.Inc, v))))
+	plus := Monoid.X_invoke_Arity2(cljs_core.X_PLUS_, cljs_core.Fn(func() interface{} { return 0.0 }))
+	assert.Equal(t, 90.0, Fold.X_invoke_Arity3(plus, cljs_core.X_PLUS_, Map_.X_invoke_Arity2(cljs_core.Fn(func(x interface{}) interface{} { return x.(float64) * 2 }), v)))
+
+	m := cljs_core.Zipmap.X_invoke_Arity2(cljs_core.Range_.X_invoke_Arity1(100.0), cljs_core.Range_.X_invoke_Arity1(100.0))
+	assert.Equal(t, 100.0, Fold.X_invoke_Arity3(cljs_core.X_PLUS_, cljs_core.Fn(func(acc interface{}, x interface{}) interface{} {
+		return acc.(float64) + 1
+	}), Map_.X_invoke_Arity2(cljs_core.Fn(func(k interface{}, v interface{}) interface{} { return v }), m)))
+}
+
+func Test_Foldcat(t *testing.T) {
+	v := cljs_core.Vec.X_invoke_Arity1(cljs_core.Range_.X_invoke_Arity1(2000.0))
+	c := Foldcat.X_invoke_Arity1(Map_.X_invoke_Arity2(cljs_core.Inc, v))
+	assert.IsType(t, &ClojureCoreReducersCat{}, c)
+	assert.Equal(t, 2000.0, cljs_core.Count.X_invoke_Arity1(c))
+	assert.Equal(t, cljs_core.Vec.X_invoke_Arity1(cljs_core.Range_.X_invoke_Arity2(1.0, 2001.0)), cljs_core.Vec.X_invoke_Arity1(c))
+	assert.Equal(t, 2001000.0, cljs_core.Reduce.X_invoke_Arity2(cljs_core.X_PLUS_, c))
+	assert.Equal(t, 2001000.0, Fold.X_invoke_Arity2(cljs_core.X_PLUS_, c))
+	assert.Equal(t, vector(1.0), Cat.X_invoke_Arity2(Cat.X_invoke_Arity0(), vector(1.0)))
+}
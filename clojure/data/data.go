@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript.
 // clojure.data
 
 // Non-core data functions.
@@ -137,6 +138,14 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("clojure.data", "atom-diff", &Atom_diff, map[string]interface{}{"doc": "Internal helper for diff.", "arglists": [][]string{{"a", "b"}}})
+	cljs_core.Intern_("clojure.data", "diff-associative", &Diff_associative, map[string]interface{}{"doc": "Diff associative things a and b, comparing only keys in ks (if supplied).", "arglists": [][]string{{"a", "b"}, {"a", "b", "ks"}}})
+	cljs_core.Intern_("clojure.data", "diff-associative-key", &Diff_associative_key, map[string]interface{}{"doc": "Diff associative things a and b, comparing only the key k.", "arglists": [][]string{{"a", "b", "k"}}})
+	cljs_core.Intern_("clojure.data", "diff-sequential", &Diff_sequential, map[string]interface{}{"arglists": [][]string{{"a", "b"}}})
+	cljs_core.Intern_("clojure.data", "diff-set", &Diff_set, map[string]interface{}{"arglists": [][]string{{"a", "b"}}})
+	cljs_core.Intern_("clojure.data", "diff-similar", &Diff_similar, map[string]interface{}{"arglists": [][]string{{"a", "b"}}})
+	cljs_core.Intern_("clojure.data", "equality-partition", &Equality_partition, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	cljs_core.Intern_("clojure.data", "vectorize", &Vectorize, map[string]interface{}{"doc": "Convert an associative-by-numeric-index collection into\nan equivalent vector, with nil for any missing keys", "arglists": [][]string{{"m"}}})
 }
 
 // Internal helper for diff.
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript.
 // clojure.data
 
 // Go overrides.
@@ -102,6 +103,9 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("clojure.data", "diff", &Diff, map[string]interface{}{"doc": "Recursively compares a and b, returning a tuple of\n[things-only-in-a things-only-in-b things-in-both].\nComparison rules:\n\n* For equal a and b, return [nil nil a].\n* Maps are subdiffed where keys match and values differ.\n* Sets are never subdiffed.\n* All sequential things are treated as associative collections\nby their indexes, with results returned as vectors.\n* Everything else (including strings!) is treated as\nan atom and compared for equality.", "arglists": [][]string{{"a", "b"}}})
+	cljs_core.Intern_("clojure.data", "ds", &Ds, map[string]interface{}{"arglists": [][]string{{"a", "b"}}})
+	cljs_core.Intern_("clojure.data", "ep", &Ep, map[string]interface{}{"arglists": [][]string{{"x"}}})
 }
 
 var Ep *cljs_core.AFn
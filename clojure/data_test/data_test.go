@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // clojure.data-test
 
 package data_test
@@ -84,6 +85,7 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("clojure.data-test", "test-data", &Test_data, map[string]interface{}{"arglists": [][]string{{}}})
 }
 
 var Test_data *cljs_core.AFn
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript.
 // clojure.set
 
 // Set operations such as union/intersection.
@@ -309,6 +310,19 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("clojure.set", "bubble-max-key", &Bubble_max_key, map[string]interface{}{"arglists": [][]string{{"k", "coll"}}})
+	cljs_core.Intern_("clojure.set", "difference", &Difference, map[string]interface{}{"doc": "Return a set that is the first set without elements of the remaining sets", "arglists": [][]string{{"s1"}, {"s1", "s2"}, {"s1", "s2", "&", "sets"}}})
+	cljs_core.Intern_("clojure.set", "index", &Index, map[string]interface{}{"doc": "Returns a map of the distinct values of ks in the xrel mapped to a\nset of the maps in xrel with the corresponding values of ks.", "arglists": [][]string{{"xrel", "ks"}}})
+	cljs_core.Intern_("clojure.set", "intersection", &Intersection, map[string]interface{}{"doc": "Return a set that is the intersection of the input sets", "arglists": [][]string{{"s1"}, {"s1", "s2"}, {"s1", "s2", "&", "sets"}}})
+	cljs_core.Intern_("clojure.set", "join", &Join, map[string]interface{}{"doc": "When passed 2 rels, returns the rel corresponding to the natural\njoin. When passed an additional keymap, joins on the corresponding\nkeys.", "arglists": [][]string{{"xrel", "yrel"}, {"xrel", "yrel", "km"}}})
+	cljs_core.Intern_("clojure.set", "map-invert", &Map_invert, map[string]interface{}{"doc": "Returns the map with the vals mapped to the keys.", "arglists": [][]string{{"m"}}})
+	cljs_core.Intern_("clojure.set", "project", &Project, map[string]interface{}{"doc": "Returns a rel of the elements of xrel with only the keys in ks", "arglists": [][]string{{"xrel", "ks"}}})
+	cljs_core.Intern_("clojure.set", "rename", &Rename, map[string]interface{}{"doc": "Returns a rel of the maps in xrel with the keys in kmap renamed to the vals in kmap", "arglists": [][]string{{"xrel", "kmap"}}})
+	cljs_core.Intern_("clojure.set", "rename-keys", &Rename_keys, map[string]interface{}{"doc": "Returns the map with the keys in kmap renamed to the vals in kmap", "arglists": [][]string{{"map", "kmap"}}})
+	cljs_core.Intern_("clojure.set", "select", &Select_, map[string]interface{}{"doc": "Returns a set of the elements for which pred is true", "arglists": [][]string{{"pred", "xset"}}})
+	cljs_core.Intern_("clojure.set", "subset?", &Subset_QMARK_, map[string]interface{}{"doc": "Is set1 a subset of set2?", "arglists": [][]string{{"set1", "set2"}}})
+	cljs_core.Intern_("clojure.set", "superset?", &Superset_QMARK_, map[string]interface{}{"doc": "Is set1 a superset of set2?", "arglists": [][]string{{"set1", "set2"}}})
+	cljs_core.Intern_("clojure.set", "union", &Union, map[string]interface{}{"doc": "Return a set that is the union of the input sets", "arglists": [][]string{{}, {"s1"}, {"s1", "s2"}, {"s1", "s2", "&", "sets"}}})
 }
 
 var Bubble_max_key *cljs_core.AFn
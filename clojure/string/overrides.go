@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript.
 // clojure.string
 
 // Go overrides.
@@ -51,6 +52,8 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("clojure.string", "replace", &Replace, map[string]interface{}{"doc": "Replaces all instance of match with replacement in s.\nmatch/replacement can be:\n\nstring / string\npattern / (string or function of match).", "arglists": [][]string{{"s", "match", "replacement"}}})
+	cljs_core.Intern_("clojure.string", "replace-first", &Replace_first, map[string]interface{}{"doc": "Replaces the first instance of match with replacement in s.\nmatch/replacement can be:\n\nstring / string\npattern / (string or function of match).", "arglists": [][]string{{"s", "match", "replacement"}}})
 }
 
 // Replaces all instance of match with replacement in s.
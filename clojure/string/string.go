@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript.
 // clojure.string
 
 package string
@@ -279,6 +280,24 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("clojure.string", "blank?", &Blank_QMARK_, map[string]interface{}{"doc": "True is s is nil, empty, or contains only whitespace.", "arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("clojure.string", "capitalize", &Capitalize, map[string]interface{}{"doc": "Converts first character of the string to upper-case, all other\ncharacters to lower-case.", "arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("clojure.string", "discard-trailing-if-needed", &Discard_trailing_if_needed, map[string]interface{}{"arglists": [][]string{{"limit", "v"}}})
+	cljs_core.Intern_("clojure.string", "escape", &Escape, map[string]interface{}{"doc": "Return a new string, using cmap to escape each character ch\nfrom s as follows:\n\nIf (cmap ch) is nil, append ch to the new string.\nIf (cmap ch) is non-nil, append (str (cmap ch)) instead.", "arglists": [][]string{{"s", "cmap"}}})
+	cljs_core.Intern_("clojure.string", "join", &Join, map[string]interface{}{"doc": "Returns a string of all elements in coll, as returned by (seq coll),\nseparated by an optional separator.", "arglists": [][]string{{"coll"}, {"separator", "coll"}}})
+	cljs_core.Intern_("clojure.string", "lower-case", &Lower_case, map[string]interface{}{"doc": "Converts string to all lower-case.", "arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("clojure.string", "pop-last-while-empty", &Pop_last_while_empty, map[string]interface{}{"arglists": [][]string{{"v"}}})
+	cljs_core.Intern_("clojure.string", "re-surrogate-pair", &Re_surrogate_pair, map[string]interface{}{})
+	cljs_core.Intern_("clojure.string", "reverse", &Reverse, map[string]interface{}{"doc": "Returns s with its characters reversed.", "arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("clojure.string", "seq-reverse", &Seq_reverse, map[string]interface{}{"arglists": [][]string{{"coll"}}})
+	cljs_core.Intern_("clojure.string", "split", &Split, map[string]interface{}{"doc": "Splits string on a regular expression. Optional argument limit is\nthe maximum number of splits. Not lazy. Returns vector of the splits.", "arglists": [][]string{{"s", "re"}, {"s", "re", "limit"}}})
+	cljs_core.Intern_("clojure.string", "split-lines", &Split_lines, map[string]interface{}{"doc": "Splits s on\nor\n.", "arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("clojure.string", "split-with-empty-regex", &Split_with_empty_regex, map[string]interface{}{"arglists": [][]string{{"s", "limit"}}})
+	cljs_core.Intern_("clojure.string", "trim", &Trim, map[string]interface{}{"doc": "Removes whitespace from both ends of string.", "arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("clojure.string", "trim-newline", &Trim_newline, map[string]interface{}{"doc": "Removes all trailing newline \\n or return \\r characters from\nstring.  Similar to Perl's chomp.", "arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("clojure.string", "triml", &Triml, map[string]interface{}{"doc": "Removes whitespace from the left side of string.", "arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("clojure.string", "trimr", &Trimr, map[string]interface{}{"doc": "Removes whitespace from the right side of string.", "arglists": [][]string{{"s"}}})
+	cljs_core.Intern_("clojure.string", "upper-case", &Upper_case, map[string]interface{}{"doc": "Converts string to all upper-case.", "arglists": [][]string{{"s"}}})
 }
 
 var Seq_reverse *cljs_core.AFn
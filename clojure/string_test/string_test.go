@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // clojure.string-test
 
 package string_test
@@ -240,6 +241,7 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("clojure.string-test", "test-string", &Test_string, map[string]interface{}{"arglists": [][]string{{}}})
 }
 
 var Test_string *cljs_core.AFn
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript.
 // clojure.walk
 
 // This file defines a generic tree walker for Clojure data
@@ -164,6 +165,13 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("clojure.walk", "keywordize-keys", &Keywordize_keys, map[string]interface{}{"doc": "Recursively transforms all map keys from strings to keywords.", "arglists": [][]string{{"m"}}})
+	cljs_core.Intern_("clojure.walk", "postwalk", &Postwalk, map[string]interface{}{"doc": "Performs a depth-first, post-order traversal of form.  Calls f on\neach sub-form, uses f's return value in place of the original.\nRecognizes all Clojure data structures. Consumes seqs as with doall.", "arglists": [][]string{{"f", "form"}}})
+	cljs_core.Intern_("clojure.walk", "postwalk-replace", &Postwalk_replace, map[string]interface{}{"doc": "Recursively transforms form by replacing keys in smap with their\nvalues.  Like clojure/replace but works on any data structure.  Does\nreplacement at the leaves of the tree first.", "arglists": [][]string{{"smap", "form"}}})
+	cljs_core.Intern_("clojure.walk", "prewalk", &Prewalk, map[string]interface{}{"doc": "Like postwalk, but does pre-order traversal.", "arglists": [][]string{{"f", "form"}}})
+	cljs_core.Intern_("clojure.walk", "prewalk-replace", &Prewalk_replace, map[string]interface{}{"doc": "Recursively transforms form by replacing keys in smap with their\nvalues.  Like clojure/replace but works on any data structure.  Does\nreplacement at the root of the tree first.", "arglists": [][]string{{"smap", "form"}}})
+	cljs_core.Intern_("clojure.walk", "stringify-keys", &Stringify_keys, map[string]interface{}{"doc": "Recursively transforms all map keys from keywords to strings.", "arglists": [][]string{{"m"}}})
+	cljs_core.Intern_("clojure.walk", "walk", &Walk, map[string]interface{}{"doc": "Traverses form, an arbitrary data structure.  inner and outer are\nfunctions.  Applies inner to each element of form, building up a\ndata structure of the same type, then applies outer to the result.\nRecognizes all Clojure data structures. Consumes seqs as with doall.", "arglists": [][]string{{"inner", "outer", "form"}}})
 }
 
 // Traverses form, an arbitrary data structure.  inner and outer are
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript.
 // clojure.zip
 
 // Functional hierarchical zipper, with navigation, editing,
@@ -619,6 +620,34 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("clojure.zip", "append-child", &Append_child, map[string]interface{}{"doc": "Inserts the item as the rightmost child of the node at this loc,\nwithout moving", "arglists": [][]string{{"loc", "item"}}})
+	cljs_core.Intern_("clojure.zip", "branch?", &Branch_QMARK_, map[string]interface{}{"doc": "Returns true if the node at loc is a branch", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "children", &Children, map[string]interface{}{"doc": "Returns a seq of the children of node at loc, which must be a branch", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "down", &Down, map[string]interface{}{"doc": "Returns the loc of the leftmost child of the node at this loc, or\nnil if no children", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "edit", &Edit, map[string]interface{}{"doc": "Replaces the node at this loc with the value of (f node args)", "arglists": [][]string{{"loc", "f", "&", "args"}}})
+	cljs_core.Intern_("clojure.zip", "end?", &End_QMARK_, map[string]interface{}{"doc": "Returns true if loc represents the end of a depth-first walk", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "insert-child", &Insert_child, map[string]interface{}{"doc": "Inserts the item as the leftmost child of the node at this loc,\nwithout moving", "arglists": [][]string{{"loc", "item"}}})
+	cljs_core.Intern_("clojure.zip", "insert-left", &Insert_left, map[string]interface{}{"doc": "Inserts the item as the left sibling of the node at this loc,\nwithout moving", "arglists": [][]string{{"loc", "item"}}})
+	cljs_core.Intern_("clojure.zip", "insert-right", &Insert_right, map[string]interface{}{"doc": "Inserts the item as the right sibling of the node at this loc,\nwithout moving", "arglists": [][]string{{"loc", "item"}}})
+	cljs_core.Intern_("clojure.zip", "left", &Left, map[string]interface{}{"doc": "Returns the loc of the left sibling of the node at this loc, or nil", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "leftmost", &Leftmost, map[string]interface{}{"doc": "Returns the loc of the leftmost sibling of the node at this loc, or self", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "lefts", &Lefts, map[string]interface{}{"doc": "Returns a seq of the left siblings of this loc", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "make-node", &Make_node, map[string]interface{}{"doc": "Returns a new branch node, given an existing node and new\nchildren. The loc is only used to supply the constructor.", "arglists": [][]string{{"loc", "node", "children"}}})
+	cljs_core.Intern_("clojure.zip", "next", &Next, map[string]interface{}{"doc": "Moves to the next loc in the hierarchy, depth-first. When reaching\nthe end, returns a distinguished loc detectable via end?. If already\nat the end, stays there.", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "node", &Node, map[string]interface{}{"doc": "Returns the node at loc", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "path", &Path, map[string]interface{}{"doc": "Returns a seq of nodes leading to this loc", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "prev", &Prev, map[string]interface{}{"doc": "Moves to the previous loc in the hierarchy, depth-first. If already\nat the root, returns nil.", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "remove", &Remove, map[string]interface{}{"doc": "Removes the node at loc, returning the loc that would have preceded\nit in a depth-first walk.", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "replace", &Replace, map[string]interface{}{"doc": "Replaces the node at this loc, without moving", "arglists": [][]string{{"loc", "node"}}})
+	cljs_core.Intern_("clojure.zip", "right", &Right, map[string]interface{}{"doc": "Returns the loc of the right sibling of the node at this loc, or nil", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "rightmost", &Rightmost, map[string]interface{}{"doc": "Returns the loc of the rightmost sibling of the node at this loc, or self", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "rights", &Rights, map[string]interface{}{"doc": "Returns a seq of the right siblings of this loc", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "root", &Root, map[string]interface{}{"doc": "zips all the way up and returns the root node, reflecting any\nchanges.", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "seq-zip", &Seq_zip, map[string]interface{}{"doc": "Returns a zipper for nested sequences, given a root sequence", "arglists": [][]string{{"root"}}})
+	cljs_core.Intern_("clojure.zip", "up", &Up, map[string]interface{}{"doc": "Returns the loc of the parent of the node at this loc, or nil if at\nthe top", "arglists": [][]string{{"loc"}}})
+	cljs_core.Intern_("clojure.zip", "vector-zip", &Vector_zip, map[string]interface{}{"doc": "Returns a zipper for nested vectors, given a root vector", "arglists": [][]string{{"root"}}})
+	cljs_core.Intern_("clojure.zip", "xml-zip", &Xml_zip, map[string]interface{}{"doc": "Returns a zipper for xml elements (as from xml/parse),\ngiven a root element", "arglists": [][]string{{"root"}}})
+	cljs_core.Intern_("clojure.zip", "zipper", &Zipper, map[string]interface{}{"doc": "Creates a new zipper structure.\n\nbranch? is a fn that, given a node, returns true if can have\nchildren, even if it currently doesn't.\n\nchildren is a fn that, given a branch node, returns a seq of its\nchildren.\n\nmake-node is a fn that, given an existing node and a seq of\nchildren, returns a new branch node with the supplied children.\nroot is the root node.", "arglists": [][]string{{"branch?", "children", "make-node", "root"}}})
 }
 
 // Creates a new zipper structure.
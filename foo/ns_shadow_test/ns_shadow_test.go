@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2411
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // foo.ns-shadow-test
 
 package ns_shadow_test
@@ -63,6 +64,12 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("foo.ns-shadow-test", "bar", &Bar, map[string]interface{}{"arglists": [][]string{{}}})
+	cljs_core.Intern_("foo.ns-shadow-test", "baz", &Baz, map[string]interface{}{"arglists": [][]string{{}, {"x"}}})
+	cljs_core.Intern_("foo.ns-shadow-test", "foo", &Foo, map[string]interface{}{"arglists": [][]string{{}}})
+	cljs_core.Intern_("foo.ns-shadow-test", "id", &Id, map[string]interface{}{"arglists": [][]string{{"x"}}})
+	cljs_core.Intern_("foo.ns-shadow-test", "quux", &Quux, map[string]interface{}{"arglists": [][]string{{"foo---1"}}})
+	cljs_core.Intern_("foo.ns-shadow-test", "test-shadow", &Test_shadow, map[string]interface{}{"arglists": [][]string{{}}})
 }
 
 var Bar *cljs_core.AFn
@@ -81,18 +81,30 @@ func IsEmptySafe(str interface{}) bool {
 	}
 }
 
-func IsNumeric(str string) bool {
-	return len(strings.FieldsFunc(str, unicode.IsNumber)) == 0
+// Both take nil, which the reader passes at the end of its input, and which like in JavaScript is
+// neither numeric nor whitespace.
+func IsNumeric(str interface{}) bool {
+	s, ok := str.(string)
+	return ok && len(strings.FieldsFunc(s, unicode.IsNumber)) == 0
 }
 
-func IsBreakingWhitespace(str string) bool {
-	return len(strings.FieldsFunc(str, unicode.IsSpace)) == 0
+func IsBreakingWhitespace(str interface{}) bool {
+	s, ok := str.(string)
+	return ok && len(strings.FieldsFunc(s, unicode.IsSpace)) == 0
 }
 
 func Contains(str, subString string) bool {
 	return strings.Contains(str, subString)
 }
 
+func StartsWith(str, prefix string) bool {
+	return strings.HasPrefix(str, prefix)
+}
+
+func EndsWith(str, suffix string) bool {
+	return strings.HasSuffix(str, suffix)
+}
+
 func BuildString(var_args ...interface{}) string {
 	ss := make([]string, len(var_args))
 	for i, v := range var_args {
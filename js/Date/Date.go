@@ -0,0 +1,36 @@
+package Date
+
+import (
+	"math"
+	"time"
+
+	"github.com/hraberg/cljs2go/js"
+)
+
+// The static functions of the JavaScript Date, in their own package like Math, as js.Date is the type.
+
+func Now() float64 {
+	return float64(time.Now().UnixNano() / (1000 * 1000))
+}
+
+// Returns the time value of the date string s, or NaN if it isn't in a format js.Date knows.
+func Parse(s string) float64 {
+	return (&js.Date{Millis: s}).GetTime()
+}
+
+// Returns the time value of the UTC date and time, where only year is required. Years 0 to 99
+// mean 1900 to 1999.
+func UTC(year, month, date, hours, minutes, seconds, ms interface{}) float64 {
+	if y, ok := year.(float64); ok && y >= 0 && y < 100 {
+		year = 1900 + math.Trunc(y)
+	}
+	or := func(x interface{}, value float64) interface{} {
+		if x == nil {
+			return value
+		}
+		return x
+	}
+	d := &js.Date{Millis: 0.0}
+	d.SetUTCFullYear(year, or(month, 0), or(date, 1))
+	return d.SetUTCHours(or(hours, 0), or(minutes, 0), or(seconds, 0), or(ms, 0))
+}
@@ -0,0 +1,23 @@
+package Date
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Date(t *testing.T) {
+	assert.Equal(t, 1289585655666.0, Parse("2010-11-12T13:14:15.666-05:00"))
+	assert.Equal(t, 1289520000000.0, Parse("2010-11-12"))
+	assert.Equal(t, math.NaN(), Parse("12 Nov 2010"))
+
+	assert.Equal(t, 1289567655666.0, UTC(2010.0, 10.0, 12.0, 13.0, 14.0, 15.0, 666.0))
+	assert.Equal(t, 1289520000000.0, UTC(2010.0, 10.0, 12.0, nil, nil, nil, nil))
+	assert.Equal(t, UTC(1999.0, 0.0, nil, nil, nil, nil, nil), UTC(99.0, nil, nil, nil, nil, nil, nil))
+	assert.Equal(t, math.NaN(), UTC(nil, nil, nil, nil, nil, nil, nil))
+
+	before := float64(time.Now().UnixNano() / int64(time.Millisecond))
+	assert.True(t, Now() >= before)
+}
@@ -28,6 +28,30 @@ func (e *TypeError) Error() string {
 	return fmt.Sprint(e.Message)
 }
 
+// Returns the Go error wrapped by this Error, if any.
+func (e *Error) Unwrap() error {
+	err, _ := e.Message.(error)
+	return err
+}
+
+// A panic in Go code called from ClojureScript, thrown as the Message of an Error. Value is the
+// recovered value.
+type PanicError struct {
+	Message interface{}
+	Value   interface{}
+	Stack   string
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprint(e.Message)
+}
+
+// Returns the recovered value if it is an error, like runtime.Error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
 type JSObject map[string]interface{}
 type JSNil struct{}
 type JSBoolean bool
@@ -40,76 +64,339 @@ type Date struct {
 	Millis interface{}
 }
 
-func (this *Date) time() time.Time {
+// The largest time value in milliseconds either side of the epoch a Date can hold.
+const maxTime = 8.64e15
+
+// A year beyond the years maxTime reaches, used to reject fields before computing with them.
+const maxYear = 300000
+
+const msPerMinute = 60 * 1000
+const msPerHour = 60 * msPerMinute
+const msPerDay = 24 * msPerHour
+
+// Returns the UTC time of a time value in milliseconds, or NaN if it is out of range.
+func millisTime(ms float64) interface{} {
+	if math.IsNaN(ms) || math.Abs(ms) > maxTime {
+		return NaN
+	}
+	ms = math.Trunc(ms)
+	return time.Unix(int64(ms)/1000, 1000*1000*(int64(ms)%1000)).UTC()
+}
+
+// Converts a setter argument to a number, undefined and anything not a number being NaN.
+func number(x interface{}) float64 {
+	switch n := x.(type) {
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	case string:
+		return ParseFloat(n)
+	}
+	return NaN
+}
+
+// Returns the time of this Date and true, or false for an Invalid Date, like one parsed from a
+// string in an unknown format. Millis is normalized to a UTC time.Time, or NaN when invalid.
+func (this *Date) valid() (time.Time, bool) {
 	switch d := this.Millis.(type) {
 	case time.Time:
-		return d
+		return d, true
 	case string:
+		this.Millis = NaN
 		for _, f := range []string{
-			"2006-01-02T15:04:05.000-07:00",
-			"2006-01-02T15:04:05.000",
+			time.RFC3339Nano,
 			"2006-01-02T15:04:05",
 			"2006-01-02T15:04",
 			"2006-01-02T15",
 			"2006-01-02",
 			"2006-01",
 			"2006"} {
-			if t, ok := time.Parse(f, d); ok == nil {
-				this.Millis = t
+			if t, err := time.Parse(f, d); err == nil {
+				this.Millis = t.UTC()
 				break
 			}
 		}
 	case float64:
-		this.Millis = time.Unix(int64(d)/1000, 1000*1000*(int64(d)%1000))
+		this.Millis = millisTime(d)
 	case int:
-		this.Millis = time.Unix(int64(d)/1000, 1000*1000*(int64(d)%1000))
+		this.Millis = millisTime(float64(d))
 	case nil:
-		this.Millis = time.Now()
+		this.Millis = time.Now().UTC()
+	default:
+		panic(&TypeError{fmt.Sprintf("Unknown date type: %v", this.Millis)})
 	}
-	if d, ok := this.Millis.(time.Time); ok {
-		return d
+	t, ok := this.Millis.(time.Time)
+	return t, ok
+}
+
+func (this *Date) time() time.Time {
+	t, ok := this.valid()
+	if !ok {
+		panic(&Error{"Invalid time value"})
 	}
-	panic(&Error{fmt.Sprint("Unknown date type: %v", this.Millis)})
+	return t
+}
+
+func (this *Date) utc(field func(time.Time) int) float64 {
+	if t, ok := this.valid(); ok {
+		return float64(field(t.UTC()))
+	}
+	return NaN
+}
+
+func (this *Date) local(field func(time.Time) int) float64 {
+	if t, ok := this.valid(); ok {
+		return float64(field(t.Local()))
+	}
+	return NaN
+}
+
+func month(t time.Time) int {
+	return int(t.Month()) - 1
+}
+
+func weekday(t time.Time) int {
+	return int(t.Weekday())
+}
+
+func millisecond(t time.Time) int {
+	return t.Nanosecond() / (1000 * 1000)
+}
+
+// Sets the fields of this Date in loc from the setter arguments, starting at index first of
+// year, month, date, hours, minutes, seconds and milliseconds, and returns the new time value.
+// Only the first argument is required, and fields overflow into larger ones like in JavaScript.
+func (this *Date) set(loc *time.Location, first int, args ...interface{}) float64 {
+	t, ok := this.valid()
+	if !ok {
+		if first != 0 {
+			return NaN
+		}
+		t = time.Date(1970, 1, 1, 0, 0, 0, 0, loc)
+	}
+	t = t.In(loc)
+	fields := []float64{float64(t.Year()), float64(month(t)), float64(t.Day()),
+		float64(t.Hour()), float64(t.Minute()), float64(t.Second()), float64(millisecond(t))}
+	nanos := t.Nanosecond() % (1000 * 1000)
+	for i, arg := range args {
+		if i == 0 || arg != nil {
+			fields[first+i] = number(arg)
+			if first+i == len(fields)-1 {
+				nanos = 0
+			}
+		}
+	}
+	for i, f := range fields {
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			this.Millis = NaN
+			return NaN
+		}
+		fields[i] = math.Trunc(f)
+	}
+	// Like MakeDay and MakeTime in JavaScript, the fields below the month are summed as milliseconds
+	// of wall clock time, so large ones carry into the others without overflowing int64 in time.Date.
+	year, month := fields[0]+math.Floor(fields[1]/12), math.Mod(fields[1], 12)
+	if month < 0 {
+		month += 12
+	}
+	if math.Abs(year) > maxYear {
+		this.Millis = NaN
+		return NaN
+	}
+	wall := float64(time.Date(int(year), time.Month(month+1), 1, 0, 0, 0, 0, time.UTC).Unix())*1000 +
+		(fields[2]-1)*msPerDay + fields[3]*msPerHour + fields[4]*msPerMinute + fields[5]*1000 + fields[6]
+	if math.Abs(wall) > maxTime+msPerDay {
+		this.Millis = NaN
+		return NaN
+	}
+	secs := math.Floor(wall / 1000)
+	w := time.Unix(int64(secs), int64(wall-secs*1000)*1000*1000+int64(nanos)).UTC()
+	t = time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), w.Nanosecond(), loc)
+	if math.Abs(float64(t.Unix()*1000+int64(millisecond(t)))) > maxTime {
+		this.Millis = NaN
+	} else {
+		this.Millis = t.UTC()
+	}
+	return this.GetTime()
 }
 
 func (this *Date) GetTime() float64 {
-	return float64(this.time().UTC().UnixNano() / (1000 * 1000))
+	if t, ok := this.valid(); ok {
+		return float64(t.Unix()*1000 + int64(millisecond(t)))
+	}
+	return NaN
 }
 
 func (this *Date) ValueOf() float64 {
 	return this.GetTime()
 }
 
+// Returns the difference in minutes between UTC and the local time of time.Local at this Date,
+// positive west of UTC.
+func (this *Date) GetTimezoneOffset() float64 {
+	return this.local(func(t time.Time) int {
+		_, offset := t.Zone()
+		return -offset / 60
+	})
+}
+
+func (this *Date) GetFullYear() float64 {
+	return this.local(time.Time.Year)
+}
+
+func (this *Date) GetMonth() float64 {
+	return this.local(month)
+}
+
+func (this *Date) GetDate() float64 {
+	return this.local(time.Time.Day)
+}
+
+func (this *Date) GetDay() float64 {
+	return this.local(weekday)
+}
+
+func (this *Date) GetHours() float64 {
+	return this.local(time.Time.Hour)
+}
+
+func (this *Date) GetMinutes() float64 {
+	return this.local(time.Time.Minute)
+}
+
+func (this *Date) GetSeconds() float64 {
+	return this.local(time.Time.Second)
+}
+
+func (this *Date) GetMilliseconds() float64 {
+	return this.local(millisecond)
+}
+
 func (this *Date) GetUTCFullYear() float64 {
-	return float64(this.time().UTC().Year())
+	return this.utc(time.Time.Year)
 }
 
 func (this *Date) GetUTCMonth() float64 {
-	return float64(this.time().UTC().Month() - 1)
+	return this.utc(month)
 }
 
 func (this *Date) GetUTCDate() float64 {
-	return float64(this.time().UTC().Day())
+	return this.utc(time.Time.Day)
+}
+
+func (this *Date) GetUTCDay() float64 {
+	return this.utc(weekday)
 }
 
 func (this *Date) GetUTCHours() float64 {
-	return float64(this.time().UTC().Hour())
+	return this.utc(time.Time.Hour)
 }
 
 func (this *Date) GetUTCMinutes() float64 {
-	return float64(this.time().UTC().Minute())
+	return this.utc(time.Time.Minute)
 }
 
 func (this *Date) GetUTCSeconds() float64 {
-	return float64(this.time().UTC().Second())
+	return this.utc(time.Time.Second)
 }
 
 func (this *Date) GetUTCMilliseconds() float64 {
-	return float64(this.time().UTC().Nanosecond() / (1000 * 1000))
+	return this.utc(millisecond)
+}
+
+// Returns the nanoseconds within the second. This is not part of the JavaScript Date API, which
+// stops at milliseconds, but lets #inst print timestamps read with nanosecond precision.
+func (this *Date) GetNanoseconds() float64 {
+	return this.utc(time.Time.Nanosecond)
+}
+
+func (this *Date) SetTime(ms interface{}) float64 {
+	this.Millis = millisTime(number(ms))
+	return this.GetTime()
+}
+
+func (this *Date) SetFullYear(year, month, date interface{}) float64 {
+	return this.set(time.Local, 0, year, month, date)
+}
+
+func (this *Date) SetMonth(month, date interface{}) float64 {
+	return this.set(time.Local, 1, month, date)
+}
+
+func (this *Date) SetDate(date interface{}) float64 {
+	return this.set(time.Local, 2, date)
+}
+
+func (this *Date) SetHours(hours, minutes, seconds, ms interface{}) float64 {
+	return this.set(time.Local, 3, hours, minutes, seconds, ms)
 }
 
+func (this *Date) SetMinutes(minutes, seconds, ms interface{}) float64 {
+	return this.set(time.Local, 4, minutes, seconds, ms)
+}
+
+func (this *Date) SetSeconds(seconds, ms interface{}) float64 {
+	return this.set(time.Local, 5, seconds, ms)
+}
+
+func (this *Date) SetMilliseconds(ms interface{}) float64 {
+	return this.set(time.Local, 6, ms)
+}
+
+func (this *Date) SetUTCFullYear(year, month, date interface{}) float64 {
+	return this.set(time.UTC, 0, year, month, date)
+}
+
+func (this *Date) SetUTCMonth(month, date interface{}) float64 {
+	return this.set(time.UTC, 1, month, date)
+}
+
+func (this *Date) SetUTCDate(date interface{}) float64 {
+	return this.set(time.UTC, 2, date)
+}
+
+func (this *Date) SetUTCHours(hours, minutes, seconds, ms interface{}) float64 {
+	return this.set(time.UTC, 3, hours, minutes, seconds, ms)
+}
+
+func (this *Date) SetUTCMinutes(minutes, seconds, ms interface{}) float64 {
+	return this.set(time.UTC, 4, minutes, seconds, ms)
+}
+
+func (this *Date) SetUTCSeconds(seconds, ms interface{}) float64 {
+	return this.set(time.UTC, 5, seconds, ms)
+}
+
+func (this *Date) SetUTCMilliseconds(ms interface{}) float64 {
+	return this.set(time.UTC, 6, ms)
+}
+
+// Returns the UTC time in the simplified ISO 8601 format of JavaScript, with milliseconds and
+// six digit years outside 0 to 9999. Throws for an Invalid Date.
+func (this *Date) ToISOString() string {
+	t := this.time()
+	s := t.Format("-01-02T15:04:05.000Z")
+	if year := t.Year(); year < 0 || year > 9999 {
+		return fmt.Sprintf("%+07d", year) + s
+	}
+	return t.Format("2006") + s
+}
+
+// Returns ToISOString, or nil for an Invalid Date.
+func (this *Date) ToJSON() interface{} {
+	if _, ok := this.valid(); !ok {
+		return nil
+	}
+	return this.ToISOString()
+}
+
+// Returns the local time as RFC 3339 with as many fractional digits as needed, up to nanoseconds.
 func (this *Date) String() string {
-	return this.time().String()
+	if _, ok := this.valid(); !ok {
+		return "Invalid Date"
+	}
+	return this.time().Local().Format(time.RFC3339Nano)
 }
 
 func (this *Date) ToString() string {
@@ -53,7 +53,7 @@ func Test_JS(t *testing.T) {
 	assert.Equal(t, 671, date.GetUTCMilliseconds())
 	assert.Equal(t, 1407962432671.0, date.GetTime())
 	assert.Equal(t, 1407962432671.0, date.ValueOf())
-	assert.Equal(t, "2014-08-13 21:40:32.671 +0100 BST", date.String())
+	assert.Equal(t, time.Unix(1407962432, 671000000).Format("2006-01-02T15:04:05.000Z07:00"), date.String())
 
 	date = &Date{"2010-11-12T13:14:15.666-05:00"}
 	assert.Equal(t, 2010, date.GetUTCFullYear())
@@ -65,6 +65,12 @@ func Test_JS(t *testing.T) {
 	assert.Equal(t, 666, date.GetUTCMilliseconds())
 	assert.Equal(t, 1289585655666.0, date.GetTime())
 	assert.Equal(t, 1289585655666.0, date.ValueOf())
+	assert.Equal(t, 666000000.0, date.GetNanoseconds())
+
+	date = &Date{"1969-12-31T23:59:59.123456789Z"}
+	assert.Equal(t, -877.0, date.GetTime())
+	assert.Equal(t, 123456789.0, date.GetNanoseconds())
+	assert.Equal(t, time.Date(1969, 12, 31, 23, 59, 59, 123456789, time.UTC).Local().Format(time.RFC3339Nano), date.String())
 	assert.True(t, (&Date{}).time().Before(time.Now()))
 
 	assert.Equal(t, 3.14, ParseFloat("3.14"))
@@ -155,3 +161,77 @@ func Test_JS(t *testing.T) {
 
 	assert.Equal(t, 0, JSNil{}.X_count_Arity1())
 }
+
+func Test_Date(t *testing.T) {
+	defer func(local *time.Location) { time.Local = local }(time.Local)
+	time.Local = time.FixedZone("EST", -5*60*60)
+
+	date := &Date{"2010-11-12T03:04:05.666Z"}
+	assert.Equal(t, 300, date.GetTimezoneOffset())
+	assert.Equal(t, 2010, date.GetFullYear())
+	assert.Equal(t, 10, date.GetMonth())
+	assert.Equal(t, 11, date.GetDate())
+	assert.Equal(t, 4, date.GetDay())
+	assert.Equal(t, 22, date.GetHours())
+	assert.Equal(t, 4, date.GetMinutes())
+	assert.Equal(t, 5, date.GetSeconds())
+	assert.Equal(t, 666, date.GetMilliseconds())
+	assert.Equal(t, 5, date.GetUTCDay())
+	assert.Equal(t, "2010-11-11T22:04:05.666-05:00", date.String())
+	assert.Equal(t, "2010-11-12T03:04:05.666Z", date.ToISOString())
+	assert.Equal(t, "2010-11-12T03:04:05.666Z", date.ToJSON())
+
+	assert.Equal(t, 1289534645666.0, date.SetHours(23, nil, nil, nil))
+	assert.Equal(t, "2010-11-12T04:04:05.666Z", date.ToISOString())
+	date.SetMinutes(90, 0, nil)
+	assert.Equal(t, "2010-11-12T05:30:00.666Z", date.ToISOString())
+	date.SetDate(31)
+	assert.Equal(t, "2010-12-01T05:30:00.666Z", date.ToISOString())
+	date.SetMonth(1, 29)
+	assert.Equal(t, "2010-03-01T05:30:00.666Z", date.ToISOString())
+	date.SetFullYear(2012, nil, nil)
+	assert.Equal(t, 2, date.GetMonth())
+	date.SetSeconds(-1, 500)
+	assert.Equal(t, "2012-03-01T05:29:59.500Z", date.ToISOString())
+	date.SetMilliseconds(1001)
+	assert.Equal(t, "2012-03-01T05:30:00.001Z", date.ToISOString())
+
+	date.SetUTCFullYear(-1, 0, 1)
+	date.SetUTCHours(0, 0, 0, 0)
+	assert.Equal(t, "-000001-01-01T00:00:00.000Z", date.ToISOString())
+	date.SetUTCMonth(11, nil)
+	date.SetUTCDate(31)
+	date.SetUTCMinutes(59, 59, 999)
+	date.SetUTCSeconds(30, nil)
+	date.SetUTCMilliseconds(0)
+	assert.Equal(t, "-000001-12-31T00:59:30.000Z", date.ToISOString())
+	assert.Equal(t, 0, date.SetTime(0))
+	assert.Equal(t, "1970-01-01T00:00:00.000Z", date.ToISOString())
+
+	date = &Date{"2010-11-12T13:14:15.666666666Z"}
+	date.SetUTCHours(0, nil, nil, nil)
+	assert.Equal(t, 666666666.0, date.GetNanoseconds())
+	date.SetUTCMilliseconds(1)
+	assert.Equal(t, 1000000.0, date.GetNanoseconds())
+
+	invalid := &Date{"12 Nov 2010"}
+	assert.Equal(t, math.NaN(), invalid.GetTime())
+	assert.Equal(t, math.NaN(), invalid.GetUTCFullYear())
+	assert.Equal(t, math.NaN(), invalid.GetHours())
+	assert.Equal(t, math.NaN(), invalid.SetHours(1, nil, nil, nil))
+	assert.Equal(t, "Invalid Date", invalid.String())
+	assert.Nil(t, invalid.ToJSON())
+	assert.Panics(t, func() { invalid.ToISOString() })
+	assert.Equal(t, 18000000.0, invalid.SetFullYear(1970, 0, 1))
+	assert.Equal(t, math.NaN(), (&Date{8.64e15 + 1}).GetTime())
+	assert.Equal(t, math.NaN(), (&Date{0.0}).SetHours(nil, nil, nil, nil))
+	assert.Equal(t, math.NaN(), (&Date{"12 Nov 2010"}).GetNanoseconds())
+
+	assert.Equal(t, 1e13, (&Date{0.0}).SetUTCMilliseconds(1e13))
+	assert.Equal(t, -1e13, (&Date{0.0}).SetUTCMilliseconds(-1e13))
+	assert.Equal(t, 3.6e15, (&Date{0.0}).SetUTCHours(1e9, nil, nil, nil))
+	assert.Equal(t, 8.64e15, (&Date{0.0}).SetUTCDate(1e8+1))
+	assert.Equal(t, math.NaN(), (&Date{0.0}).SetUTCMilliseconds(8.64e15+1))
+	assert.Equal(t, math.NaN(), (&Date{0.0}).SetUTCFullYear(1e18, nil, nil))
+	assert.Panics(t, func() { (&Date{true}).GetTime() })
+}
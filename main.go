@@ -3,12 +3,19 @@ package main
 
 import (
 	"log"
+	"os"
 	"os/exec"
+
+	"github.com/hraberg/cljs2go/cljs/repl"
 )
 
 import . "github.com/hraberg/cljs2go/cljs/core"
 
 var _main = Fn(func(args ...interface{}) interface{} {
+	if First.X_invoke_Arity1(args[0]) == "repl" {
+		repl.Repl_(os.Stdin, os.Stdout)
+		return nil
+	}
 	Println.X_invoke_ArityVariadic(Array_seq.X_invoke_Arity1([]interface{}{"ClojureScript to Go [go]"}))
 	goGet := exec.Command("go", "get", "code.google.com/p/go.tools/cmd/goimports")
 	if out, err := goGet.CombinedOutput(); err != nil {
@@ -1,4 +1,5 @@
 // Compiled by ClojureScript to Go 0.0-2371
+// The Intern_ calls at the end of init were added by hand, until regenerated by lein compile-clojurescript-tests.
 // hello
 
 package hello
@@ -14,6 +15,7 @@ func init() {
 		})
 	}(&cljs_core.AFn{})
 
+	cljs_core.Intern_("hello", "-main", &X_main, map[string]interface{}{"arglists": [][]string{{"&", "args"}}})
 }
 
 // @param {...*} var_args
@@ -0,0 +1,13 @@
+(ns cljs.core.async.macros)
+
+(defmacro go
+  "Asynchronously executes the body on a new goroutine, returning
+  immediately to the calling thread. Returns a channel which will
+  receive the result of the body when completed."
+  [& body]
+  `(cljs.core.async/go* (fn [] ~@body)))
+
+(defmacro go-loop
+  "Like (go (loop ...))"
+  [bindings & body]
+  `(go (loop ~bindings ~@body)))
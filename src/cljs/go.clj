@@ -188,7 +188,7 @@
        '[cljs.core
          cljs.reader
          ;; cljs.test ;; issue with .cljs$lang$test and .cljs$lang$body
-         ;; clojure.core.reducers ;; fails to macroexpand a destructure during analyzing.
+         ;; clojure.core.reducers ;; fails to macroexpand a destructure during analyzing, written in Go instead.
          clojure.set clojure.data clojure.string clojure.walk clojure.zip]))
   ([target-dir namespaces]
      (env/ensure
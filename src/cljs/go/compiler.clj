@@ -59,7 +59,10 @@
                                             goog.object
                                             js
                                             js.Math
+                                            js.Date
                                             cljs.core
+                                            cljs.core.async
+                                            clojure.core.reducers
                                             cljs.reader
                                             clojure.data
                                             clojure.set
@@ -89,10 +92,13 @@
 (def ^:dynamic *go-protocol* nil)
 (def ^:dynamic *go-loop-vars* #{})
 (def ^:dynamic *go-defs* nil)
+(def ^:dynamic *go-dynamic-root* false)
 (def ^:dynamic *go-def-vars* false)
 (def ^:dynamic *go-assign-vars* true)
 (def ^:dynamic *go-dot* false)
 (def ^:dynamic *go-line-numbers* false) ;; https://golang.org/cmd/gc/#hdr-Compiler_Directives
+(def ^:dynamic *go-line-file* nil)
+(def ^:dynamic *go-var-indirection* false) ;; or ^:go-var-indirection on the ns, defs are CljsCoreVars callers go through, see Var_
 (def ^:dynamic *go-skip-def*
   '#{cljs.core/*clojurescript-version*
      cljs.core/enable-console-print!
@@ -119,6 +125,7 @@
      cljs.core/char
      cljs.core/apply
      cljs.core/get
+     cljs.core/deref
      cljs.core/truth_
      cljs.core/is_proto_
      cljs.core/type
@@ -135,17 +142,36 @@
      cljs.core/key->js
      cljs.core/clj->js
      cljs.core/test
+     cljs.core/atom
+     cljs.core/reset!
+     cljs.core/swap!
+     cljs.core/compare-and-set!
+     cljs.core/set-validator!
+     cljs.core/get-validator
+     cljs.reader/macro-terminating?
+     cljs.reader/reader-error
      cljs.reader/read-2-chars
      cljs.reader/read-4-chars
      cljs.reader/read-token
+     cljs.reader/read-symbol
+     cljs.reader/read-list
+     cljs.reader/read-vector
+     cljs.reader/read-map
+     cljs.reader/read-set
+     cljs.reader/read-delimited-list
      cljs.reader/macros
+     cljs.reader/dispatch-macros
+     cljs.reader/read
+     cljs.reader/read-string
      cljs.reader/days-in-month
+     cljs.reader/parse-and-validate-timestamp
      cljs.reader/parse-timestamp
      cljs.reader/read-queue
      cljs.reader/read-date
      cljs.reader/read-uuid
      cljs.reader/read-js
      cljs.reader/*tag-table*
+     cljs.reader/maybe-read-tagged-type
      clojure.string/replace
      clojure.string/replace-first
      clojure.data/diff})
@@ -383,7 +409,7 @@
 
 (defn emitln [& xs]
   (when-let [line (and *go-line-numbers*  (some (comp :line :env) xs))]
-    (printf "\n//line %s:%d\n" ana/*cljs-file* line))
+    (printf "\n//line %s:%d\n" (or *go-line-file* ana/*cljs-file*) line))
   (apply emits xs)
   (println)
   nil)
@@ -486,6 +512,31 @@
 
 (defmethod emit* :no-op [m])
 
+(defn go-var-root
+  "Returns the Go name of the package variable holding the var described by info."
+  [info]
+  (let [ns (and (symbol? (:name info)) (some-> info :name namespace symbol))]
+    (munge (cond ;; this runs munge in a different order from most other things.
+             (or (= ana/*cljs-ns* ns)
+                 (:field info))
+             (update-in info [:name] (comp go-public munge name))
+             ns
+             (update-in info [:name] #(str ns "." (-> % name munge go-public)))
+             :else info))))
+
+(defn go-var-indirection?
+  "True if info describes a top-level def of a namespace compiled with
+  *go-var-indirection*, whose package variable is a CljsCoreVar, the root of
+  which is read with Var_root_ and set with Set_var_root_."
+  [info]
+  (let [ns (and (symbol? (:name info)) (some-> info :name namespace symbol))]
+    (boolean
+     (and ns
+          (not (or (:dynamic info) (:field info) (:binding-form? info)
+                   (:type info) (:protocol info) (:protocol-symbol info)))
+          (get-in @env/*compiler* [::ana/namespaces ns ::var-indirection])
+          (get-in @env/*compiler* [::ana/namespaces ns :defs (symbol (name (:name info)))])))))
+
 (defmethod emit* :var
   [{:keys [info env tag] :as arg}]
   (let [ns (and (symbol? (:name info)) (some-> info :name namespace symbol))
@@ -515,27 +566,51 @@
      (when-not statement?
        (binding [*go-return-tag* (when (go-needs-coercion? tag *go-return-tag*)
                                    *go-return-tag*)]
-         (emit-wrap env (emits (munge (cond ;; this runs munge in a different order from most other things.
-                                       (or (= ana/*cljs-ns* ns)
-                                           (:field info))
-                                       (update-in info [:name] (comp go-public munge name))
-                                       ns
-                                       (update-in info [:name] #(str ns "." (-> % name munge go-public)))
-                                       :else info)))))))))
+         (emit-wrap env
+           (let [root (go-var-root info)
+                 type (if (= 'function (:tag info))
+                        (str "*" (go-core "AFn"))
+                        (go-type (:tag info)))]
+             (cond
+               (and (:dynamic info) (not *go-dynamic-root*))
+               ;; Flags like *print-meta* can be bound to nil or any other value, so they're tested for truth.
+               ;; Other typed vars read as the zero value of their type when bound to nil.
+               (cond
+                 (= "bool" type)
+                 (emits (go-core "Truth_") "(" (go-core "Dynamic_") "(" (wrap-in-double-quotes (:name info)) ", " root "))")
+
+                 (= "interface{}" type)
+                 (emits (go-core "Dynamic_") "(" (wrap-in-double-quotes (:name info)) ", " root ")")
+
+                 :else
+                 (emits (go-core "Dynamic_or_zero_") "(" (wrap-in-double-quotes (:name info)) ", " root ").(" type ")"))
+
+               (go-var-indirection? info)
+               (do (emits (go-core "Var_root_") "(" root ")")
+                   (when-not (= "interface{}" type)
+                     (emits ".(" type ")")))
+
+               :else
+               (emits root)))))))))
+
+(defn emit-var-special [var sym meta]
+  (emits "(&" (go-core "CljsCoreVar") "{")
+  (emits "Val: ")
+  (emit var)
+  (emits ",")
+  (emits "Sym: ")
+  (emit sym)
+  (emits ",")
+  (emits "X_meta: ")
+  (emit meta)
+  (emits "})"))
 
 (defmethod emit* :var-special
   [{:keys [env var sym meta] :as arg}]
   (emit-wrap env
-    (emits "(&" (go-core "CljsCoreVar") "{")
-    (emits "Val: ")
-    (emit var)
-    (emits ",")
-    (emits "Sym: ")
-    (emit sym)
-    (emits ",")
-    (emits "X_meta: ")
-    (emit meta)
-    (emits "})")))
+    (if (go-var-indirection? (:info var))
+      (emits (go-var-root (:info var)))
+      (emit-var-special var sym meta))))
 
 (defmethod emit* :meta
   [{:keys [expr meta env]}]
@@ -644,6 +719,12 @@
 (defn safe-test? [env e]
   (= 'boolean (:tag e)))
 
+(defn go-dynamic-flag?
+  "True for reads of dynamic vars holding booleans, like *print-meta*, which are
+  already tested for truth where they're read."
+  [{:keys [op info]}]
+  (and (= :var op) (:dynamic info) (not *go-dynamic-root*) (= "bool" (go-type (:tag info)))))
+
 (defmethod emit* :if
   [{:keys [test then else env form unchecked tag]}]
   (let [context (:context env)
@@ -651,6 +732,7 @@
         checked (if-let [real-tag (go-tag-of-target test)]
                   (not= real-tag 'boolean)
                   checked)
+        checked (and checked (not (go-dynamic-flag? test)))
         test (cond-> test checked (assoc :tag 'any))] ;; and/or can mess up the tags - will always be interface{} for checked.
     (cond
       (truthy-constant? test) (emitln then)
@@ -721,16 +803,29 @@
             afn-type (str "*" (go-core "AFn"))
             def-type (if (= 'function tag)
                        afn-type
-                       (go-type (when-not (-> init :info :fn-var) tag)))]
+                       (go-type (when-not (-> init :info :fn-var) tag)))
+            indirect? (go-var-indirection? (get-in @env/*compiler* [::ana/namespaces (symbol (namespace name))
+                                                                     :defs (symbol (clojure.core/name name))]))]
         (some-> *go-defs* (swap! conj ast))
         (when *go-def-vars*
           (emit-comment doc (:jsdoc init))
-          (emits "var " mname " " def-type))
+          (if indirect?
+            (emits "var " mname " = " (go-core "Var_") "(" (wrap-in-double-quotes (namespace name)) ", "
+                   (wrap-in-double-quotes (escape-string (clojure.core/name name))) ")")
+            (emits "var " mname " " def-type)))
         (if-let [init (and *go-assign-vars*
                            (if init (emit-str init) ('{number "-1.0" boolean "false" clj-nil "nil"} tag)))]
-          (emitln (when (not *go-def-vars*) mname) " = " init
-                  (when (and (= 'function tag) (not fn?))
-                    (go-unbox-no-emit afn-type nil)))
+          (do (when (and *go-def-vars* indirect?)
+                (emitln))
+              (if indirect?
+                (emitln (go-core "Set_var_root_") "(" mname ", " init
+                        (when (and (= 'function tag) (not fn?))
+                          (go-unbox-no-emit afn-type nil))
+                        ")")
+                (emitln (when-not *go-def-vars* mname)
+                        " = " init
+                        (when (and (= 'function tag) (not fn?))
+                          (go-unbox-no-emit afn-type nil)))))
           (emitln))
         ;; NOTE: JavaScriptCore does not like this under advanced compilation
         ;; this change was primarily for REPL interactions - David
@@ -1003,7 +1098,7 @@
         ns (:ns info)
         go? (and ns (not (ana/get-namespace ns))
                  (some #(get (% (ana/get-namespace ana/*cljs-ns*)) ns) [:requires :uses]))
-        js? ('#{js Math} ns)
+        js? ('#{js Math Date} ns)
         goog? (go-goog? ns)
         native? (or js? goog? go? object?)
         keyword? (and (= (-> f :op) :constant)
@@ -1013,7 +1108,8 @@
         mfa (:max-fixed-arity info)
         variadic-invoke (and (:variadic info) (or (> arity mfa) (= 1 (count (:method-params info)))))
         primitive-sig (go-type-suffix params (-> f :info :ret-tag))
-        has-primitives? (not (or (re-find #"^I+$" primitive-sig) variadic-invoke))
+        has-primitives? (not (or (re-find #"^I+$" primitive-sig) variadic-invoke
+                                 (go-var-indirection? info)))
         tags-match? true ; (= (map :tag params) (map :tag args))
         ifn? (when (symbol? (:tag info))
                ('cljs.core/IFn (some->> (:tag info) (ana/resolve-existing-var (dissoc env :locals)) :protocols)))
@@ -1129,8 +1225,20 @@
                       ","  val ")")
               (when-not (= :statement (:context env))
                 (emitln (go-unbox-no-emit (:tag val) nil))))
-            (if (and static? (not *go-def-vars*))
+            (cond
+              (and static? (not *go-def-vars*))
               (swap! *go-defs* conj ast)
+
+              (-> target :info :dynamic)
+              (do (emitln "if !" (go-core "Set_dynamic_") "(" (wrap-in-double-quotes (-> target :info :name)) ", " val ") {")
+                  (binding [*go-dynamic-root* true]
+                    (emitln target " = " val))
+                  (emitln "}"))
+
+              (go-var-indirection? (:info target))
+              (emitln (go-core "Set_var_root_") "(" (go-var-root (:info target)) ", " val ")")
+
+              :else
               (do (when (and static? (= :statement (:context env)))
                     (emits "var "))
                   (emitln target " = " val))))
@@ -1138,10 +1246,12 @@
             (emitln " return " return)
             (emits "}()")))))))
 
-(def js-base-libs '#{js js.Math goog})
+(def js-base-libs '#{js js.Math js.Date goog})
 
 (defmethod emit* :ns
   [{:keys [name requires uses imports require-macros env]}]
+  (when (or *go-var-indirection* (-> name meta :go-var-indirection))
+    (swap! env/*compiler* assoc-in [::ana/namespaces name ::var-indirection] true))
   (emitln "// " name)
   (emitln)
   (emit-comment (-> name meta :doc)
@@ -1319,6 +1429,52 @@ func main() {
 }
 ")
 
+(defn go-intern-meta [{sym :name doc :doc}]
+  (let [{:keys [arglists dynamic private]}
+        (get-in @env/*compiler* [::ana/namespaces (symbol (namespace sym)) :defs (symbol (name sym))])
+        arglists (if (and (seq? arglists) (= 'quote (first arglists))) (second arglists) arglists)]
+    (->> [(when doc
+            (str "\"doc\": " (wrap-in-double-quotes (escape-string doc))))
+          (when (seq arglists)
+            (str "\"arglists\": [][]string{"
+                 (apply str (comma-sep (for [params arglists]
+                                         (str "{" (apply str (comma-sep (map (comp wrap-in-double-quotes escape-string pr-str) params))) "}"))))
+                 "}"))
+          (when dynamic "\"dynamic\": true")
+          (when private "\"private\": true")]
+         (remove nil?)
+         comma-sep
+         (apply str))))
+
+(defn emit-interns
+  "Registers the vars defined in this file with the runtime namespace
+  registry, see find-ns and ns-publics."
+  [defs]
+  (doseq [{sym :name :as ast} (->> defs
+                                   (filter (comp #{:def} :op))
+                                   (map (juxt :name identity))
+                                   (into {})
+                                   vals
+                                   (sort-by :name))]
+    (emitln (go-core "Intern_") "(" (wrap-in-double-quotes (namespace sym)) ", "
+            (wrap-in-double-quotes (escape-string (name sym))) ", "
+            (when-not (go-var-indirection? (get-in @env/*compiler* [::ana/namespaces (symbol (namespace sym)) :defs (symbol (name sym))]))
+              "&")
+            (-> sym munge go-short-name go-public)
+            ", map[string]interface{}{" (go-intern-meta ast) "})")))
+
+(defn go-line-file
+  "Returns the path of file relative to the classpath root it's on, like
+  cljs/core.cljs, for use in //line directives and thereby Go stack traces."
+  [^File file]
+  (let [path (.getCanonicalPath file)
+        root (->> (string/split (System/getProperty "java.class.path") (re-pattern File/pathSeparator))
+                  (map #(str (.getCanonicalPath (io/file ^String %)) File/separator))
+                  (filter #(.startsWith path ^String %))
+                  (sort-by count >)
+                  first)]
+    (subs path (count root))))
+
 (defn compile-file*
   ([src dest] (compile-file* src dest nil))
   ([src dest opts]
@@ -1331,7 +1487,9 @@ func main() {
                       ana/*cljs-ns* 'cljs.user
                       tags/*cljs-data-readers* (assoc tags/*cljs-data-readers* 'queue read-queue)
                       reader/*alias-map* (or reader/*alias-map* {})
-                      *go-line-numbers* (boolean (:source-map opts))]
+                      *go-line-numbers* (boolean (:source-map opts))
+                      *go-var-indirection* (or (:go-var-indirection opts) *go-var-indirection*)
+                      *go-line-file* (go-line-file src)]
               (let [forms (ana/forms-seq src)
                     [ns-ast forms] (let [ns-ast (ana/analyze (ana/empty-env) (first forms) nil opts)]
                                      (if (= :ns (:op ns-ast))
@@ -1345,6 +1503,7 @@ func main() {
                         :let [ast (ana/analyze (ana/empty-env) form nil opts)]
                         :when (not= ns-ast ast)]
                   (emit ast))
+                (emit-interns @*go-defs*)
                 (binding [*go-def-vars* true
                           *go-assign-vars* false]
                   (emitln "}")
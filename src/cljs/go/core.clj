@@ -294,11 +294,27 @@
 (defmacro identical? [a b]
   (bool-expr (core/list 'js* "reflect.DeepEqual(~{}, ~{})" a b))) ;; this is wrong, but kind of works
 
+(defn- go-package-var? [env sym]
+  (core/let [ns (:ns (ana/resolve-var (dissoc env :locals) sym))]
+    (core/and ns (core/not ('#{js goog} ns)) (core/not (cljs.compiler/go-goog? ns)) (core/not (ana/get-namespace ns)))))
+
 (defmacro instance? [t o]
   ;; Google Closure warns about some references to RegExp, so
   ;; (instance? RegExp ...) needs to be inlined, but the expansion
   ;; should preserve the order of argument evaluation.
-  (bool-expr `(~'js* ~(core/str (cljs.compiler/go-core "Value_") "(~{}).Type().AssignableTo(~{})") ~o ~t)))
+  ;; Types only known at run time, like Go errors used by catch, are matched by Instance_.
+  (if (core/or (core/not (core/symbol? t)) (contains? (:locals &env) t) (go-package-var? &env t))
+    (bool-expr `(~'js* ~(core/str (cljs.compiler/go-core "Instance_") "(~{}, ~{})") ~t ~o))
+    (bool-expr `(~'js* ~(core/str (cljs.compiler/go-core "Value_") "(~{}).Type().AssignableTo(~{})") ~o ~t))))
+
+(defmacro go-type
+  "Returns the reflect.Type of the Go type named by sym, like os/PathError.
+  Can be used to catch Go errors of this type, or pointers to it, anywhere
+  in the chain of a thrown error: (catch (go-type os/PathError) e ...)"
+  [sym]
+  (core/let [ns (:ns (ana/resolve-var (dissoc &env :locals) sym))]
+    (core/list 'js* (core/str "reflect.TypeOf((*" (clojure.string/replace (cljs.compiler/munge ns) "." "_")
+                              "." (name sym) ")(nil)).Elem()"))))
 
 (defmacro number? [x]
   (bool-expr (core/list 'js* (core/str (cljs.compiler/go-core "Value_") "(~{}).Kind() == reflect.Float64") x)))
@@ -752,7 +768,7 @@
 
 (core/declare dt->et collect-protocols)
 
-(def go-skip-type '#{cljs.core/ObjMap})
+(def go-skip-type '#{cljs.core/ObjMap cljs.core/Atom})
 
 (defn validate-impl-sigs [env p method]
   (when-not (= p 'Object)
@@ -1041,6 +1057,41 @@
   calls."
   `(new cljs.core/Delay (fn [] ~@body) nil))
 
+(defmacro future
+  "Takes a body of expressions and yields a future object that will
+  invoke the body in another goroutine, and will cache the result and
+  return it on all subsequent calls to deref/@. If the computation has
+  not yet finished, calls to deref/@ will block, unless the variant of
+  deref with timeout is used. See also - realized?."
+  [& body]
+  `(cljs.core/future-call (fn [] ~@body)))
+
+(defmacro pvalues
+  "Returns a lazy sequence of the values of the exprs, which are
+  evaluated in parallel"
+  [& exprs]
+  `(cljs.core/pcalls ~@(map (fn [e] `(fn [] ~e)) exprs)))
+
+(defmacro sync
+  "transaction-flags => TBD, pass nil for now
+
+  Runs the exprs (in an implicit do) in a transaction that encompasses
+  exprs and any nested calls.  Starts a transaction if none is already
+  running on this goroutine. Any uncaught exception will abort the
+  transaction and flow out of sync. The exprs may be run more than
+  once, but any effects on Refs will be atomic."
+  [flags-ignored-for-now & body]
+  `(cljs.core/run-in-transaction (fn [] ~@body)))
+
+(defmacro dosync
+  "Runs the exprs (in an implicit do) in a transaction that encompasses
+  exprs and any nested calls.  Starts a transaction if none is already
+  running on this goroutine. Any uncaught exception will abort the
+  transaction and flow out of dosync. The exprs may be run more than
+  once, but any effects on Refs will be atomic."
+  [& exprs]
+  `(sync nil ~@exprs))
+
 (defmacro with-redefs
   "binding => var-symbol temp-value-expr
 
@@ -1072,9 +1123,31 @@
   are made in parallel (unlike let); all init-exprs are evaluated
   before the vars are bound to their new values."
   [bindings & body]
-  (let [names (take-nth 2 bindings)]
+  (let [names (take-nth 2 bindings)
+        vals (take-nth 2 (drop 1 bindings))]
     (cljs.analyzer/confirm-bindings &env names)
-    `(with-redefs ~bindings ~@body)))
+    `(do
+       (cljs.core/push-thread-bindings
+        ~(zipmap (map #(str (:name (cljs.analyzer/resolve-var &env %))) names) vals))
+       (try
+         ~@body
+         (finally
+          (cljs.core/pop-thread-bindings))))))
+
+(defmacro with-bindings
+  "Takes a map of Var/value pairs. Installs for the given Vars the associated
+  values as goroutine-local bindings. Then executes body. Pops the installed
+  bindings after body was evaluated. Returns the value of body."
+  [binding-map & body]
+  `(cljs.core/with-bindings* ~binding-map (fn [] ~@body)))
+
+(defmacro bound-fn
+  "Returns a function defined by the given fntail, which will install the
+  same bindings in effect as in the goroutine at the time bound-fn was called.
+  This may be used to define a helper function which runs on a different
+  goroutine, but needs the same bindings in place."
+  [& fntail]
+  `(cljs.core/bound-fn* (fn ~@fntail)))
 
 (defmacro condp
   "Takes a binary predicate, an expression, and a set of clauses.
@@ -357,6 +357,8 @@
          cljs.reader-test
          cljs.binding-test-other-ns
          cljs.binding-test
+         cljs.var-test-other-ns
+         cljs.var-test
          cljs.macro-test
          cljs.letfn-test
          cljs.ns-test.bar